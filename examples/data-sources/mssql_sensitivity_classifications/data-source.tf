@@ -0,0 +1,14 @@
+data "mssql_database" "example" {
+  name = "example"
+}
+
+data "mssql_sensitivity_classifications" "all" {
+  database_id = data.mssql_database.example.id
+}
+
+output "confidential_columns" {
+  value = [
+    for c in data.mssql_sensitivity_classifications.all.classifications : "${c.table_name}.${c.column_name}"
+    if c.label == "Confidential"
+  ]
+}
@@ -0,0 +1,8 @@
+provider "mssql" {
+  hostname = "example.database.windows.net"
+
+  azure_auth = {
+    method    = "managed_identity"
+    client_id = "94e8d55d-cbbc-4e41-b21b-8923d83f9a85"
+  }
+}
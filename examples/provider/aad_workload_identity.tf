@@ -0,0 +1,10 @@
+provider "mssql" {
+  hostname = "example.database.windows.net"
+
+  azure_auth = {
+    method               = "workload_identity"
+    client_id            = "94e8d55d-cbbc-4e41-b21b-8923d83f9a85"
+    tenant_id            = "a352c914-bcf2-4f2a-8a3c-3da5a5a0a8c4"
+    oidc_token_file_path = "/var/run/secrets/azure/tokens/azure-identity-token"
+  }
+}
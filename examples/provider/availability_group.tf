@@ -0,0 +1,10 @@
+provider "mssql" {
+  hostname              = "ag-listener.example.com"
+  multi_subnet_failover = true
+  application_intent    = "ReadWrite"
+
+  sql_auth = {
+    username = "sa"
+    password = "sa_password"
+  }
+}
@@ -0,0 +1,21 @@
+provider "mssql" {
+  hostname = "sql.example.com"
+
+  sql_auth = {
+    username = "sa"
+    password = "P@ssw0rd"
+  }
+
+  timeouts = {
+    dial    = "10s"
+    connect = "30s"
+    command = "10m"
+  }
+
+  connection_pool = {
+    max_open_connections    = 10
+    max_idle_connections    = 2
+    connection_max_lifetime = "30m"
+    database_connection_ttl = "1h"
+  }
+}
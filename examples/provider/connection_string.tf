@@ -0,0 +1,12 @@
+variable "connection_string" {
+  type      = string
+  sensitive = true
+}
+
+provider "mssql" {
+  # e.g. "Server=tcp:sql.example.com,1433;Initial Catalog=master;User ID=sa;Password=P@ssw0rd;Encrypt=true"
+  connection_string = var.connection_string
+
+  # Explicitly set attributes take precedence over connection string values
+  application_intent = "ReadWrite"
+}
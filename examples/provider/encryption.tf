@@ -0,0 +1,14 @@
+provider "mssql" {
+  hostname = "sql.example.com"
+
+  sql_auth = {
+    username = "sa"
+    password = "sa_password"
+  }
+
+  encryption = {
+    mode                    = "strict"
+    certificate             = file("ca.pem")
+    hostname_in_certificate = "sql.example.com"
+  }
+}
@@ -0,0 +1,10 @@
+provider "mssql" {
+  hostname = "sql.example.com"
+
+  kerberos_auth = {
+    krb5_config_path = "/etc/krb5.conf"
+    keytab_path      = "/etc/terraform.keytab"
+    username         = "terraform"
+    realm            = "EXAMPLE.COM"
+  }
+}
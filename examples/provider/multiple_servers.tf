@@ -0,0 +1,33 @@
+provider "mssql" {
+  hostname = "sql-admin.example.com"
+
+  sql_auth = {
+    username = "sa"
+    password = "sa_password"
+  }
+
+  auth_profiles = {
+    fleet = {
+      sql_auth = {
+        username = "fleet_admin"
+        password = "fleet_password"
+      }
+    }
+  }
+}
+
+variable "servers" {
+  type    = set(string)
+  default = ["sql1.example.com", "sql2.example.com", "sql3.example.com"]
+}
+
+resource "mssql_database" "app" {
+  for_each = var.servers
+
+  name = "app"
+
+  server = {
+    hostname = each.value
+    auth     = "fleet"
+  }
+}
@@ -0,0 +1,11 @@
+provider "mssql" {
+  hostname   = "example.database.windows.net"
+  azure_auth = {}
+
+  retry = {
+    max_attempts    = 8
+    initial_backoff = "2s"
+    max_backoff     = "1m"
+    error_codes     = [4060, 40501, 40613]
+  }
+}
@@ -0,0 +1,9 @@
+provider "mssql" {
+  hostname   = "example.database.windows.net"
+  azure_auth = {}
+
+  retry = {
+    # Default codes, extended with deadlock victim (1205)
+    error_codes = [1205, 4060, 40197, 40501, 40613, 49918, 49919, 49920]
+  }
+}
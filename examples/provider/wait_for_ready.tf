@@ -0,0 +1,9 @@
+provider "mssql" {
+  hostname   = "example.database.windows.net"
+  azure_auth = {}
+
+  # Serverless databases can take a minute to resume after auto-pause
+  wait_for_ready = {
+    timeout = "10m"
+  }
+}
@@ -0,0 +1,2 @@
+# import using <alert_id> - can be retrieved using `SELECT id FROM msdb.dbo.sysalerts WHERE [name]='<alert_name>'`
+terraform import mssql_agent_alert.fatal_errors '1'
@@ -0,0 +1,17 @@
+resource "mssql_agent_operator" "dba" {
+  name          = "dba_team"
+  email_address = "dba@example.com"
+}
+
+resource "mssql_agent_alert" "fatal_errors" {
+  name                    = "fatal_errors"
+  severity                = 20
+  delay_between_responses = 300
+
+  notifications = [
+    {
+      operator_id = mssql_agent_operator.dba.id
+      method      = "EMAIL"
+    }
+  ]
+}
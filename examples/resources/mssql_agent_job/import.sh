@@ -0,0 +1,2 @@
+# import using <job_id> - can be retrieved using `SELECT job_id FROM msdb.dbo.sysjobs WHERE [name]='<job_name>'`
+terraform import mssql_agent_job.maintenance '1c7a3e5e-8a4f-4b6e-9d2a-3f2f6b0c1d4e'
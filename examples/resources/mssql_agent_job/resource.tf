@@ -0,0 +1,26 @@
+resource "mssql_agent_schedule" "nightly" {
+  name              = "nightly"
+  frequency_type    = "DAILY"
+  active_start_time = 20000
+}
+
+resource "mssql_agent_job" "maintenance" {
+  name        = "nightly_maintenance"
+  description = "Rebuilds indexes and updates statistics"
+
+  steps = [
+    {
+      name              = "rebuild_indexes"
+      database_name     = "example"
+      command           = "ALTER INDEX ALL ON dbo.orders REBUILD"
+      on_failure_action = "QUIT_WITH_FAILURE"
+    },
+    {
+      name          = "update_statistics"
+      database_name = "example"
+      command       = "EXEC sp_updatestats"
+    }
+  ]
+
+  schedule_ids = [mssql_agent_schedule.nightly.id]
+}
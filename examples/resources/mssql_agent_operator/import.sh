@@ -0,0 +1,2 @@
+# import using <operator_id> - can be retrieved using `SELECT id FROM msdb.dbo.sysoperators WHERE [name]='<operator_name>'`
+terraform import mssql_agent_operator.dba '1'
@@ -0,0 +1,4 @@
+resource "mssql_agent_operator" "dba" {
+  name          = "dba_team"
+  email_address = "dba@example.com"
+}
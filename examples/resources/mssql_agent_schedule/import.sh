@@ -0,0 +1,2 @@
+# import using <schedule_id> - can be retrieved using `SELECT schedule_id FROM msdb.dbo.sysschedules WHERE [name]='<schedule_name>'`
+terraform import mssql_agent_schedule.nightly '9'
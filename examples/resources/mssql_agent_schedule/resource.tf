@@ -0,0 +1,12 @@
+resource "mssql_agent_schedule" "nightly" {
+  name              = "nightly"
+  frequency_type    = "DAILY"
+  active_start_time = 20000
+}
+
+resource "mssql_agent_schedule" "every_15_minutes" {
+  name                      = "every_15_minutes"
+  frequency_type            = "DAILY"
+  frequency_subday_type     = "MINUTES"
+  frequency_subday_interval = 15
+}
@@ -0,0 +1,2 @@
+# import using <db_id>/<asymmetric_key_id> - can be retrieved using `SELECT CONCAT(DB_ID(), '/', asymmetric_key_id) FROM sys.asymmetric_keys WHERE [name]='<key_name>'`
+terraform import mssql_asymmetric_key.signing '1/256'
@@ -0,0 +1,9 @@
+resource "mssql_asymmetric_key" "signing" {
+  name      = "signing_key"
+  algorithm = "RSA_2048"
+}
+
+resource "mssql_sql_login" "signing" {
+  name              = "signing_login"
+  asymmetric_key_id = mssql_asymmetric_key.signing.id
+}
@@ -0,0 +1,2 @@
+# import using <db_id>/<certificate_id> - can be retrieved using `SELECT CONCAT(DB_ID(), '/', certificate_id) FROM sys.certificates WHERE [name]='<certificate_name>'`
+terraform import mssql_certificate.signing '7/256'
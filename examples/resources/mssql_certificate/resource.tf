@@ -0,0 +1,17 @@
+data "mssql_database" "example" {
+  name = "example"
+}
+
+resource "mssql_certificate" "signing" {
+  database_id         = data.mssql_database.example.id
+  name                = "signing_certificate"
+  subject             = "Module signing certificate"
+  expiry_date         = "2030-12-31"
+  encryption_password = "Str0ngCertPa$$w0rd"
+}
+
+resource "mssql_sql_user" "signing" {
+  name           = "signing_user"
+  database_id    = data.mssql_database.example.id
+  certificate_id = mssql_certificate.signing.id
+}
@@ -0,0 +1,2 @@
+# import using <db_id>/<column_encryption_key_id> - can be retrieved using `SELECT CONCAT(DB_ID(), '/', column_encryption_key_id) FROM sys.column_encryption_keys WHERE [name]='<key_name>'`
+terraform import mssql_column_encryption_key.example '7/1'
@@ -0,0 +1,28 @@
+data "mssql_database" "example" {
+  name = "example"
+}
+
+resource "mssql_column_master_key" "example" {
+  database_id             = data.mssql_database.example.id
+  name                    = "example_cmk"
+  key_store_provider_name = "AZURE_KEY_VAULT"
+  key_path                = "https://example-vault.vault.azure.net/keys/always-encrypted/1f3d0c0e0ac84e4b9e3c8d5fb5f56a5b"
+}
+
+resource "mssql_column_encryption_key" "example" {
+  database_id = data.mssql_database.example.id
+  name        = "example_cek"
+
+  values = [
+    {
+      column_master_key_id = mssql_column_master_key.example.id
+      algorithm            = "RSA_OAEP"
+      encrypted_value      = var.encrypted_cek_value
+    }
+  ]
+}
+
+variable "encrypted_cek_value" {
+  type        = string
+  description = "Column encryption key encrypted with the column master key, as generated by SSMS"
+}
@@ -0,0 +1,24 @@
+data "mssql_database" "example" {
+  name = "example"
+}
+
+resource "mssql_script" "customers" {
+  database_id = data.mssql_database.example.id
+
+  create_script = "CREATE TABLE [dbo].[Customers] ([Id] INT, [Email] NVARCHAR(100), [Phone] VARCHAR(20))"
+  delete_script = "DROP TABLE [dbo].[Customers]"
+  read_script   = "SELECT COUNT(*) AS [exists] FROM sys.tables WHERE [object_id]=OBJECT_ID('dbo.Customers')"
+
+  state = {
+    exists = "1"
+  }
+}
+
+resource "mssql_column_mask" "phone" {
+  database_id = data.mssql_database.example.id
+  table_name  = "dbo.Customers"
+  column_name = "Phone"
+  function    = "partial(0,\"XXX-XXX-\",4)"
+
+  depends_on = [mssql_script.customers]
+}
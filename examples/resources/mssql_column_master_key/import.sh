@@ -0,0 +1,2 @@
+# import using <db_id>/<column_master_key_id> - can be retrieved using `SELECT CONCAT(DB_ID(), '/', column_master_key_id) FROM sys.column_master_keys WHERE [name]='<key_name>'`
+terraform import mssql_column_master_key.example '7/1'
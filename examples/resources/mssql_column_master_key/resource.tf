@@ -0,0 +1,10 @@
+data "mssql_database" "example" {
+  name = "example"
+}
+
+resource "mssql_column_master_key" "example" {
+  database_id             = data.mssql_database.example.id
+  name                    = "example_cmk"
+  key_store_provider_name = "AZURE_KEY_VAULT"
+  key_path                = "https://example-vault.vault.azure.net/keys/always-encrypted/1f3d0c0e0ac84e4b9e3c8d5fb5f56a5b"
+}
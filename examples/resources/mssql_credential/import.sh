@@ -0,0 +1,2 @@
+# import using <credential_id> - can be retrieved using `SELECT credential_id FROM sys.credentials WHERE [name]='<credential_name>'`
+terraform import mssql_credential.example '65536'
@@ -0,0 +1,5 @@
+resource "mssql_credential" "example" {
+  name     = "example"
+  identity = "example_identity"
+  secret   = "example_secret"
+}
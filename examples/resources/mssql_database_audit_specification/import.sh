@@ -0,0 +1,2 @@
+# import using <db_id>/<specification_id> - can be retrieved using `SELECT CONCAT(DB_ID(), '/', database_specification_id) FROM sys.database_audit_specifications WHERE [name]='<specification_name>'`
+terraform import mssql_database_audit_specification.orders '7/65536'
@@ -0,0 +1,34 @@
+data "mssql_database" "example" {
+  name = "example"
+}
+
+data "mssql_database_role" "public" {
+  name        = "public"
+  database_id = data.mssql_database.example.id
+}
+
+resource "mssql_server_audit" "example" {
+  name        = "example"
+  destination = "APPLICATION_LOG"
+}
+
+resource "mssql_database_audit_specification" "orders" {
+  database_id = data.mssql_database.example.id
+  name        = "orders"
+  audit_id    = mssql_server_audit.example.id
+
+  action_groups = ["SCHEMA_OBJECT_CHANGE_GROUP"]
+
+  audit_actions = [
+    {
+      action       = "SELECT"
+      securable    = "OBJECT::dbo.Orders"
+      principal_id = data.mssql_database_role.public.id
+    },
+    {
+      action       = "DELETE"
+      securable    = "SCHEMA::sales"
+      principal_id = data.mssql_database_role.public.id
+    }
+  ]
+}
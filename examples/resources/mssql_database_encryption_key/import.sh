@@ -0,0 +1,2 @@
+# import using <db_id> - can be retrieved using `SELECT DB_ID('<db_name>')`
+terraform import mssql_database_encryption_key.example '7'
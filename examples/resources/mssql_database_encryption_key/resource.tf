@@ -0,0 +1,21 @@
+resource "mssql_master_key" "master" {
+  password = "Str0ngMasterKeyPa$$w0rd"
+}
+
+resource "mssql_server_certificate" "tde" {
+  name    = "tde_certificate"
+  subject = "TDE certificate"
+
+  depends_on = [mssql_master_key.master]
+}
+
+data "mssql_database" "example" {
+  name = "example"
+}
+
+resource "mssql_database_encryption_key" "example" {
+  database_id           = data.mssql_database.example.id
+  algorithm             = "AES_256"
+  server_certificate_id = mssql_server_certificate.tde.id
+  encryption_enabled    = true
+}
@@ -0,0 +1,13 @@
+data "mssql_database" "example" {
+  name = "example"
+}
+
+resource "mssql_database_query_store" "example" {
+  database_id                = data.mssql_database.example.id
+  operation_mode             = "READ_WRITE"
+  query_capture_mode         = "AUTO"
+  max_storage_size_mb        = 1024
+  stale_query_threshold_days = 30
+  interval_length_minutes    = 60
+  wait_stats_capture_mode    = true
+}
@@ -0,0 +1,3 @@
+# import using <db_id> - can be retrieved using `SELECT DB_ID('<db_name>')`
+# all configurations with non-default values are imported
+terraform import mssql_database_scoped_configuration.example '7'
@@ -0,0 +1,18 @@
+data "mssql_database" "example" {
+  name = "example"
+}
+
+resource "mssql_database_scoped_configuration" "example" {
+  database_id = data.mssql_database.example.id
+
+  configurations = {
+    MAXDOP                        = "4"
+    LEGACY_CARDINALITY_ESTIMATION = "ON"
+    QUERY_OPTIMIZER_HOTFIXES      = "ON"
+    IDENTITY_CACHE                = "OFF"
+  }
+
+  secondary_configurations = {
+    MAXDOP = "1"
+  }
+}
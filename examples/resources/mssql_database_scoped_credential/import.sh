@@ -0,0 +1,2 @@
+# import using <db_id>/<credential_id> - can be retrieved using `SELECT CONCAT(DB_ID(), '/', credential_id) FROM sys.database_scoped_credentials WHERE [name]='<credential_name>'`
+terraform import mssql_database_scoped_credential.example '7/65536'
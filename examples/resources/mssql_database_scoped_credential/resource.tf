@@ -0,0 +1,10 @@
+data "mssql_database" "example" {
+  name = "example"
+}
+
+resource "mssql_database_scoped_credential" "example" {
+  database_id = data.mssql_database.example.id
+  name        = "example"
+  identity    = "SHARED ACCESS SIGNATURE"
+  secret      = "sv=2022-11-02&ss=b&srt=sco&sp=rl&sig=example"
+}
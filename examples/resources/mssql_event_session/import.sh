@@ -0,0 +1,5 @@
+# import server-scoped session using <session_id> - can be retrieved using `SELECT event_session_id FROM sys.server_event_sessions WHERE [name]='<session_name>'`
+terraform import mssql_event_session.slow_queries '65536'
+
+# import database-scoped session using <db_id>/<session_id> - can be retrieved using `SELECT CONCAT(DB_ID(), '/', event_session_id) FROM sys.database_event_sessions WHERE [name]='<session_name>'`
+terraform import mssql_event_session.azure_errors '7/65536'
@@ -0,0 +1,53 @@
+resource "mssql_event_session" "slow_queries" {
+  name = "slow_queries"
+
+  events = [
+    {
+      name      = "sqlserver.rpc_completed"
+      predicate = "[duration] > 1000000"
+      actions   = ["sqlserver.sql_text", "sqlserver.username"]
+    },
+    {
+      name      = "sqlserver.sql_batch_completed"
+      predicate = "[duration] > 1000000"
+      actions   = ["sqlserver.sql_text"]
+    }
+  ]
+
+  targets = [
+    {
+      name = "package0.event_file"
+      options = {
+        filename           = "slow_queries.xel"
+        max_file_size      = "50"
+        max_rollover_files = "5"
+      }
+    }
+  ]
+
+  startup_state = true
+}
+
+data "mssql_database" "azure" {
+  name = "example"
+}
+
+resource "mssql_event_session" "azure_errors" {
+  database_id = data.mssql_database.azure.id
+  name        = "errors"
+
+  events = [
+    {
+      name    = "sqlserver.error_reported"
+      actions = ["sqlserver.sql_text"]
+    }
+  ]
+
+  targets = [
+    {
+      name = "package0.ring_buffer"
+    }
+  ]
+
+  running = false
+}
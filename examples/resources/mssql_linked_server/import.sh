@@ -0,0 +1,2 @@
+# import using <linked_server_id> - can be retrieved using `SELECT server_id FROM sys.servers WHERE [name]='<linked_server_name>'`
+terraform import mssql_linked_server.reporting '1'
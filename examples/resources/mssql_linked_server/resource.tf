@@ -0,0 +1,12 @@
+resource "mssql_linked_server" "reporting" {
+  name            = "reporting"
+  provider_name   = "MSOLEDBSQL"
+  data_source     = "reporting-sql.example.com,1433"
+  catalog         = "reports"
+  rpc_out_enabled = true
+}
+
+resource "mssql_linked_server" "legacy" {
+  name    = "LEGACY-SQL"
+  product = "SQL Server"
+}
@@ -0,0 +1,5 @@
+# import login mapping using <linked_server_id>/<login_id> - can be retrieved using `SELECT CONCAT(ll.server_id, '/', CONVERT(VARCHAR(85), sp.sid, 1)) FROM sys.linked_logins ll JOIN sys.server_principals sp ON sp.principal_id = ll.local_principal_id`
+terraform import mssql_linked_server_login.etl '1/0xB7BDEF7990D03541BAA2AD73E4FF18E8'
+
+# import mapping of all local logins using <linked_server_id>
+terraform import mssql_linked_server_login.default '1'
@@ -0,0 +1,22 @@
+resource "mssql_linked_server" "reporting" {
+  name          = "reporting"
+  provider_name = "MSOLEDBSQL"
+  data_source   = "reporting-sql.example.com,1433"
+}
+
+data "mssql_sql_login" "etl" {
+  name = "etl"
+}
+
+resource "mssql_linked_server_login" "etl" {
+  linked_server_id = mssql_linked_server.reporting.id
+  login_id         = data.mssql_sql_login.etl.id
+  remote_user      = "etl_reader"
+  remote_password  = "Str0ngRem0tePa$$w0rd"
+}
+
+# all other local logins connect using their own credentials
+resource "mssql_linked_server_login" "default" {
+  linked_server_id = mssql_linked_server.reporting.id
+  use_self         = true
+}
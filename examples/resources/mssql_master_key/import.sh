@@ -0,0 +1,2 @@
+# import using <db_id> - can be retrieved using `SELECT DB_ID('<db_name>')`
+terraform import mssql_master_key.master '1'
@@ -0,0 +1,8 @@
+data "mssql_database" "master" {
+  name = "master"
+}
+
+resource "mssql_master_key" "master" {
+  database_id = data.mssql_database.master.id
+  password    = "Str0ngMasterKeyPa$$w0rd"
+}
@@ -0,0 +1,2 @@
+# import using <function_schema>.<function_name> - can be retrieved using `SELECT CONCAT(OBJECT_SCHEMA_NAME(classifier_function_id, 1), '.', OBJECT_NAME(classifier_function_id, 1)) FROM sys.resource_governor_configuration`
+terraform import mssql_resource_governor_classifier.example 'dbo.rg_classifier'
@@ -0,0 +1,25 @@
+resource "mssql_sql_login" "reporting" {
+  name     = "reporting"
+  password = "Str0ngPa$$word12"
+}
+
+resource "mssql_resource_pool" "reports" {
+  name            = "reports"
+  max_cpu_percent = 30
+}
+
+resource "mssql_workload_group" "reports" {
+  name             = "reports"
+  resource_pool_id = mssql_resource_pool.reports.id
+}
+
+resource "mssql_resource_governor_classifier" "example" {
+  function_name = "rg_classifier"
+
+  login_routes = [
+    {
+      login_id          = mssql_sql_login.reporting.id
+      workload_group_id = mssql_workload_group.reports.id
+    }
+  ]
+}
@@ -0,0 +1,2 @@
+# import using <pool_id> - can be retrieved using `SELECT pool_id FROM sys.resource_governor_resource_pools WHERE [name]='<pool_name>'`
+terraform import mssql_resource_pool.reports '256'
@@ -0,0 +1,6 @@
+resource "mssql_resource_pool" "reports" {
+  name               = "reports"
+  max_cpu_percent    = 30
+  cap_cpu_percent    = 50
+  max_memory_percent = 40
+}
@@ -0,0 +1,2 @@
+# import using <db_id>/<policy_id> - can be retrieved using `SELECT CONCAT(DB_ID(), '/', OBJECT_ID('<schema_name>.<policy_name>'))`
+terraform import mssql_security_policy.tenant '7/1269579561'
@@ -0,0 +1,41 @@
+data "mssql_database" "example" {
+  name = "example"
+}
+
+resource "mssql_script" "tenant_predicate" {
+  database_id = data.mssql_database.example.id
+
+  read_script   = "SELECT COUNT(*) AS [exists] FROM sys.objects WHERE [object_id] = OBJECT_ID('dbo.fn_tenant_access')"
+  update_script = <<-SQL
+    CREATE OR ALTER FUNCTION [dbo].[fn_tenant_access](@tenant_id INT)
+    RETURNS TABLE WITH SCHEMABINDING AS
+    RETURN SELECT 1 AS [result] WHERE @tenant_id = CAST(SESSION_CONTEXT(N'tenant_id') AS INT)
+  SQL
+
+  state = {
+    exists = "1"
+  }
+}
+
+resource "mssql_security_policy" "tenant" {
+  database_id = data.mssql_database.example.id
+  name        = "tenant_isolation"
+
+  predicates = [
+    {
+      type      = "FILTER"
+      function  = "dbo.fn_tenant_access"
+      arguments = "TenantId"
+      table     = "dbo.Orders"
+    },
+    {
+      type      = "BLOCK"
+      function  = "dbo.fn_tenant_access"
+      arguments = "TenantId"
+      table     = "dbo.Orders"
+      operation = "AFTER_INSERT"
+    }
+  ]
+
+  depends_on = [mssql_script.tenant_predicate]
+}
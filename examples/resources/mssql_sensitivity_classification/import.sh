@@ -0,0 +1,2 @@
+# import using <db_id>/<table_id>/<column_id> - can be retrieved using `SELECT CONCAT(DB_ID(), '/', OBJECT_ID('<schema_name>.<table_name>'), '/', COLUMNPROPERTY(OBJECT_ID('<schema_name>.<table_name>'), '<column_name>', 'ColumnId'))`
+terraform import mssql_sensitivity_classification.example '7/1093578934/3'
@@ -0,0 +1,14 @@
+data "mssql_database" "example" {
+  name = "example"
+}
+
+resource "mssql_sensitivity_classification" "email" {
+  database_id         = data.mssql_database.example.id
+  table_name          = "dbo.Customers"
+  column_name         = "Email"
+  label               = "Confidential"
+  label_id            = "331f0b13-76b5-2f1b-a77b-def5a73c73c2"
+  information_type    = "Contact Info"
+  information_type_id = "5c503e21-22c6-81fa-620b-f369b8ec38d1"
+  rank                = "MEDIUM"
+}
@@ -0,0 +1,2 @@
+# import using <audit_id> - can be retrieved using `SELECT audit_id FROM sys.server_audits WHERE [name]='<audit_name>'`
+terraform import mssql_server_audit.file '65536'
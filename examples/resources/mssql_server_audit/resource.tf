@@ -0,0 +1,15 @@
+resource "mssql_server_audit" "file" {
+  name               = "file_audit"
+  destination        = "FILE"
+  file_path          = "/var/opt/mssql/audit/"
+  max_file_size_mb   = 100
+  max_rollover_files = 10
+  queue_delay        = 1000
+  on_failure         = "CONTINUE"
+}
+
+resource "mssql_server_audit" "app_log" {
+  name        = "app_log_audit"
+  destination = "APPLICATION_LOG"
+  enabled     = false
+}
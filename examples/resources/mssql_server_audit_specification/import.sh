@@ -0,0 +1,2 @@
+# import using <specification_id> - can be retrieved using `SELECT server_specification_id FROM sys.server_audit_specifications WHERE [name]='<specification_name>'`
+terraform import mssql_server_audit_specification.logins '65536'
@@ -0,0 +1,14 @@
+resource "mssql_server_audit" "example" {
+  name        = "example"
+  destination = "APPLICATION_LOG"
+}
+
+resource "mssql_server_audit_specification" "logins" {
+  name     = "logins"
+  audit_id = mssql_server_audit.example.id
+
+  action_groups = [
+    "FAILED_LOGIN_GROUP",
+    "SUCCESSFUL_LOGIN_GROUP",
+  ]
+}
@@ -0,0 +1,2 @@
+# import using <certificate_id> - can be retrieved using `SELECT certificate_id FROM master.sys.certificates WHERE [name]='<certificate_name>'`
+terraform import mssql_server_certificate.generated '256'
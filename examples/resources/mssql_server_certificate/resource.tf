@@ -0,0 +1,12 @@
+resource "mssql_server_certificate" "generated" {
+  name        = "tde_certificate"
+  subject     = "TDE certificate"
+  expiry_date = "2030-12-31"
+}
+
+resource "mssql_server_certificate" "from_file" {
+  name                  = "imported_certificate"
+  file_path             = "/var/opt/mssql/certs/imported.cer"
+  private_key_file_path = "/var/opt/mssql/certs/imported.pvk"
+  private_key_password  = "Str0ngPrivateKeyPa$$w0rd"
+}
@@ -0,0 +1,2 @@
+# import using comma-separated list of option names - can be retrieved using `SELECT [name] FROM sys.configurations`
+terraform import mssql_server_configuration.tuning 'contained database authentication,cost threshold for parallelism,max degree of parallelism,max server memory (MB),optimize for ad hoc workloads'
@@ -0,0 +1,9 @@
+resource "mssql_server_configuration" "tuning" {
+  options = {
+    "max server memory (MB)"            = 16384
+    "cost threshold for parallelism"    = 50
+    "max degree of parallelism"         = 4
+    "optimize for ad hoc workloads"     = 1
+    "contained database authentication" = 1
+  }
+}
@@ -0,0 +1,2 @@
+# import using <db_id>/<object_id>/<thumbprint> - can be retrieved using `SELECT CONCAT(DB_ID(), '/', major_id, '/', CONVERT(VARCHAR(MAX), thumbprint, 1)) FROM sys.crypt_properties WHERE major_id=OBJECT_ID('<object_name>')`
+terraform import mssql_signature.example '7/245575913/0x8E3A1C5D67B1A14B6F7C3D0B2E6A1E9F40C2A7B1'
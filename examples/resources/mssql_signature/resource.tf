@@ -0,0 +1,16 @@
+data "mssql_database" "example" {
+  name = "example"
+}
+
+resource "mssql_certificate" "signing" {
+  database_id         = data.mssql_database.example.id
+  name                = "signing_certificate"
+  subject             = "Module signing certificate"
+  encryption_password = "Str0ngCertPa$$w0rd"
+}
+
+resource "mssql_signature" "example" {
+  object_name    = "dbo.usp_cleanup"
+  certificate_id = mssql_certificate.signing.id
+  password       = "Str0ngCertPa$$w0rd"
+}
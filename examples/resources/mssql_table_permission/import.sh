@@ -0,0 +1,2 @@
+# import using <db_id>/<table_id>/<column_id>/<principal_id>/<permission> - can be retrieved using `SELECT CONCAT(DB_ID(), '/', OBJECT_ID('<schema_name>.<table_name>'), '/0/', DATABASE_PRINCIPAL_ID('<principal_name>'), '/SELECT')`
+terraform import mssql_table_permission.example '7/1093578934/0/8/SELECT'
@@ -0,0 +1,21 @@
+data "mssql_database" "example" {
+  name = "example"
+}
+
+data "mssql_sql_user" "example" {
+  name        = "example_user"
+  database_id = data.mssql_database.example.id
+}
+
+resource "mssql_table_permission" "select_customers" {
+  principal_id = data.mssql_sql_user.example.id
+  table_name   = "dbo.Customers"
+  permission   = "SELECT"
+}
+
+resource "mssql_table_permission" "unmask_phone" {
+  principal_id = data.mssql_sql_user.example.id
+  table_name   = "dbo.Customers"
+  column_name  = "Phone"
+  permission   = "UNMASK"
+}
@@ -0,0 +1,2 @@
+# import using <group_id> - can be retrieved using `SELECT group_id FROM sys.resource_governor_workload_groups WHERE [name]='<group_name>'`
+terraform import mssql_workload_group.reports '256'
@@ -0,0 +1,12 @@
+resource "mssql_resource_pool" "reports" {
+  name            = "reports"
+  max_cpu_percent = 30
+}
+
+resource "mssql_workload_group" "reports" {
+  name                             = "reports"
+  resource_pool_id                 = mssql_resource_pool.reports.id
+  importance                       = "LOW"
+  request_max_memory_grant_percent = 10
+  max_dop                          = 2
+}
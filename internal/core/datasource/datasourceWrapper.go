@@ -6,6 +6,9 @@ import (
 	"github.com/PGSSoft/terraform-provider-mssql/internal/core"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 var _ datasource.DataSourceWithValidateConfig = &dataSourceWrapper[any]{}
@@ -46,22 +49,53 @@ func (d *dataSourceWrapper[T]) Schema(ctx context.Context, _ datasource.SchemaRe
 	resp := SchemaResponse{}
 	d.d.Schema(utils.WithDiagnostics(ctx, &response.Diagnostics), SchemaRequest{}, &resp)
 	response.Schema = resp.Schema
+
+	if response.Schema.Attributes == nil {
+		response.Schema.Attributes = map[string]schema.Attribute{}
+	}
+
+	response.Schema.Attributes[core.ServerAttributeName] = schema.SingleNestedAttribute{
+		MarkdownDescription: core.ServerDescription,
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: core.ServerHostnameDescription,
+				Required:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: core.ServerPortDescription,
+				Optional:            true,
+			},
+			"instance_name": schema.StringAttribute{
+				MarkdownDescription: core.ServerInstanceDescription,
+				Optional:            true,
+			},
+			"auth": schema.StringAttribute{
+				MarkdownDescription: core.ServerAuthDescription,
+				Optional:            true,
+			},
+		},
+	}
 }
 
 func (d *dataSourceWrapper[T]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	ctx = utils.WithDiagnostics(ctx, &resp.Diagnostics)
 
+	var serverObj types.Object
+
 	request := ReadRequest[T]{}
 	request.monad = utils.StopOnError(ctx).
-		Then(func() { request.Conn = d.ctx.ConnFactory(ctx) }).
-		Then(func() { request.Config = utils.GetData[T](ctx, req.Config) })
+		Then(func() {
+			request.Config, serverObj = core.GetServerData[T](ctx, req.Config, basetypes.ObjectAsOptions{})
+		}).
+		Then(func() { request.Conn = d.ctx.ConnFactory(ctx, core.GetServer(ctx, serverObj)) })
 
 	response := ReadResponse[T]{}
 	d.d.Read(ctx, request, &response)
 
 	request.monad.Then(func() {
 		if response.exists {
-			utils.SetData(ctx, &resp.State, response.state)
+			core.SetServerData(ctx, &resp.State, response.state, serverObj)
 		} else {
 			resp.State.RemoveResource(ctx)
 		}
@@ -78,7 +112,7 @@ func (d *dataSourceWrapper[T]) ValidateConfig(ctx context.Context, req datasourc
 
 	request := ValidateRequest[T]{}
 	request.monad = utils.StopOnError(ctx).
-		Then(func() { request.Config = utils.GetData[T](ctx, req.Config) })
+		Then(func() { request.Config, _ = core.GetServerData[T](ctx, req.Config, basetypes.ObjectAsOptions{}) })
 
 	response := ValidateResponse[T]{}
 	dsWithValidation.Validate(ctx, request, &response)
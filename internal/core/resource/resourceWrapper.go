@@ -7,6 +7,10 @@ import (
 	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -53,43 +57,126 @@ func (r *resourceWrapper[T]) Schema(ctx context.Context, _ resource.SchemaReques
 	resp := SchemaResponse{}
 	r.r.Schema(utils.WithDiagnostics(ctx, &response.Diagnostics), SchemaRequest{}, &resp)
 	response.Schema = resp.Schema
+
+	if response.Schema.Attributes == nil {
+		response.Schema.Attributes = map[string]schema.Attribute{}
+	}
+
+	response.Schema.Attributes[core.ServerAttributeName] = schema.SingleNestedAttribute{
+		MarkdownDescription: core.ServerDescription + " Changing `hostname`, `port` or `instance_name` forces a new resource to be created. " +
+			"Import is supported only for resources managed on the provider server.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: core.ServerHostnameDescription,
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: core.ServerPortDescription,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					serverPortDefault{},
+					serverPortRequiresReplace(),
+				},
+			},
+			"instance_name": schema.StringAttribute{
+				MarkdownDescription: core.ServerInstanceDescription,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"auth": schema.StringAttribute{
+				MarkdownDescription: core.ServerAuthDescription,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// serverPortDefault sets default port in the plan, unless named instance is selected. Port of the named instance is
+// resolved using SQL Browser service, so it is kept null.
+type serverPortDefault struct{}
+
+func (serverPortDefault) Description(context.Context) string {
+	return fmt.Sprintf("When neither port nor instance_name is set in config, %d will be used in plan", core.DefaultServerPort)
+}
+
+func (m serverPortDefault) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (serverPortDefault) PlanModifyInt64(ctx context.Context, request planmodifier.Int64Request, response *planmodifier.Int64Response) {
+	if !request.ConfigValue.IsNull() {
+		return
+	}
+
+	var instance types.String
+	diags := request.Config.GetAttribute(ctx, request.Path.ParentPath().AtName("instance_name"), &instance)
+	if response.Diagnostics.Append(diags...); response.Diagnostics.HasError() {
+		return
+	}
+
+	if instance.IsNull() {
+		response.PlanValue = types.Int64Value(core.DefaultServerPort)
+	} else {
+		response.PlanValue = types.Int64Null()
+	}
+}
+
+// serverPortRequiresReplace forces replacement when the port changes. State created before the port default was
+// introduced keeps null port, which means the same server as the default port.
+func serverPortRequiresReplace() planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(func(_ context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+		resp.RequiresReplace = portOrDefault(req.StateValue) != portOrDefault(req.PlanValue)
+	}, "Changing the port forces a new resource to be created", "Changing the port forces a new resource to be created")
+}
+
+func portOrDefault(port types.Int64) int64 {
+	if port.IsNull() {
+		return core.DefaultServerPort
+	}
+
+	return port.ValueInt64()
 }
 
 func (r *resourceWrapper[T]) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	ctx = utils.WithDiagnostics(ctx, &response.Diagnostics)
 
+	var serverObj types.Object
+
 	req := CreateRequest[T]{}
 	req.monad = utils.StopOnError(ctx).
-		Then(func() { req.Conn = r.ctx.ConnFactory(ctx) }).
 		Then(func() {
-			obj := utils.GetData[types.Object](ctx, request.Plan)
-			diags := obj.As(ctx, &req.Plan, basetypes.ObjectAsOptions{
+			req.Plan, serverObj = core.GetServerData[T](ctx, request.Plan, basetypes.ObjectAsOptions{
 				UnhandledUnknownAsEmpty: true,
 				UnhandledNullAsEmpty:    true,
 			})
-			utils.AppendDiagnostics(ctx, diags...)
-		})
+		}).
+		Then(func() { req.Conn = r.ctx.ConnFactory(ctx, core.GetServer(ctx, serverObj)) })
 
 	resp := CreateResponse[T]{}
 	r.r.Create(ctx, req, &resp)
 
-	req.monad.Then(func() { utils.SetData(ctx, &response.State, resp.State) })
+	req.monad.Then(func() { core.SetServerData(ctx, &response.State, resp.State, serverObj) })
 }
 
 func (r *resourceWrapper[T]) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	ctx = utils.WithDiagnostics(ctx, &response.Diagnostics)
 
+	var serverObj types.Object
+
 	req := ReadRequest[T]{}
 	req.monad = utils.StopOnError(ctx).
-		Then(func() { req.Conn = r.ctx.ConnFactory(ctx) }).
-		Then(func() { req.State = utils.GetData[T](ctx, request.State) })
+		Then(func() { req.State, serverObj = core.GetServerData[T](ctx, request.State, basetypes.ObjectAsOptions{}) }).
+		Then(func() { req.Conn = r.ctx.ConnFactory(ctx, core.GetServer(ctx, serverObj)) })
 
 	resp := ReadResponse[T]{}
 	r.r.Read(ctx, req, &resp)
 
 	req.monad.Then(func() {
 		if resp.exists {
-			utils.SetData(ctx, &response.State, resp.state)
+			core.SetServerData(ctx, &response.State, resp.state, serverObj)
 		} else {
 			response.State.RemoveResource(ctx)
 		}
@@ -99,25 +186,29 @@ func (r *resourceWrapper[T]) Read(ctx context.Context, request resource.ReadRequ
 func (r *resourceWrapper[T]) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	ctx = utils.WithDiagnostics(ctx, &response.Diagnostics)
 
+	var serverObj types.Object
+
 	req := UpdateRequest[T]{}
 	req.monad = utils.StopOnError(ctx).
-		Then(func() { req.Conn = r.ctx.ConnFactory(ctx) }).
-		Then(func() { req.Plan = utils.GetData[T](ctx, request.Plan) }).
-		Then(func() { req.State = utils.GetData[T](ctx, request.State) })
+		Then(func() { req.Plan, serverObj = core.GetServerData[T](ctx, request.Plan, basetypes.ObjectAsOptions{}) }).
+		Then(func() { req.State, _ = core.GetServerData[T](ctx, request.State, basetypes.ObjectAsOptions{}) }).
+		Then(func() { req.Conn = r.ctx.ConnFactory(ctx, core.GetServer(ctx, serverObj)) })
 
 	resp := UpdateResponse[T]{}
 	r.r.Update(ctx, req, &resp)
 
-	req.monad.Then(func() { utils.SetData(ctx, &response.State, resp.State) })
+	req.monad.Then(func() { core.SetServerData(ctx, &response.State, resp.State, serverObj) })
 }
 
 func (r *resourceWrapper[T]) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	ctx = utils.WithDiagnostics(ctx, &response.Diagnostics)
 
+	var serverObj types.Object
+
 	req := DeleteRequest[T]{}
 	req.monad = utils.StopOnError(ctx).
-		Then(func() { req.Conn = r.ctx.ConnFactory(ctx) }).
-		Then(func() { req.State = utils.GetData[T](ctx, request.State) })
+		Then(func() { req.State, serverObj = core.GetServerData[T](ctx, request.State, basetypes.ObjectAsOptions{}) }).
+		Then(func() { req.Conn = r.ctx.ConnFactory(ctx, core.GetServer(ctx, serverObj)) })
 
 	resp := DeleteResponse[T]{}
 	r.r.Delete(ctx, req, &resp)
@@ -138,7 +229,7 @@ func (r *resourceWrapper[T]) ValidateConfig(ctx context.Context, request resourc
 	ctx = utils.WithDiagnostics(ctx, &response.Diagnostics)
 
 	req := ValidateRequest[T]{}
-	req.monad = utils.StopOnError(ctx).Then(func() { req.Config = utils.GetData[T](ctx, request.Config) })
+	req.monad = utils.StopOnError(ctx).Then(func() { req.Config, _ = core.GetServerData[T](ctx, request.Config, basetypes.ObjectAsOptions{}) })
 	resp := ValidateResponse[T]{}
 	res.Validate(ctx, req, &resp)
 }
@@ -0,0 +1,103 @@
+package resource
+
+import (
+	"context"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestServerPortDefault(t *testing.T) {
+	ctx := context.Background()
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			core.ServerAttributeName: schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"port":          schema.Int64Attribute{Optional: true, Computed: true},
+					"instance_name": schema.StringAttribute{Optional: true},
+				},
+			},
+		},
+	}
+	serverAttrTypes := map[string]attr.Type{"port": types.Int64Type, "instance_name": types.StringType}
+
+	cases := map[string]struct {
+		port     types.Int64
+		instance types.String
+		expected types.Int64
+	}{
+		"default":        {port: types.Int64Null(), instance: types.StringNull(), expected: types.Int64Value(core.DefaultServerPort)},
+		"port":           {port: types.Int64Value(1444), instance: types.StringNull(), expected: types.Int64Value(1444)},
+		"named instance": {port: types.Int64Null(), instance: types.StringValue("test_instance"), expected: types.Int64Null()},
+		"instance port":  {port: types.Int64Value(1444), instance: types.StringValue("test_instance"), expected: types.Int64Value(1444)},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			config := types.ObjectValueMust(testSchema.Type().(attr.TypeWithAttributeTypes).AttributeTypes(), map[string]attr.Value{
+				core.ServerAttributeName: types.ObjectValueMust(serverAttrTypes, map[string]attr.Value{"port": tc.port, "instance_name": tc.instance}),
+			})
+			raw, err := config.ToTerraformValue(ctx)
+			require.NoError(t, err)
+
+			req := planmodifier.Int64Request{
+				Path:        path.Root(core.ServerAttributeName).AtName("port"),
+				Config:      tfsdk.Config{Schema: testSchema, Raw: raw},
+				ConfigValue: tc.port,
+				PlanValue:   types.Int64Unknown(),
+			}
+			resp := planmodifier.Int64Response{PlanValue: req.PlanValue}
+			if !tc.port.IsNull() {
+				resp.PlanValue = tc.port
+			}
+
+			serverPortDefault{}.PlanModifyInt64(ctx, req, &resp)
+
+			require.False(t, resp.Diagnostics.HasError(), "diagnostics")
+			assert.Equal(t, tc.expected, resp.PlanValue)
+		})
+	}
+}
+
+func TestServerPortRequiresReplace(t *testing.T) {
+	cases := map[string]struct {
+		state           types.Int64
+		plan            types.Int64
+		requiresReplace bool
+	}{
+		"unchanged":          {state: types.Int64Value(1433), plan: types.Int64Value(1433)},
+		"null to default":    {state: types.Int64Null(), plan: types.Int64Value(1433)},
+		"null to other":      {state: types.Int64Null(), plan: types.Int64Value(1444), requiresReplace: true},
+		"changed":            {state: types.Int64Value(1433), plan: types.Int64Value(1444), requiresReplace: true},
+		"default to unknown": {state: types.Int64Value(1433), plan: types.Int64Unknown(), requiresReplace: true},
+	}
+
+	existing := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.Int64Request{
+				State:      tfsdk.State{Raw: existing},
+				Plan:       tfsdk.Plan{Raw: existing},
+				StateValue: tc.state,
+				PlanValue:  tc.plan,
+			}
+			resp := planmodifier.Int64Response{PlanValue: tc.plan}
+
+			serverPortRequiresReplace().PlanModifyInt64(context.Background(), req, &resp)
+
+			assert.Equal(t, tc.requiresReplace, resp.RequiresReplace)
+		})
+	}
+}
@@ -6,5 +6,6 @@ import (
 )
 
 type ResourceContext struct {
-	ConnFactory func(ctx context.Context) sql.Connection
+	// ConnFactory returns connection to the given server, or to the server configured in the provider when server is nil.
+	ConnFactory func(ctx context.Context, server *Server) sql.Connection
 }
@@ -0,0 +1,132 @@
+package core
+
+import (
+	"context"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ServerAttributeName is the name of the optional attribute, added to all resources and data sources,
+// which selects SQL Server other than the one configured in the provider.
+const ServerAttributeName = "server"
+
+// DefaultServerPort is used when neither `port` nor `instance_name` of the `server` attribute is set.
+const DefaultServerPort = 1433
+
+const (
+	ServerHostnameDescription = "FQDN or IP address of the SQL endpoint."
+	ServerPortDescription     = "TCP port of SQL endpoint. Defaults to `1433`, unless `instance_name` is set."
+	ServerInstanceDescription = "Name of SQL Server named instance. When `port` is not set, the instance port is resolved using SQL Browser service."
+	ServerAuthDescription     = "Name of the provider `auth_profiles` entry used to authenticate. When omitted, the provider authentication settings are used."
+	ServerDescription         = "SQL Server the object is managed on. When omitted, the server configured in the provider is used. " +
+		"Other connection settings, e.g. `encryption` or `retry`, are taken from the provider configuration."
+)
+
+// Server identifies SQL endpoint selected with the `server` attribute. It is comparable, so it can be used as a cache key.
+type Server struct {
+	Hostname string
+	// Port is 0 when named instance port should be resolved using SQL Browser service
+	Port     int64
+	Instance string
+	Auth     string
+}
+
+type serverData struct {
+	Hostname     types.String `tfsdk:"hostname"`
+	Port         types.Int64  `tfsdk:"port"`
+	InstanceName types.String `tfsdk:"instance_name"`
+	Auth         types.String `tfsdk:"auth"`
+}
+
+// GetServerData reads resource data, separating the `server` attribute from attributes handled by the resource itself.
+func GetServerData[T any](ctx context.Context, dg utils.DataGetter, opts basetypes.ObjectAsOptions) (data T, serverObj types.Object) {
+	obj := utils.GetData[types.Object](ctx, dg)
+	if utils.HasError(ctx) {
+		return
+	}
+
+	attrs := map[string]attr.Value{}
+	for name, value := range obj.Attributes() {
+		if name == ServerAttributeName {
+			serverObj = value.(types.Object)
+		} else {
+			attrs[name] = value
+		}
+	}
+
+	dataObj, diags := types.ObjectValue(withoutServerAttribute(obj.AttributeTypes(ctx)), attrs)
+	if utils.AppendDiagnostics(ctx, diags...); utils.HasError(ctx) {
+		return
+	}
+
+	diags = dataObj.As(ctx, &data, opts)
+	utils.AppendDiagnostics(ctx, diags...)
+	return
+}
+
+// GetServer converts value of the `server` attribute. Returns nil when the attribute is not set.
+func GetServer(ctx context.Context, serverObj types.Object) *Server {
+	if serverObj.IsNull() {
+		return nil
+	}
+
+	if serverObj.IsUnknown() {
+		utils.AddAttributeError(ctx, path.Root(ServerAttributeName), "Server cannot be a computed value", "SQL connection details must be known during plan execution")
+		return nil
+	}
+
+	sd := serverData{}
+	diags := serverObj.As(ctx, &sd, basetypes.ObjectAsOptions{})
+	if utils.AppendDiagnostics(ctx, diags...); utils.HasError(ctx) {
+		return nil
+	}
+
+	if sd.Hostname.IsUnknown() || sd.Port.IsUnknown() || sd.InstanceName.IsUnknown() || sd.Auth.IsUnknown() {
+		utils.AddAttributeError(ctx, path.Root(ServerAttributeName), "Server cannot be a computed value", "SQL connection details must be known during plan execution")
+		return nil
+	}
+
+	server := &Server{Hostname: sd.Hostname.ValueString(), Port: sd.Port.ValueInt64(), Instance: sd.InstanceName.ValueString(), Auth: sd.Auth.ValueString()}
+	if sd.Port.IsNull() && server.Instance == "" {
+		server.Port = DefaultServerPort
+	}
+
+	return server
+}
+
+// SetServerData stores resource data in the state, together with the `server` attribute value.
+func SetServerData(ctx context.Context, state *tfsdk.State, data any, serverObj types.Object) {
+	attrTypes := state.Schema.Type().(attr.TypeWithAttributeTypes).AttributeTypes()
+
+	dataObj, diags := types.ObjectValueFrom(ctx, withoutServerAttribute(attrTypes), data)
+	if utils.AppendDiagnostics(ctx, diags...); utils.HasError(ctx) {
+		return
+	}
+
+	attrs := dataObj.Attributes()
+	attrs[ServerAttributeName] = serverObj
+	if serverObj.IsNull() {
+		attrs[ServerAttributeName] = types.ObjectNull(attrTypes[ServerAttributeName].(attr.TypeWithAttributeTypes).AttributeTypes())
+	}
+
+	obj, diags := types.ObjectValue(attrTypes, attrs)
+	if utils.AppendDiagnostics(ctx, diags...); utils.HasError(ctx) {
+		return
+	}
+
+	utils.SetData(ctx, state, obj)
+}
+
+func withoutServerAttribute(attrTypes map[string]attr.Type) map[string]attr.Type {
+	result := map[string]attr.Type{}
+	for name, t := range attrTypes {
+		if name != ServerAttributeName {
+			result[name] = t
+		}
+	}
+	return result
+}
@@ -0,0 +1,182 @@
+package core
+
+import (
+	"context"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type testServerResourceData struct {
+	Name types.String `tfsdk:"name"`
+}
+
+var testServerAttrTypes = map[string]attr.Type{
+	"hostname":      types.StringType,
+	"port":          types.Int64Type,
+	"instance_name": types.StringType,
+	"auth":          types.StringType,
+}
+
+var testServerSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{Required: true},
+		ServerAttributeName: schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"hostname":      schema.StringAttribute{Required: true},
+				"port":          schema.Int64Attribute{Optional: true},
+				"instance_name": schema.StringAttribute{Optional: true},
+				"auth":          schema.StringAttribute{Optional: true},
+			},
+		},
+	},
+}
+
+func testServerObject(hostname string, port types.Int64, auth types.String) types.Object {
+	return testInstanceServerObject(hostname, port, types.StringNull(), auth)
+}
+
+func testInstanceServerObject(hostname string, port types.Int64, instance types.String, auth types.String) types.Object {
+	return types.ObjectValueMust(testServerAttrTypes, map[string]attr.Value{
+		"hostname":      types.StringValue(hostname),
+		"port":          port,
+		"instance_name": instance,
+		"auth":          auth,
+	})
+}
+
+func TestGetServer(t *testing.T) {
+	cases := map[string]struct {
+		serverObj types.Object
+		expected  *Server
+		isError   bool
+	}{
+		"null": {
+			serverObj: types.ObjectNull(testServerAttrTypes),
+		},
+		"set": {
+			serverObj: testServerObject("sql.test.local", types.Int64Value(1444), types.StringValue("fleet")),
+			expected:  &Server{Hostname: "sql.test.local", Port: 1444, Auth: "fleet"},
+		},
+		"default port": {
+			serverObj: testServerObject("sql.test.local", types.Int64Null(), types.StringNull()),
+			expected:  &Server{Hostname: "sql.test.local", Port: DefaultServerPort},
+		},
+		"named instance": {
+			serverObj: testInstanceServerObject("sql.test.local", types.Int64Null(), types.StringValue("test_instance"), types.StringNull()),
+			expected:  &Server{Hostname: "sql.test.local", Instance: "test_instance"},
+		},
+		"named instance with port": {
+			serverObj: testInstanceServerObject("sql.test.local", types.Int64Value(1444), types.StringValue("test_instance"), types.StringNull()),
+			expected:  &Server{Hostname: "sql.test.local", Port: 1444, Instance: "test_instance"},
+		},
+		"unknown": {
+			serverObj: types.ObjectUnknown(testServerAttrTypes),
+			isError:   true,
+		},
+		"unknown port": {
+			serverObj: testServerObject("sql.test.local", types.Int64Unknown(), types.StringNull()),
+			isError:   true,
+		},
+		"unknown instance": {
+			serverObj: testInstanceServerObject("sql.test.local", types.Int64Null(), types.StringUnknown(), types.StringNull()),
+			isError:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			server := GetServer(utils.WithDiagnostics(context.Background(), &diags), tc.serverObj)
+
+			assert.Equal(t, tc.isError, diags.HasError(), "error")
+			assert.Equal(t, tc.expected, server)
+		})
+	}
+}
+
+func TestGetServerData(t *testing.T) {
+	cases := map[string]types.Object{
+		"null": types.ObjectNull(testServerAttrTypes),
+		"set":  testServerObject("sql.test.local", types.Int64Value(1444), types.StringNull()),
+	}
+
+	for name, serverObj := range cases {
+		serverObj := serverObj
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			state := tfsdk.State{Schema: testServerSchema}
+			diags := state.Set(ctx, types.ObjectValueMust(testServerSchema.Type().(attr.TypeWithAttributeTypes).AttributeTypes(), map[string]attr.Value{
+				"name":              types.StringValue("test_name"),
+				ServerAttributeName: serverObj,
+			}))
+			require.False(t, diags.HasError(), "setting state")
+
+			data, actualServerObj := GetServerData[testServerResourceData](utils.WithDiagnostics(ctx, &diags), state, basetypes.ObjectAsOptions{})
+
+			require.False(t, diags.HasError(), "diagnostics")
+			assert.Equal(t, testServerResourceData{Name: types.StringValue("test_name")}, data, "data")
+			assert.Equal(t, serverObj, actualServerObj, "server")
+		})
+	}
+}
+
+func TestSetServerData(t *testing.T) {
+	cases := map[string]struct {
+		oldServerObj types.Object
+		newServerObj types.Object
+	}{
+		"null": {
+			oldServerObj: testServerObject("sql.test.local", types.Int64Null(), types.StringNull()),
+			newServerObj: types.ObjectNull(map[string]attr.Type{}),
+		},
+		"set": {
+			oldServerObj: types.ObjectNull(testServerAttrTypes),
+			newServerObj: testServerObject("sql.test.local", types.Int64Value(1433), types.StringNull()),
+		},
+		"changed": {
+			oldServerObj: testServerObject("sql.test.local", types.Int64Value(1433), types.StringNull()),
+			newServerObj: testInstanceServerObject("sql2.test.local", types.Int64Null(), types.StringValue("test_instance"), types.StringValue("fleet")),
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			state := tfsdk.State{Schema: testServerSchema}
+			diags := state.Set(ctx, types.ObjectValueMust(testServerSchema.Type().(attr.TypeWithAttributeTypes).AttributeTypes(), map[string]attr.Value{
+				"name":              types.StringValue("old_name"),
+				ServerAttributeName: tc.oldServerObj,
+			}))
+			require.False(t, diags.HasError(), "setting state")
+
+			SetServerData(utils.WithDiagnostics(ctx, &diags), &state, testServerResourceData{Name: types.StringValue("new_name")}, tc.newServerObj)
+			require.False(t, diags.HasError(), "diagnostics")
+
+			var name types.String
+			var serverObj types.Object
+			diags.Append(state.GetAttribute(ctx, path.Root("name"), &name)...)
+			diags.Append(state.GetAttribute(ctx, path.Root(ServerAttributeName), &serverObj)...)
+			require.False(t, diags.HasError(), "reading state")
+
+			assert.Equal(t, "new_name", name.ValueString(), "name")
+			if tc.newServerObj.IsNull() {
+				assert.True(t, serverObj.IsNull(), "server")
+			} else {
+				assert.Equal(t, tc.newServerObj, serverObj, "server")
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package planModifiers
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func BoolDefault(value bool) planmodifier.Bool {
+	return boolDefaultModifier{value: value}
+}
+
+type boolDefaultModifier struct {
+	value bool
+}
+
+func (m boolDefaultModifier) Description(context.Context) string {
+	return fmt.Sprintf("When value is not set in config, %t will be used in plan", m.value)
+}
+
+func (m boolDefaultModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m boolDefaultModifier) PlanModifyBool(_ context.Context, request planmodifier.BoolRequest, response *planmodifier.BoolResponse) {
+	if !request.ConfigValue.IsNull() {
+		return
+	}
+
+	response.PlanValue = types.BoolValue(m.value)
+}
@@ -0,0 +1,51 @@
+package planModifiers
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBoolDefaultModifier(t *testing.T) {
+	cases := map[string]struct {
+		request       planmodifier.BoolRequest
+		expectedValue types.Bool
+	}{
+		"null config": {
+			request: planmodifier.BoolRequest{
+				ConfigValue: types.BoolNull(),
+				StateValue:  types.BoolValue(false),
+				PlanValue:   types.BoolValue(false),
+			},
+			expectedValue: types.BoolValue(true),
+		},
+		"set config": {
+			request: planmodifier.BoolRequest{
+				ConfigValue: types.BoolValue(false),
+				StateValue:  types.BoolValue(true),
+				PlanValue:   types.BoolValue(false),
+			},
+			expectedValue: types.BoolValue(false),
+		},
+		"unknown config": {
+			request: planmodifier.BoolRequest{
+				ConfigValue: types.BoolUnknown(),
+				PlanValue:   types.BoolUnknown(),
+			},
+			expectedValue: types.BoolUnknown(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			modifier := BoolDefault(true)
+			response := planmodifier.BoolResponse{PlanValue: tc.request.PlanValue}
+
+			modifier.PlanModifyBool(context.Background(), tc.request, &response)
+
+			assert.Equal(t, tc.expectedValue, response.PlanValue)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"sync"
+)
+
+// connCache keeps connections opened per server, so resources iterating over many servers share connection pools.
+// Opening connection to one server, which can take long e.g. while waiting for the database, does not block other servers.
+type connCache struct {
+	mutex sync.Mutex
+	conns map[core.Server]*cachedConn
+}
+
+type cachedConn struct {
+	mutex sync.Mutex
+	conn  sql.Connection
+}
+
+// get returns cached connection of the server, or calls open to create it. Connection is cached only when open
+// does not report errors.
+func (c *connCache) get(ctx context.Context, server core.Server, open func() sql.Connection) sql.Connection {
+	c.mutex.Lock()
+	if c.conns == nil {
+		c.conns = map[core.Server]*cachedConn{}
+	}
+	entry, ok := c.conns[server]
+	if !ok {
+		entry = &cachedConn{}
+		c.conns[server] = entry
+	}
+	c.mutex.Unlock()
+
+	entry.mutex.Lock()
+	defer entry.mutex.Unlock()
+
+	if entry.conn != nil {
+		return entry.conn
+	}
+
+	conn := open()
+	if !utils.HasError(ctx) {
+		entry.conn = conn
+	}
+
+	return conn
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type fakeConnection struct {
+	sql.Connection
+	server core.Server
+}
+
+func TestConnCacheReusesConnection(t *testing.T) {
+	cache := connCache{}
+	server := core.Server{Hostname: "sql.test.local", Port: 1433}
+	calls := 0
+	open := func() sql.Connection {
+		calls++
+		return fakeConnection{server: server}
+	}
+	var diags diag.Diagnostics
+	ctx := utils.WithDiagnostics(context.Background(), &diags)
+
+	first := cache.get(ctx, server, open)
+	second := cache.get(ctx, server, open)
+
+	assert.Equal(t, 1, calls, "open calls")
+	assert.Equal(t, first, second, "connection")
+}
+
+func TestConnCacheDoesNotCacheFailures(t *testing.T) {
+	cache := connCache{}
+	server := core.Server{Hostname: "sql.test.local", Port: 1433}
+	calls := 0
+	failing := func(ctx context.Context) func() sql.Connection {
+		return func() sql.Connection {
+			calls++
+			utils.AddError(ctx, "Test error", assert.AnError)
+			return nil
+		}
+	}
+
+	var diags diag.Diagnostics
+	ctx := utils.WithDiagnostics(context.Background(), &diags)
+	cache.get(ctx, server, failing(ctx))
+	var otherDiags diag.Diagnostics
+	otherCtx := utils.WithDiagnostics(context.Background(), &otherDiags)
+	cache.get(otherCtx, server, failing(otherCtx))
+
+	assert.Equal(t, 2, calls, "open calls")
+}
+
+func TestConnCacheDoesNotBlockOtherServers(t *testing.T) {
+	cache := connCache{}
+	slowServer := core.Server{Hostname: "slow.test.local", Port: 1433}
+	fastServer := core.Server{Hostname: "fast.test.local", Port: 1433}
+	release := make(chan struct{})
+	defer close(release)
+	var diags diag.Diagnostics
+	ctx := utils.WithDiagnostics(context.Background(), &diags)
+
+	opening := make(chan struct{})
+	go cache.get(ctx, slowServer, func() sql.Connection {
+		close(opening)
+		<-release
+		return fakeConnection{server: slowServer}
+	})
+	<-opening
+
+	done := make(chan sql.Connection)
+	go func() {
+		var diags diag.Diagnostics
+		done <- cache.get(utils.WithDiagnostics(context.Background(), &diags), fastServer, func() sql.Connection {
+			return fakeConnection{server: fastServer}
+		})
+	}()
+
+	select {
+	case conn := <-done:
+		assert.Equal(t, fakeConnection{server: fastServer}, conn)
+	case <-time.After(time.Second):
+		assert.Fail(t, "opening connection to other server was blocked")
+	}
+}
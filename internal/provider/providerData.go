@@ -3,10 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type sqlAuth struct {
@@ -15,72 +20,339 @@ type sqlAuth struct {
 }
 
 type azureAuth struct {
-	ClientId     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	TenantId     types.String `tfsdk:"tenant_id"`
+	Method                    types.String `tfsdk:"method"`
+	ClientId                  types.String `tfsdk:"client_id"`
+	ClientSecret              types.String `tfsdk:"client_secret"`
+	TenantId                  types.String `tfsdk:"tenant_id"`
+	ClientCertificatePath     types.String `tfsdk:"client_certificate_path"`
+	ClientCertificatePassword types.String `tfsdk:"client_certificate_password"`
+	OidcTokenFilePath         types.String `tfsdk:"oidc_token_file_path"`
+	AccessToken               types.String `tfsdk:"access_token"`
 }
 
+type kerberosAuth struct {
+	Krb5ConfigPath      types.String `tfsdk:"krb5_config_path"`
+	KeytabPath          types.String `tfsdk:"keytab_path"`
+	CredentialCachePath types.String `tfsdk:"credential_cache_path"`
+	Username            types.String `tfsdk:"username"`
+	Realm               types.String `tfsdk:"realm"`
+	ServerSPN           types.String `tfsdk:"server_spn"`
+}
+
+type encryption struct {
+	Mode                   types.String `tfsdk:"mode"`
+	Certificate            types.String `tfsdk:"certificate"`
+	HostNameInCertificate  types.String `tfsdk:"hostname_in_certificate"`
+	TrustServerCertificate types.Bool   `tfsdk:"trust_server_certificate"`
+}
+
+type retry struct {
+	MaxAttempts    types.Int64  `tfsdk:"max_attempts"`
+	InitialBackoff types.String `tfsdk:"initial_backoff"`
+	MaxBackoff     types.String `tfsdk:"max_backoff"`
+	ErrorCodes     types.Set    `tfsdk:"error_codes"`
+}
+
+type timeouts struct {
+	Dial    types.String `tfsdk:"dial"`
+	Connect types.String `tfsdk:"connect"`
+	Command types.String `tfsdk:"command"`
+}
+
+type connectionPool struct {
+	MaxOpenConnections    types.Int64  `tfsdk:"max_open_connections"`
+	MaxIdleConnections    types.Int64  `tfsdk:"max_idle_connections"`
+	ConnectionMaxLifetime types.String `tfsdk:"connection_max_lifetime"`
+	ConnectionMaxIdleTime types.String `tfsdk:"connection_max_idle_time"`
+	DatabaseConnectionTTL types.String `tfsdk:"database_connection_ttl"`
+}
+
+type waitForReady struct {
+	Timeout types.String `tfsdk:"timeout"`
+}
+
+type authProfile struct {
+	SqlAuth      *sqlAuth      `tfsdk:"sql_auth"`
+	AzureAuth    *azureAuth    `tfsdk:"azure_auth"`
+	KerberosAuth *kerberosAuth `tfsdk:"kerberos_auth"`
+	AccessToken  types.String  `tfsdk:"access_token"`
+}
+
+const defaultWaitForReadyTimeout = 5 * time.Minute
+
 type providerData struct {
-	Hostname  types.String `tfsdk:"hostname"`
-	Port      types.Int64  `tfsdk:"port"`
-	SqlAuth   *sqlAuth     `tfsdk:"sql_auth"`
-	AzureAuth *azureAuth   `tfsdk:"azure_auth"`
+	Hostname            types.String           `tfsdk:"hostname"`
+	Port                types.Int64            `tfsdk:"port"`
+	InstanceName        types.String           `tfsdk:"instance_name"`
+	FailoverPartner     types.String           `tfsdk:"failover_partner"`
+	MultiSubnetFailover types.Bool             `tfsdk:"multi_subnet_failover"`
+	ApplicationIntent   types.String           `tfsdk:"application_intent"`
+	SqlAuth             *sqlAuth               `tfsdk:"sql_auth"`
+	AzureAuth           *azureAuth             `tfsdk:"azure_auth"`
+	KerberosAuth        *kerberosAuth          `tfsdk:"kerberos_auth"`
+	AccessToken         types.String           `tfsdk:"access_token"`
+	AuthProfiles        map[string]authProfile `tfsdk:"auth_profiles"`
+	ConnectionString    types.String           `tfsdk:"connection_string"`
+	Retry               *retry                 `tfsdk:"retry"`
+	Timeouts            *timeouts              `tfsdk:"timeouts"`
+	ConnectionPool      *connectionPool        `tfsdk:"connection_pool"`
+	WaitForReady        *waitForReady          `tfsdk:"wait_for_ready"`
+	Encryption          *encryption            `tfsdk:"encryption"`
 }
 
-func (pd providerData) asConnectionDetails(context.Context) (sql.ConnectionDetails, diag.Diagnostics) {
+func (pd providerData) asConnectionDetails(ctx context.Context) (sql.ConnectionDetails, diag.Diagnostics) {
 	diags := diag.Diagnostics{}
 
-	var addComputedError = func(summary string) {
-		diags.AddError(summary, "SQL connection details must be known during plan execution")
+	if pd.ConnectionString.IsUnknown() {
+		addComputedError(&diags, "Connection string cannot be a computed value")
 	}
 
-	if pd.Hostname.IsUnknown() {
-		addComputedError("Hostname cannot be a computed value")
+	// Values parsed from connection string take precedence over environment variables of individual settings,
+	// but are overridden by explicitly set attributes.
+	parsed := sql.ConnectionDetails{}
+	connStr := os.Getenv("MSSQL_CONNECTION_STRING")
+	if !pd.ConnectionString.IsNull() {
+		connStr = pd.ConnectionString.ValueString()
 	}
 
-	connDetails := sql.ConnectionDetails{
-		Host: os.Getenv("MSSQL_HOSTNAME"),
+	if connStr != "" {
+		var err error
+		if parsed, err = sql.ParseConnectionString(connStr); err != nil {
+			diags.AddError("Invalid connection string", fmt.Sprintf("Failed to parse connection string: %v", err))
+		}
+	}
+
+	parsedHost, parsedPort, err := net.SplitHostPort(parsed.Host)
+	if err != nil {
+		parsedHost, parsedPort = parsed.Host, ""
 	}
 
-	if !pd.Hostname.IsNull() {
-		connDetails.Host = pd.Hostname.ValueString()
+	connDetails := sql.ConnectionDetails{
+		Host:       stringOrDefault(&diags, pd.Hostname, "Hostname", parsedHost, os.Getenv("MSSQL_HOSTNAME")),
+		Database:   parsed.Database,
+		Auth:       parsed.Auth,
+		Encryption: parsed.Encryption,
 	}
 
 	if !pd.Port.IsNull() {
 		connDetails.Host = fmt.Sprintf("%s:%d", connDetails.Host, pd.Port.ValueInt64())
+	} else if parsedPort != "" {
+		connDetails.Host = fmt.Sprintf("%s:%s", connDetails.Host, parsedPort)
 	} else if envPort := os.Getenv("MSSQL_PORT"); envPort != "" {
 		connDetails.Host = fmt.Sprintf("%s:%s", connDetails.Host, envPort)
 	}
 
-	if pd.SqlAuth != nil {
-		if pd.SqlAuth.Username.IsUnknown() {
-			addComputedError("SQL username cannot be a computed value")
+	connDetails.Instance = stringOrDefault(&diags, pd.InstanceName, "Instance name", parsed.Instance, os.Getenv("MSSQL_INSTANCE_NAME"))
+	connDetails.FailoverPartner = stringOrDefault(&diags, pd.FailoverPartner, "Failover partner", parsed.FailoverPartner, os.Getenv("MSSQL_FAILOVER_PARTNER"))
+
+	if pd.MultiSubnetFailover.IsUnknown() {
+		addComputedError(&diags, "Multi-subnet failover cannot be a computed value")
+	}
+
+	if !pd.MultiSubnetFailover.IsNull() {
+		connDetails.MultiSubnetFailover = pd.MultiSubnetFailover.ValueBool()
+	} else if parsed.MultiSubnetFailover {
+		connDetails.MultiSubnetFailover = true
+	} else if envValue := os.Getenv("MSSQL_MULTI_SUBNET_FAILOVER"); envValue != "" {
+		value, err := strconv.ParseBool(envValue)
+		if err != nil {
+			diags.AddError("Invalid MSSQL_MULTI_SUBNET_FAILOVER environment variable", fmt.Sprintf("Value %q is not a valid boolean", envValue))
+		}
+		connDetails.MultiSubnetFailover = value
+	}
+
+	if intent := stringOrDefault(&diags, pd.ApplicationIntent, "Application intent", parsed.ApplicationIntent, os.Getenv("MSSQL_APPLICATION_INTENT")); intent != "" {
+		if connDetails.ApplicationIntent = normalizeApplicationIntent(intent); connDetails.ApplicationIntent == "" {
+			diags.AddError("Invalid application intent", fmt.Sprintf("Application intent %q is not supported. Must be one of: %s", intent, strings.Join(sql.ApplicationIntents, ", ")))
+		}
+	}
+
+	if auth := (authProfile{SqlAuth: pd.SqlAuth, AzureAuth: pd.AzureAuth, KerberosAuth: pd.KerberosAuth, AccessToken: pd.AccessToken}).asConnectionAuth(&diags); auth != nil {
+		connDetails.Auth = auth
+	}
+
+	if pd.Encryption != nil {
+		if pd.Encryption.Mode.IsUnknown() {
+			addComputedError(&diags, "Encryption mode cannot be a computed value")
+		}
+
+		if pd.Encryption.Certificate.IsUnknown() {
+			addComputedError(&diags, "Encryption certificate cannot be a computed value")
+		}
+
+		if pd.Encryption.HostNameInCertificate.IsUnknown() {
+			addComputedError(&diags, "Encryption hostname_in_certificate cannot be a computed value")
+		}
+
+		if pd.Encryption.TrustServerCertificate.IsUnknown() {
+			addComputedError(&diags, "Encryption trust_server_certificate cannot be a computed value")
+		}
+
+		connDetails.Encryption = &sql.ConnectionEncryption{
+			Mode:                   pd.Encryption.Mode.ValueString(),
+			Certificate:            pd.Encryption.Certificate.ValueString(),
+			HostNameInCertificate:  pd.Encryption.HostNameInCertificate.ValueString(),
+			TrustServerCertificate: pd.Encryption.TrustServerCertificate.ValueBool(),
+		}
+	}
+
+	// parseDuration sets target only when the value is provided, so it keeps defaults otherwise
+	parseDuration := func(value types.String, attrName string, target *time.Duration) {
+		if value.IsUnknown() {
+			addComputedError(&diags, fmt.Sprintf("%s cannot be a computed value", attrName))
+		}
+
+		if value.IsNull() || value.IsUnknown() {
+			return
+		}
+
+		var err error
+		if *target, err = time.ParseDuration(value.ValueString()); err != nil {
+			diags.AddError("Invalid duration", fmt.Sprintf("%s %q is not a valid duration: %v", attrName, value.ValueString(), err))
+		}
+	}
+
+	if pd.Timeouts != nil {
+		parseDuration(pd.Timeouts.Dial, "Dial timeout", &connDetails.DialTimeout)
+		parseDuration(pd.Timeouts.Connect, "Connect timeout", &connDetails.ConnectTimeout)
+		parseDuration(pd.Timeouts.Command, "Command timeout", &connDetails.CommandTimeout)
+	}
+
+	if pd.ConnectionPool != nil {
+		if pd.ConnectionPool.MaxOpenConnections.IsUnknown() || pd.ConnectionPool.MaxIdleConnections.IsUnknown() {
+			addComputedError(&diags, "Connection pool size cannot be a computed value")
+		}
+
+		connDetails.Pool = sql.ConnectionPool{
+			MaxOpenConns: int(pd.ConnectionPool.MaxOpenConnections.ValueInt64()),
+			MaxIdleConns: int(pd.ConnectionPool.MaxIdleConnections.ValueInt64()),
+		}
+
+		parseDuration(pd.ConnectionPool.ConnectionMaxLifetime, "Connection max lifetime", &connDetails.Pool.ConnMaxLifetime)
+		parseDuration(pd.ConnectionPool.ConnectionMaxIdleTime, "Connection max idle time", &connDetails.Pool.ConnMaxIdleTime)
+		parseDuration(pd.ConnectionPool.DatabaseConnectionTTL, "Database connection TTL", &connDetails.Pool.DatabaseConnectionTTL)
+	}
+
+	if pd.WaitForReady != nil {
+		connDetails.WaitForReady = defaultWaitForReadyTimeout
+		parseDuration(pd.WaitForReady.Timeout, "Wait for ready timeout", &connDetails.WaitForReady)
+	}
+
+	if pd.Retry != nil {
+		if pd.Retry.MaxAttempts.IsUnknown() || pd.Retry.ErrorCodes.IsUnknown() {
+			addComputedError(&diags, "Retry policy cannot be a computed value")
+		}
+
+		retryPolicy := sql.DefaultRetryPolicy
+
+		if !pd.Retry.MaxAttempts.IsNull() {
+			retryPolicy.MaxAttempts = int(pd.Retry.MaxAttempts.ValueInt64())
+		}
+
+		parseDuration(pd.Retry.InitialBackoff, "Retry initial_backoff", &retryPolicy.InitialBackoff)
+		parseDuration(pd.Retry.MaxBackoff, "Retry max_backoff", &retryPolicy.MaxBackoff)
+
+		if !pd.Retry.ErrorCodes.IsNull() && !pd.Retry.ErrorCodes.IsUnknown() {
+			var codes []int64
+			diags.Append(pd.Retry.ErrorCodes.ElementsAs(ctx, &codes, false)...)
+
+			retryPolicy.ErrorCodes = make([]int32, len(codes))
+			for i, code := range codes {
+				retryPolicy.ErrorCodes[i] = int32(code)
+			}
+		}
+
+		connDetails.Retry = &retryPolicy
+	}
+
+	return connDetails, diags
+}
+
+// asServerConnectionDetails returns connection details of the server selected with the `server` attribute of a resource.
+// Settings identifying the provider server are dropped, the rest of the provider configuration is kept.
+func (pd providerData) asServerConnectionDetails(ctx context.Context, server core.Server) (sql.ConnectionDetails, diag.Diagnostics) {
+	connDetails, diags := pd.asConnectionDetails(ctx)
+
+	connDetails.Host = server.Hostname
+	if server.Port != 0 {
+		connDetails.Host = fmt.Sprintf("%s:%d", server.Hostname, server.Port)
+	}
+
+	connDetails.Instance = server.Instance
+	connDetails.FailoverPartner = ""
+	connDetails.Database = ""
+
+	if server.Auth == "" {
+		return connDetails, diags
+	}
+
+	profile, ok := pd.AuthProfiles[server.Auth]
+	if !ok {
+		diags.AddError("Unknown auth profile", fmt.Sprintf("Auth profile %q of server %q is not defined in the provider auth_profiles", server.Auth, server.Hostname))
+		return connDetails, diags
+	}
+
+	connDetails.Auth = profile.asConnectionAuth(&diags)
+
+	return connDetails, diags
+}
+
+// asConnectionAuth returns nil when no authentication method is configured.
+func (ap authProfile) asConnectionAuth(diags *diag.Diagnostics) (auth sql.ConnectionAuth) {
+	if ap.SqlAuth != nil {
+		if ap.SqlAuth.Username.IsUnknown() {
+			addComputedError(diags, "SQL username cannot be a computed value")
 		}
 
-		if pd.SqlAuth.Password.IsUnknown() {
-			addComputedError("SQL password cannot be a computed value")
+		if ap.SqlAuth.Password.IsUnknown() {
+			addComputedError(diags, "SQL password cannot be a computed value")
 		}
 
-		connDetails.Auth = sql.ConnectionAuthSql{Username: pd.SqlAuth.Username.ValueString(), Password: pd.SqlAuth.Password.ValueString()}
+		auth = sql.ConnectionAuthSql{Username: ap.SqlAuth.Username.ValueString(), Password: ap.SqlAuth.Password.ValueString()}
 	}
 
-	if pd.AzureAuth != nil {
-		if pd.AzureAuth.ClientId.IsUnknown() {
-			addComputedError("Azure AD Service Principal client_id cannot be a computed value")
+	if ap.AzureAuth != nil {
+		if ap.AzureAuth.Method.IsUnknown() {
+			addComputedError(diags, "Azure AD auth method cannot be a computed value")
+		}
+
+		if ap.AzureAuth.ClientId.IsUnknown() {
+			addComputedError(diags, "Azure AD Service Principal client_id cannot be a computed value")
+		}
+
+		if ap.AzureAuth.ClientSecret.IsUnknown() {
+			addComputedError(diags, "Azure AD Service Principal client_secret cannot be a computed value")
+		}
+
+		if ap.AzureAuth.TenantId.IsUnknown() {
+			addComputedError(diags, "Azure AD Service Principal tenant_id cannot be a computed value")
+		}
+
+		if ap.AzureAuth.ClientCertificatePath.IsUnknown() {
+			addComputedError(diags, "Azure AD Service Principal client_certificate_path cannot be a computed value")
+		}
+
+		if ap.AzureAuth.ClientCertificatePassword.IsUnknown() {
+			addComputedError(diags, "Azure AD Service Principal client_certificate_password cannot be a computed value")
 		}
 
-		if pd.AzureAuth.ClientSecret.IsUnknown() {
-			addComputedError("Azure AD Service Principal client_secret cannot be a computed value")
+		if ap.AzureAuth.OidcTokenFilePath.IsUnknown() {
+			addComputedError(diags, "Azure AD oidc_token_file_path cannot be a computed value")
 		}
 
-		if pd.AzureAuth.TenantId.IsUnknown() {
-			addComputedError("Azure AD Service Principal tenant_id cannot be a computed value")
+		if ap.AzureAuth.AccessToken.IsUnknown() {
+			addComputedError(diags, "Azure AD access_token cannot be a computed value")
 		}
 
 		connAuth := sql.ConnectionAuthAzure{
-			ClientId:     pd.AzureAuth.ClientId.ValueString(),
-			ClientSecret: pd.AzureAuth.ClientSecret.ValueString(),
-			TenantId:     pd.AzureAuth.TenantId.ValueString(),
+			Method:                    ap.AzureAuth.Method.ValueString(),
+			ClientId:                  ap.AzureAuth.ClientId.ValueString(),
+			ClientSecret:              ap.AzureAuth.ClientSecret.ValueString(),
+			TenantId:                  ap.AzureAuth.TenantId.ValueString(),
+			ClientCertificatePath:     ap.AzureAuth.ClientCertificatePath.ValueString(),
+			ClientCertificatePassword: ap.AzureAuth.ClientCertificatePassword.ValueString(),
+			OidcTokenFilePath:         ap.AzureAuth.OidcTokenFilePath.ValueString(),
+			AccessToken:               ap.AzureAuth.AccessToken.ValueString(),
 		}
 
 		if connAuth.ClientId == "" {
@@ -95,8 +367,75 @@ func (pd providerData) asConnectionDetails(context.Context) (sql.ConnectionDetai
 			connAuth.TenantId = os.Getenv("ARM_TENANT_ID")
 		}
 
-		connDetails.Auth = connAuth
+		if connAuth.ClientCertificatePath == "" {
+			connAuth.ClientCertificatePath = os.Getenv("ARM_CLIENT_CERTIFICATE_PATH")
+		}
+
+		if connAuth.ClientCertificatePassword == "" {
+			connAuth.ClientCertificatePassword = os.Getenv("ARM_CLIENT_CERTIFICATE_PASSWORD")
+		}
+
+		if connAuth.OidcTokenFilePath == "" {
+			connAuth.OidcTokenFilePath = os.Getenv("ARM_OIDC_TOKEN_FILE_PATH")
+		}
+
+		if connAuth.OidcTokenFilePath == "" {
+			connAuth.OidcTokenFilePath = os.Getenv("AZURE_FEDERATED_TOKEN_FILE")
+		}
+
+		auth = connAuth
 	}
 
-	return connDetails, diags
+	if ap.KerberosAuth != nil {
+		auth = sql.ConnectionAuthKerberos{
+			Krb5ConfigPath:      stringOrDefault(diags, ap.KerberosAuth.Krb5ConfigPath, "Kerberos krb5_config_path", os.Getenv("KRB5_CONFIG")),
+			KeytabPath:          strings.TrimPrefix(stringOrDefault(diags, ap.KerberosAuth.KeytabPath, "Kerberos keytab_path", os.Getenv("KRB5_KTNAME")), "FILE:"),
+			CredentialCachePath: strings.TrimPrefix(stringOrDefault(diags, ap.KerberosAuth.CredentialCachePath, "Kerberos credential_cache_path", os.Getenv("KRB5CCNAME")), "FILE:"),
+			Username:            stringOrDefault(diags, ap.KerberosAuth.Username, "Kerberos username"),
+			Realm:               stringOrDefault(diags, ap.KerberosAuth.Realm, "Kerberos realm"),
+			ServerSPN:           stringOrDefault(diags, ap.KerberosAuth.ServerSPN, "Kerberos server_spn"),
+		}
+	}
+
+	if ap.AccessToken.IsUnknown() {
+		addComputedError(diags, "Access token cannot be a computed value")
+	}
+
+	if !ap.AccessToken.IsNull() {
+		auth = sql.ConnectionAuthAccessToken{TokenProvider: sql.StaticAccessToken(ap.AccessToken.ValueString())}
+	}
+
+	return auth
+}
+func addComputedError(diags *diag.Diagnostics, summary string) {
+	diags.AddError(summary, "SQL connection details must be known during plan execution")
+}
+
+func stringOrDefault(diags *diag.Diagnostics, value types.String, attrName string, defaults ...string) string {
+	if value.IsUnknown() {
+		addComputedError(diags, fmt.Sprintf("%s cannot be a computed value", attrName))
+	}
+
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+
+	for _, d := range defaults {
+		if d != "" {
+			return d
+		}
+	}
+
+	return ""
+}
+
+// normalizeApplicationIntent returns the value in casing expected by the driver, or empty string if it is not supported.
+func normalizeApplicationIntent(intent string) string {
+	for _, i := range sql.ApplicationIntents {
+		if strings.EqualFold(intent, i) {
+			return i
+		}
+	}
+
+	return ""
 }
@@ -3,13 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"os"
 	"testing"
+	"time"
 )
 
 func TestProviderDataAsConnectionDetails(t *testing.T) {
@@ -78,6 +81,51 @@ func TestProviderDataAsConnectionDetails(t *testing.T) {
 			},
 			errSummary: "Azure AD Service Principal tenant_id cannot be a computed value",
 		},
+
+		"Encryption mode": {
+			pd: providerData{
+				Encryption: &encryption{
+					Mode:                   types.StringUnknown(),
+					Certificate:            types.StringNull(),
+					HostNameInCertificate:  types.StringNull(),
+					TrustServerCertificate: types.BoolNull(),
+				},
+			},
+			errSummary: "Encryption mode cannot be a computed value",
+		},
+
+		"Encryption certificate": {
+			pd: providerData{
+				Encryption: &encryption{
+					Mode:                   types.StringNull(),
+					Certificate:            types.StringUnknown(),
+					HostNameInCertificate:  types.StringNull(),
+					TrustServerCertificate: types.BoolNull(),
+				},
+			},
+			errSummary: "Encryption certificate cannot be a computed value",
+		},
+
+		"Instance name": {
+			pd: providerData{
+				InstanceName: types.StringUnknown(),
+			},
+			errSummary: "Instance name cannot be a computed value",
+		},
+
+		"Multi-subnet failover": {
+			pd: providerData{
+				MultiSubnetFailover: types.BoolUnknown(),
+			},
+			errSummary: "Multi-subnet failover cannot be a computed value",
+		},
+
+		"Connection string": {
+			pd: providerData{
+				ConnectionString: types.StringUnknown(),
+			},
+			errSummary: "Connection string cannot be a computed value",
+		},
 	}
 
 	for name, tc := range computedErrorCases {
@@ -237,4 +285,281 @@ func TestProviderDataAsConnectionDetails(t *testing.T) {
 		assert.Equal(t, "test_client_secret", azureAuth.ClientSecret, "client_secret")
 		assert.Equal(t, "test_tenant_id", azureAuth.TenantId, "tenant_id")
 	})
+
+	t.Run("Encryption", func(t *testing.T) {
+		pd := providerData{
+			Encryption: &encryption{
+				Mode:                   types.StringValue("strict"),
+				Certificate:            types.StringValue("/test/ca.pem"),
+				HostNameInCertificate:  types.StringValue("test.host"),
+				TrustServerCertificate: types.BoolNull(),
+			},
+		}
+
+		cd, _ := pd.asConnectionDetails(ctx)
+
+		require.NotNil(t, cd.Encryption, "Connection encryption not set")
+		assert.Equal(t, sql.ConnectionEncryption{Mode: "strict", Certificate: "/test/ca.pem", HostNameInCertificate: "test.host"}, *cd.Encryption)
+	})
+
+	t.Run("No encryption", func(t *testing.T) {
+		cd, _ := providerData{}.asConnectionDetails(ctx)
+
+		assert.Nil(t, cd.Encryption, "encryption")
+	})
+
+	t.Run("Instance and failover settings", func(t *testing.T) {
+		pd := providerData{
+			InstanceName:        types.StringValue("test_instance"),
+			FailoverPartner:     types.StringValue("test_partner"),
+			MultiSubnetFailover: types.BoolValue(true),
+			ApplicationIntent:   types.StringValue("readonly"),
+		}
+
+		cd, diags := pd.asConnectionDetails(ctx)
+
+		assert.False(t, diags.HasError(), "diagnostics")
+		assert.Equal(t, "test_instance", cd.Instance, "instance")
+		assert.Equal(t, "test_partner", cd.FailoverPartner, "failover partner")
+		assert.True(t, cd.MultiSubnetFailover, "multi-subnet failover")
+		assert.Equal(t, sql.ApplicationIntentReadOnly, cd.ApplicationIntent, "application intent")
+	})
+
+	t.Run("Instance and failover env variables", func(t *testing.T) {
+		env := map[string]string{
+			"MSSQL_INSTANCE_NAME":         "env_test_instance",
+			"MSSQL_FAILOVER_PARTNER":      "env_test_partner",
+			"MSSQL_MULTI_SUBNET_FAILOVER": "true",
+			"MSSQL_APPLICATION_INTENT":    "ReadWrite",
+		}
+		for n, v := range env {
+			os.Setenv(n, v)
+		}
+		defer func() {
+			for n := range env {
+				os.Unsetenv(n)
+			}
+		}()
+
+		cd, diags := providerData{InstanceName: types.StringValue("test_instance")}.asConnectionDetails(ctx)
+
+		assert.False(t, diags.HasError(), "diagnostics")
+		assert.Equal(t, "test_instance", cd.Instance, "instance")
+		assert.Equal(t, "env_test_partner", cd.FailoverPartner, "failover partner")
+		assert.True(t, cd.MultiSubnetFailover, "multi-subnet failover")
+		assert.Equal(t, sql.ApplicationIntentReadWrite, cd.ApplicationIntent, "application intent")
+	})
+
+	t.Run("Invalid application intent", func(t *testing.T) {
+		_, diags := providerData{ApplicationIntent: types.StringValue("test")}.asConnectionDetails(ctx)
+
+		assert.True(t, diags.HasError(), "diagnostics")
+	})
+
+	t.Run("Access token", func(t *testing.T) {
+		cd, _ := providerData{AccessToken: types.StringValue("test_token")}.asConnectionDetails(ctx)
+
+		tokenAuth, ok := cd.Auth.(sql.ConnectionAuthAccessToken)
+		require.True(t, ok, "Connection auth not set to access token")
+		token, err := tokenAuth.TokenProvider(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "test_token", token)
+	})
+
+	t.Run("Kerberos auth", func(t *testing.T) {
+		os.Setenv("KRB5CCNAME", "FILE:/tmp/env_test_cache")
+		defer os.Unsetenv("KRB5CCNAME")
+
+		pd := providerData{
+			KerberosAuth: &kerberosAuth{
+				Krb5ConfigPath: types.StringValue("/test/krb5.conf"),
+				Realm:          types.StringValue("TEST.LOCAL"),
+				ServerSPN:      types.StringValue("MSSQLSvc/sql.test.local:1433"),
+			},
+		}
+
+		cd, _ := pd.asConnectionDetails(ctx)
+
+		assert.Equal(t, sql.ConnectionAuthKerberos{
+			Krb5ConfigPath:      "/test/krb5.conf",
+			CredentialCachePath: "/tmp/env_test_cache",
+			Realm:               "TEST.LOCAL",
+			ServerSPN:           "MSSQLSvc/sql.test.local:1433",
+		}, cd.Auth)
+	})
+
+	t.Run("Connection string", func(t *testing.T) {
+		pd := providerData{
+			ConnectionString: types.StringValue("Server=tcp:cs_hostname,1444;Database=cs_db;User ID=cs_user;Password=cs_password;Encrypt=no;ApplicationIntent=ReadOnly"),
+		}
+
+		cd, diags := pd.asConnectionDetails(ctx)
+
+		assert.False(t, diags.HasError(), "diagnostics")
+		assert.Equal(t, "cs_hostname:1444", cd.Host, "host")
+		assert.Equal(t, "cs_db", cd.Database, "database")
+		assert.Equal(t, sql.ApplicationIntentReadOnly, cd.ApplicationIntent, "application intent")
+		assert.Equal(t, sql.ConnectionAuthSql{Username: "cs_user", Password: "cs_password"}, cd.Auth, "auth")
+		assert.Equal(t, &sql.ConnectionEncryption{Mode: sql.EncryptionFalse}, cd.Encryption, "encryption")
+	})
+
+	t.Run("Connection string and attributes", func(t *testing.T) {
+		os.Setenv("MSSQL_CONNECTION_STRING", "sqlserver://cs_user:cs_password@cs_hostname:1444?applicationintent=ReadOnly")
+		os.Setenv("MSSQL_HOSTNAME", "env_test_hostname")
+		defer os.Unsetenv("MSSQL_CONNECTION_STRING")
+		defer os.Unsetenv("MSSQL_HOSTNAME")
+
+		pd := providerData{
+			Hostname:          types.StringValue("test_hostname"),
+			ApplicationIntent: types.StringValue("ReadWrite"),
+			SqlAuth: &sqlAuth{
+				Username: types.StringValue("test_username"),
+				Password: types.StringValue("test_password"),
+			},
+		}
+
+		cd, diags := pd.asConnectionDetails(ctx)
+
+		assert.False(t, diags.HasError(), "diagnostics")
+		assert.Equal(t, "test_hostname:1444", cd.Host, "host")
+		assert.Equal(t, sql.ApplicationIntentReadWrite, cd.ApplicationIntent, "application intent")
+		assert.Equal(t, sql.ConnectionAuthSql{Username: "test_username", Password: "test_password"}, cd.Auth, "auth")
+	})
+
+	t.Run("Invalid connection string", func(t *testing.T) {
+		for _, connStr := range []string{"Server=cs_hostname;Password={cs_password", "Server=cs_hostname;Password=cs_pass;cs_word"} {
+			_, diags := providerData{ConnectionString: types.StringValue(connStr)}.asConnectionDetails(ctx)
+
+			require.True(t, diags.HasError(), "diagnostics")
+			for _, d := range diags {
+				assert.NotContains(t, d.Detail(), "cs_pass", "password should not be reported")
+				assert.NotContains(t, d.Detail(), "cs_word", "password fragment should not be reported")
+			}
+		}
+	})
+
+	t.Run("Default retry policy", func(t *testing.T) {
+		cd, _ := providerData{}.asConnectionDetails(ctx)
+
+		assert.Nil(t, cd.Retry, "retry")
+	})
+
+	t.Run("Retry policy", func(t *testing.T) {
+		pd := providerData{
+			Retry: &retry{
+				MaxAttempts:    types.Int64Value(10),
+				InitialBackoff: types.StringValue("500ms"),
+				MaxBackoff:     types.StringNull(),
+				ErrorCodes:     types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1205)}),
+			},
+		}
+
+		cd, diags := pd.asConnectionDetails(ctx)
+
+		assert.False(t, diags.HasError(), "diagnostics")
+		assert.Equal(t, &sql.RetryPolicy{
+			MaxAttempts:    10,
+			InitialBackoff: 500 * time.Millisecond,
+			MaxBackoff:     sql.DefaultRetryPolicy.MaxBackoff,
+			ErrorCodes:     []int32{1205},
+		}, cd.Retry)
+	})
+
+	t.Run("Invalid retry backoff", func(t *testing.T) {
+		pd := providerData{
+			Retry: &retry{InitialBackoff: types.StringValue("test")},
+		}
+
+		_, diags := pd.asConnectionDetails(ctx)
+
+		assert.True(t, diags.HasError(), "diagnostics")
+	})
+
+	t.Run("Timeouts and connection pool", func(t *testing.T) {
+		pd := providerData{
+			Timeouts: &timeouts{
+				Dial:    types.StringValue("5s"),
+				Connect: types.StringNull(),
+				Command: types.StringValue("2m"),
+			},
+			ConnectionPool: &connectionPool{
+				MaxOpenConnections:    types.Int64Value(10),
+				MaxIdleConnections:    types.Int64Null(),
+				ConnectionMaxLifetime: types.StringValue("30m"),
+				ConnectionMaxIdleTime: types.StringNull(),
+				DatabaseConnectionTTL: types.StringValue("1h"),
+			},
+		}
+
+		cd, diags := pd.asConnectionDetails(ctx)
+
+		assert.False(t, diags.HasError(), "diagnostics")
+		assert.Equal(t, 5*time.Second, cd.DialTimeout, "dial timeout")
+		assert.Zero(t, cd.ConnectTimeout, "connect timeout")
+		assert.Equal(t, 2*time.Minute, cd.CommandTimeout, "command timeout")
+		assert.Equal(t, sql.ConnectionPool{MaxOpenConns: 10, ConnMaxLifetime: 30 * time.Minute, DatabaseConnectionTTL: time.Hour}, cd.Pool, "pool")
+	})
+
+	t.Run("Unknown command timeout", func(t *testing.T) {
+		_, diags := providerData{Timeouts: &timeouts{Command: types.StringUnknown()}}.asConnectionDetails(ctx)
+
+		assert.True(t, diags.HasError(), "diagnostics")
+	})
+
+	t.Run("Wait for ready", func(t *testing.T) {
+		cd, _ := providerData{}.asConnectionDetails(ctx)
+		assert.Zero(t, cd.WaitForReady, "disabled")
+
+		cd, _ = providerData{WaitForReady: &waitForReady{Timeout: types.StringNull()}}.asConnectionDetails(ctx)
+		assert.Equal(t, 5*time.Minute, cd.WaitForReady, "default timeout")
+
+		cd, _ = providerData{WaitForReady: &waitForReady{Timeout: types.StringValue("90s")}}.asConnectionDetails(ctx)
+		assert.Equal(t, 90*time.Second, cd.WaitForReady, "timeout")
+	})
+}
+
+func TestProviderDataAsServerConnectionDetails(t *testing.T) {
+	ctx := context.Background()
+
+	pd := providerData{
+		Hostname:     types.StringValue("default.test.local"),
+		InstanceName: types.StringValue("INST1"),
+		SqlAuth:      &sqlAuth{Username: types.StringValue("default_user"), Password: types.StringValue("default_password")},
+		AuthProfiles: map[string]authProfile{
+			"fleet": {SqlAuth: &sqlAuth{Username: types.StringValue("fleet_user"), Password: types.StringValue("fleet_password")}},
+		},
+		Encryption: &encryption{Mode: types.StringValue("strict")},
+	}
+
+	t.Run("Provider auth", func(t *testing.T) {
+		cd, diags := pd.asServerConnectionDetails(ctx, core.Server{Hostname: "sql1.test.local"})
+
+		require.False(t, diags.HasError(), "diagnostics")
+		assert.Equal(t, "sql1.test.local", cd.Host, "host")
+		assert.Empty(t, cd.Instance, "instance")
+		assert.Equal(t, sql.ConnectionAuthSql{Username: "default_user", Password: "default_password"}, cd.Auth, "auth")
+		assert.Equal(t, sql.EncryptionStrict, cd.Encryption.Mode, "encryption")
+	})
+
+	t.Run("Auth profile", func(t *testing.T) {
+		cd, diags := pd.asServerConnectionDetails(ctx, core.Server{Hostname: "sql2.test.local", Port: 1444, Auth: "fleet"})
+
+		require.False(t, diags.HasError(), "diagnostics")
+		assert.Equal(t, "sql2.test.local:1444", cd.Host, "host")
+		assert.Equal(t, sql.ConnectionAuthSql{Username: "fleet_user", Password: "fleet_password"}, cd.Auth, "auth")
+	})
+
+	t.Run("Named instance", func(t *testing.T) {
+		cd, diags := pd.asServerConnectionDetails(ctx, core.Server{Hostname: "sql4.test.local", Instance: "test_instance"})
+
+		require.False(t, diags.HasError(), "diagnostics")
+		assert.Equal(t, "sql4.test.local", cd.Host, "host")
+		assert.Equal(t, "test_instance", cd.Instance, "instance")
+	})
+
+	t.Run("Unknown auth profile", func(t *testing.T) {
+		_, diags := pd.asServerConnectionDetails(ctx, core.Server{Hostname: "sql3.test.local", Auth: "missing"})
+
+		assert.True(t, diags.HasError(), "diagnostics")
+	})
 }
@@ -2,20 +2,50 @@ package provider
 
 import (
 	"github.com/PGSSoft/terraform-provider-mssql/internal/core"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/agentAlert"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/agentJob"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/agentOperator"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/agentSchedule"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/asymmetricKey"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/services/azureADServicePrincipal"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/services/azureADUser"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/certificate"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/columnEncryptionKey"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/columnMask"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/columnMasterKey"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/credential"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/services/database"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/databaseAuditSpecification"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/databaseEncryptionKey"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/services/databasePermission"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/databaseQueryStore"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/services/databaseRole"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/services/databaseRoleMember"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/databaseScopedConfiguration"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/databaseScopedCredential"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/eventSession"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/linkedServer"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/linkedServerLogin"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/masterKey"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/resourceGovernorClassifier"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/resourcePool"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/services/schema"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/services/schemaPermission"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/services/script"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/securityPolicy"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/sensitivityClassification"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/serverAudit"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/serverAuditSpecification"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/serverCertificate"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/serverConfiguration"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/services/serverPermission"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/services/serverRole"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/services/serverRoleMember"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/signature"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/services/sqlLogin"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/services/sqlUser"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/tablePermission"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/workloadGroup"
 )
 
 func Services() []core.Service {
@@ -24,9 +54,11 @@ func Services() []core.Service {
 		azureADUser.Service(),
 
 		database.Service(),
+		databaseEncryptionKey.Service(),
 		databasePermission.Service(),
 		databaseRole.Service(),
 		databaseRoleMember.Service(),
+		databaseScopedCredential.Service(),
 		sqlLogin.Service(),
 		sqlUser.Service(),
 		schema.Service(),
@@ -34,6 +66,34 @@ func Services() []core.Service {
 		serverRole.Service(),
 		serverRoleMember.Service(),
 		serverPermission.Service(),
+		credential.Service(),
+		masterKey.Service(),
+		serverCertificate.Service(),
+		certificate.Service(),
+		asymmetricKey.Service(),
+		signature.Service(),
+		columnMasterKey.Service(),
+		columnEncryptionKey.Service(),
+		securityPolicy.Service(),
+		columnMask.Service(),
+		tablePermission.Service(),
+		sensitivityClassification.Service(),
+		serverAudit.Service(),
+		serverAuditSpecification.Service(),
+		databaseAuditSpecification.Service(),
+		eventSession.Service(),
+		agentOperator.Service(),
+		agentSchedule.Service(),
+		agentJob.Service(),
+		agentAlert.Service(),
+		serverConfiguration.Service(),
+		databaseScopedConfiguration.Service(),
+		databaseQueryStore.Service(),
+		linkedServer.Service(),
+		linkedServerLogin.Service(),
+		resourcePool.Service(),
+		workloadGroup.Service(),
+		resourceGovernorClassifier.Service(),
 
 		script.Service(),
 	}
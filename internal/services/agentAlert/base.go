@@ -0,0 +1,114 @@
+package agentAlert
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+)
+
+var notificationMethods = []string{sql.AgentNotificationEmail, sql.AgentNotificationPager}
+
+var attrDescriptions = map[string]string{
+	"id":                      "SQL Agent alert ID. Can be retrieved using `SELECT id FROM msdb.dbo.sysalerts WHERE [name]='<alert_name>'`.",
+	"name":                    "Name of the alert. Cannot be longer than 128 chars.",
+	"enabled":                 "When `false`, the alert is not raised. Defaults to `true`.",
+	"message_id":              "Number of the error message raising the alert. Conflicts with `severity`.",
+	"severity":                "Severity level, from `1` to `25`, of errors raising the alert. Conflicts with `message_id`.",
+	"database_name":           "Name of the database in which the error must occur to raise the alert. When not set, errors in all databases raise the alert.",
+	"job_id":                  "ID of `mssql_agent_job` executed when the alert is raised.",
+	"delay_between_responses": "Number of seconds to wait between responses to the alert. Defaults to `0`.",
+	"notifications":           "Set of operators notified when the alert is raised.",
+	"operator_id":             "ID of notified `mssql_agent_operator`.",
+	"method":                  "Notification method. One of `EMAIL`, `PAGER`.",
+}
+
+type notificationData struct {
+	OperatorId types.String `tfsdk:"operator_id"`
+	Method     types.String `tfsdk:"method"`
+}
+
+type resourceData struct {
+	Id                    types.String       `tfsdk:"id"`
+	Name                  types.String       `tfsdk:"name"`
+	Enabled               types.Bool         `tfsdk:"enabled"`
+	MessageId             types.Int64        `tfsdk:"message_id"`
+	Severity              types.Int64        `tfsdk:"severity"`
+	DatabaseName          types.String       `tfsdk:"database_name"`
+	JobId                 types.String       `tfsdk:"job_id"`
+	DelayBetweenResponses types.Int64        `tfsdk:"delay_between_responses"`
+	Notifications         []notificationData `tfsdk:"notifications"`
+}
+
+func (d resourceData) toSettings(ctx context.Context) sql.AgentAlertSettings {
+	settings := sql.AgentAlertSettings{
+		Name:                  d.Name.ValueString(),
+		Enabled:               d.Enabled.ValueBool() || !common.IsAttrSet(d.Enabled),
+		MessageId:             int(d.MessageId.ValueInt64()),
+		Severity:              int(d.Severity.ValueInt64()),
+		DatabaseName:          d.DatabaseName.ValueString(),
+		JobId:                 sql.AgentJobId(d.JobId.ValueString()),
+		DelayBetweenResponses: int(d.DelayBetweenResponses.ValueInt64()),
+	}
+
+	for _, n := range d.Notifications {
+		operatorId, err := strconv.Atoi(n.OperatorId.ValueString())
+		utils.AddError(ctx, fmt.Sprintf("Failed to convert operator ID '%s'", n.OperatorId.ValueString()), err)
+
+		settings.Notifications = append(settings.Notifications, sql.AgentAlertNotification{
+			OperatorId: sql.AgentOperatorId(operatorId),
+			Method:     n.Method.ValueString(),
+		})
+	}
+
+	return settings
+}
+
+func (d resourceData) withSettings(settings sql.AgentAlertSettings) resourceData {
+	optionalInt := func(value int) types.Int64 {
+		if value == 0 {
+			return types.Int64Null()
+		}
+
+		return types.Int64Value(int64(value))
+	}
+
+	optionalString := func(value string) types.String {
+		if value == "" {
+			return types.StringNull()
+		}
+
+		return types.StringValue(value)
+	}
+
+	d.Name = types.StringValue(settings.Name)
+	d.Enabled = types.BoolValue(settings.Enabled)
+	d.MessageId = optionalInt(settings.MessageId)
+	d.Severity = optionalInt(settings.Severity)
+	d.DatabaseName = optionalString(settings.DatabaseName)
+	d.JobId = optionalString(string(settings.JobId))
+	d.DelayBetweenResponses = optionalInt(settings.DelayBetweenResponses)
+	d.Notifications = nil
+
+	for _, n := range settings.Notifications {
+		d.Notifications = append(d.Notifications, notificationData{
+			OperatorId: types.StringValue(fmt.Sprint(n.OperatorId)),
+			Method:     types.StringValue(n.Method),
+		})
+	}
+
+	return d
+}
+
+func (d resourceData) getId(ctx context.Context) sql.AgentAlertId {
+	if !common.IsAttrSet(d.Id) {
+		return 0
+	}
+
+	id, err := strconv.Atoi(d.Id.ValueString())
+	utils.AddError(ctx, fmt.Sprintf("Failed to convert resource ID '%s'", d.Id.ValueString()), err)
+	return sql.AgentAlertId(id)
+}
@@ -0,0 +1,34 @@
+package agentAlert
+
+import (
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	sdkdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	sdkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func Service() core.Service {
+	return service{}
+}
+
+type service struct{}
+
+func (s service) Name() string {
+	return "agent_alert"
+}
+
+func (s service) Resources() []func() sdkresource.ResourceWithConfigure {
+	return []func() sdkresource.ResourceWithConfigure{
+		resource.NewResource[resourceData](&res{}),
+	}
+}
+
+func (s service) DataSources() []func() sdkdatasource.DataSourceWithConfigure {
+	return []func() sdkdatasource.DataSourceWithConfigure{}
+}
+
+func (s service) Tests() core.AccTests {
+	return core.AccTests{
+		Resource: testResource,
+	}
+}
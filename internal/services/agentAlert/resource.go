@@ -0,0 +1,170 @@
+package agentAlert
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/planModifiers"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "agent_alert"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages SQL Server Agent alert, raised by errors of given number or severity.\n\n" +
+		"-> **Note** SQL Server Agent is not available in Azure SQL Database."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.AgentObjectNameValidators,
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: attrDescriptions["enabled"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				planModifiers.BoolDefault(true),
+			},
+		},
+		"message_id": schema.Int64Attribute{
+			MarkdownDescription: attrDescriptions["message_id"],
+			Optional:            true,
+		},
+		"severity": schema.Int64Attribute{
+			MarkdownDescription: attrDescriptions["severity"],
+			Optional:            true,
+		},
+		"database_name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["database_name"],
+			Optional:            true,
+		},
+		"job_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["job_id"],
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				planModifiers.IgnoreCase(),
+			},
+		},
+		"delay_between_responses": schema.Int64Attribute{
+			MarkdownDescription: attrDescriptions["delay_between_responses"],
+			Optional:            true,
+		},
+		"notifications": schema.SetNestedAttribute{
+			MarkdownDescription: attrDescriptions["notifications"],
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"operator_id": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["operator_id"],
+						Required:            true,
+					},
+					"method": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["method"],
+						Required:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var (
+		settings sql.AgentAlertSettings
+		alert    sql.AgentAlert
+	)
+
+	req.
+		Then(func() { settings = req.Plan.toSettings(ctx) }).
+		Then(func() { alert = sql.CreateAgentAlert(ctx, req.Conn, settings) }).
+		Then(func() {
+			resp.State = req.Plan.withSettings(alert.GetSettings(ctx))
+			resp.State.Id = types.StringValue(fmt.Sprint(alert.GetId(ctx)))
+		})
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		alert  sql.AgentAlert
+		exists bool
+	)
+
+	req.
+		Then(func() { alert = sql.GetAgentAlert(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { exists = alert.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withSettings(alert.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var (
+		settings sql.AgentAlertSettings
+		alert    sql.AgentAlert
+	)
+
+	req.
+		Then(func() { settings = req.Plan.toSettings(ctx) }).
+		Then(func() { alert = sql.GetAgentAlert(ctx, req.Conn, req.Plan.getId(ctx)) }).
+		Then(func() { alert.UpdateSettings(ctx, settings) }).
+		Then(func() { resp.State = req.Plan.withSettings(alert.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var alert sql.AgentAlert
+
+	req.
+		Then(func() { alert = sql.GetAgentAlert(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { alert.Drop(ctx) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	if req.Config.MessageId.IsUnknown() || req.Config.Severity.IsUnknown() {
+		return
+	}
+
+	if req.Config.MessageId.IsNull() == req.Config.Severity.IsNull() {
+		utils.AddAttributeError(ctx, path.Root("severity"), "Invalid alert condition", "Exactly one of message_id and severity must be set")
+	}
+
+	if common.IsAttrSet(req.Config.Severity) && (req.Config.Severity.ValueInt64() < 1 || req.Config.Severity.ValueInt64() > 25) {
+		utils.AddAttributeError(ctx, path.Root("severity"), "Invalid severity", "Severity must be between 1 and 25")
+	}
+
+	isOneOf := func(value string, allowed []string) bool {
+		for _, a := range allowed {
+			if value == a {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, n := range req.Config.Notifications {
+		if common.IsAttrSet(n.Method) && !isOneOf(n.Method.ValueString(), notificationMethods) {
+			utils.AddAttributeError(ctx, path.Root("notifications"), "Invalid notification method", fmt.Sprintf("Notification method %q is not supported", n.Method.ValueString()))
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package agentAlert
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	if testCtx.IsAzureTest {
+		return
+	}
+
+	newResource := func(name string, severity int, enabled bool, method string) string {
+		return fmt.Sprintf(`
+resource "mssql_agent_operator" "test" {
+	name          = "test_alert_operator"
+	email_address = "dba@example.com"
+	pager_address = "dba-pager@example.com"
+}
+
+resource "mssql_agent_alert" "test" {
+	name     = %q
+	severity = %d
+	enabled  = %t
+
+	notifications = [{
+		operator_id = mssql_agent_operator.test.id
+		method      = %q
+	}]
+}
+`, name, severity, enabled, method)
+	}
+
+	var alertId string
+
+	fetchAlert := func(conn *sql.DB, name string) (string, int, bool, int, error) {
+		var (
+			id                string
+			severity, methods int
+			enabled           bool
+		)
+		err := conn.QueryRow(`SELECT a.[id], a.[severity], a.[enabled], ISNULL(n.[notification_method], 0)
+			FROM msdb.dbo.sysalerts a LEFT JOIN msdb.dbo.sysnotifications n ON n.[alert_id] = a.[id]
+			WHERE a.[name]=@p1`, name).Scan(&id, &severity, &enabled, &methods)
+		return id, severity, enabled, methods, err
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("test_alert", 17, true, "EMAIL"),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						id, severity, enabled, methods, err := fetchAlert(conn, "test_alert")
+						alertId = id
+
+						testCtx.Assert.Equal(17, severity, "severity")
+						testCtx.Assert.True(enabled, "enabled")
+						testCtx.Assert.Equal(1, methods, "notification_method")
+
+						return err
+					}),
+					resource.TestCheckResourceAttrPtr("mssql_agent_alert.test", "id", &alertId),
+				),
+			},
+			{
+				Config: newResource("test_alert_renamed", 19, false, "PAGER"),
+				Check: testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+					id, severity, enabled, methods, err := fetchAlert(conn, "test_alert_renamed")
+
+					testCtx.Assert.Equal(alertId, id, "alert should not be recreated")
+					testCtx.Assert.Equal(19, severity, "severity")
+					testCtx.Assert.False(enabled, "enabled")
+					testCtx.Assert.Equal(2, methods, "notification_method")
+
+					return err
+				}),
+			},
+			{
+				ResourceName:      "mssql_agent_alert.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,174 @@
+package agentJob
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+)
+
+const defaultStepDatabase = "master"
+
+var stepActions = []string{sql.AgentJobStepQuitWithSuccess, sql.AgentJobStepQuitWithFailure, sql.AgentJobStepGoToNextStep, sql.AgentJobStepGoToStep}
+
+var attrDescriptions = map[string]string{
+	"id":               "SQL Agent job ID. Can be retrieved using `SELECT job_id FROM msdb.dbo.sysjobs WHERE [name]='<job_name>'`.",
+	"name":             "Name of the job. Cannot be longer than 128 chars.",
+	"description":      "Description of the job.",
+	"enabled":          "When `false`, the job is not started by its schedules. Defaults to `true`.",
+	"owner_login_name": "Name of the login owning the job. Defaults to the login used by the provider.",
+	"steps":            "Ordered list of T-SQL steps executed by the job. Steps are numbered starting from `1`.",
+	"step_name":        "Name of the step. Cannot be longer than 128 chars.",
+	"command":          "T-SQL command executed by the step.",
+	"database_name":    "Name of the database in which the command is executed. Defaults to `master`.",
+	"on_success_action": "Action taken when the step succeeds. One of `QUIT_WITH_SUCCESS`, `QUIT_WITH_FAILURE`, `GO_TO_NEXT_STEP`, `GO_TO_STEP`. " +
+		"Defaults to `GO_TO_NEXT_STEP`, or `QUIT_WITH_SUCCESS` for the last step.",
+	"on_success_step_id": "Number of the step executed when the step succeeds. Required when `on_success_action` is `GO_TO_STEP`.",
+	"on_failure_action": "Action taken when the step fails. One of `QUIT_WITH_SUCCESS`, `QUIT_WITH_FAILURE`, `GO_TO_NEXT_STEP`, `GO_TO_STEP`. " +
+		"Defaults to `QUIT_WITH_FAILURE`.",
+	"on_failure_step_id": "Number of the step executed when the step fails. Required when `on_failure_action` is `GO_TO_STEP`.",
+	"retry_attempts":     "Number of retries of failed step. Defaults to `0`.",
+	"retry_interval":     "Number of minutes between retries of failed step. Defaults to `0`.",
+	"schedule_ids":       "Set of IDs of `mssql_agent_schedule` starting the job.",
+}
+
+type stepData struct {
+	Name            types.String `tfsdk:"name"`
+	Command         types.String `tfsdk:"command"`
+	DatabaseName    types.String `tfsdk:"database_name"`
+	OnSuccessAction types.String `tfsdk:"on_success_action"`
+	OnSuccessStepId types.Int64  `tfsdk:"on_success_step_id"`
+	OnFailureAction types.String `tfsdk:"on_failure_action"`
+	OnFailureStepId types.Int64  `tfsdk:"on_failure_step_id"`
+	RetryAttempts   types.Int64  `tfsdk:"retry_attempts"`
+	RetryInterval   types.Int64  `tfsdk:"retry_interval"`
+}
+
+func (d stepData) toStep(isLast bool) sql.AgentJobStep {
+	step := sql.AgentJobStep{
+		Name:            d.Name.ValueString(),
+		Command:         d.Command.ValueString(),
+		DatabaseName:    defaultStepDatabase,
+		OnSuccessAction: sql.AgentJobStepGoToNextStep,
+		OnSuccessStepId: int(d.OnSuccessStepId.ValueInt64()),
+		OnFailureAction: sql.AgentJobStepQuitWithFailure,
+		OnFailureStepId: int(d.OnFailureStepId.ValueInt64()),
+		RetryAttempts:   int(d.RetryAttempts.ValueInt64()),
+		RetryInterval:   int(d.RetryInterval.ValueInt64()),
+	}
+
+	if isLast {
+		step.OnSuccessAction = sql.AgentJobStepQuitWithSuccess
+	}
+
+	if common.IsAttrSet(d.DatabaseName) {
+		step.DatabaseName = d.DatabaseName.ValueString()
+	}
+
+	if common.IsAttrSet(d.OnSuccessAction) {
+		step.OnSuccessAction = d.OnSuccessAction.ValueString()
+	}
+
+	if common.IsAttrSet(d.OnFailureAction) {
+		step.OnFailureAction = d.OnFailureAction.ValueString()
+	}
+
+	return step
+}
+
+// withStep keeps attributes not set in configuration as null, as long as their values match the defaults.
+func (d stepData) withStep(step sql.AgentJobStep, isLast bool) stepData {
+	defaults := stepData{}.toStep(isLast)
+
+	optionalString := func(configured types.String, value string, def string) types.String {
+		if configured.IsNull() && value == def {
+			return types.StringNull()
+		}
+
+		return types.StringValue(value)
+	}
+
+	optionalInt := func(configured types.Int64, value int) types.Int64 {
+		if configured.IsNull() && value == 0 {
+			return types.Int64Null()
+		}
+
+		return types.Int64Value(int64(value))
+	}
+
+	d.Name = types.StringValue(step.Name)
+	d.Command = types.StringValue(step.Command)
+	d.DatabaseName = optionalString(d.DatabaseName, step.DatabaseName, defaults.DatabaseName)
+	d.OnSuccessAction = optionalString(d.OnSuccessAction, step.OnSuccessAction, defaults.OnSuccessAction)
+	d.OnSuccessStepId = optionalInt(d.OnSuccessStepId, step.OnSuccessStepId)
+	d.OnFailureAction = optionalString(d.OnFailureAction, step.OnFailureAction, defaults.OnFailureAction)
+	d.OnFailureStepId = optionalInt(d.OnFailureStepId, step.OnFailureStepId)
+	d.RetryAttempts = optionalInt(d.RetryAttempts, step.RetryAttempts)
+	d.RetryInterval = optionalInt(d.RetryInterval, step.RetryInterval)
+	return d
+}
+
+type resourceData struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	OwnerLoginName types.String `tfsdk:"owner_login_name"`
+	Steps          []stepData   `tfsdk:"steps"`
+	ScheduleIds    []string     `tfsdk:"schedule_ids"`
+}
+
+func (d resourceData) toSettings(ctx context.Context) sql.AgentJobSettings {
+	settings := sql.AgentJobSettings{
+		Name:           d.Name.ValueString(),
+		Description:    d.Description.ValueString(),
+		Enabled:        d.Enabled.ValueBool() || !common.IsAttrSet(d.Enabled),
+		OwnerLoginName: d.OwnerLoginName.ValueString(),
+	}
+
+	for i, step := range d.Steps {
+		settings.Steps = append(settings.Steps, step.toStep(i == len(d.Steps)-1))
+	}
+
+	for _, idString := range d.ScheduleIds {
+		id, err := strconv.Atoi(idString)
+		utils.AddError(ctx, fmt.Sprintf("Failed to convert schedule ID '%s'", idString), err)
+		settings.ScheduleIds = append(settings.ScheduleIds, sql.AgentScheduleId(id))
+	}
+
+	return settings
+}
+
+func (d resourceData) withSettings(settings sql.AgentJobSettings) resourceData {
+	configuredSteps := d.Steps
+
+	d.Name = types.StringValue(settings.Name)
+	d.Enabled = types.BoolValue(settings.Enabled)
+	d.OwnerLoginName = types.StringValue(settings.OwnerLoginName)
+	d.Steps = nil
+	d.ScheduleIds = nil
+
+	if settings.Description == "" {
+		d.Description = types.StringNull()
+	} else {
+		d.Description = types.StringValue(settings.Description)
+	}
+
+	for i, step := range settings.Steps {
+		var conf stepData
+		if i < len(configuredSteps) {
+			conf = configuredSteps[i]
+		}
+
+		d.Steps = append(d.Steps, conf.withStep(step, i == len(settings.Steps)-1))
+	}
+
+	for _, id := range settings.ScheduleIds {
+		d.ScheduleIds = append(d.ScheduleIds, fmt.Sprint(id))
+	}
+
+	return d
+}
@@ -0,0 +1,206 @@
+package agentJob
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/planModifiers"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "agent_job"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages SQL Server Agent job consisting of T-SQL steps. Job is targeted at the local server.\n\n" +
+		"-> **Note** SQL Server Agent is not available in Azure SQL Database."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.AgentObjectNameValidators,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["description"],
+			Optional:            true,
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: attrDescriptions["enabled"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				planModifiers.BoolDefault(true),
+			},
+		},
+		"owner_login_name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["owner_login_name"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"steps": schema.ListNestedAttribute{
+			MarkdownDescription: attrDescriptions["steps"],
+			Required:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["step_name"],
+						Required:            true,
+						Validators:          validators.AgentObjectNameValidators,
+					},
+					"command": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["command"],
+						Required:            true,
+					},
+					"database_name": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["database_name"],
+						Optional:            true,
+					},
+					"on_success_action": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["on_success_action"],
+						Optional:            true,
+					},
+					"on_success_step_id": schema.Int64Attribute{
+						MarkdownDescription: attrDescriptions["on_success_step_id"],
+						Optional:            true,
+					},
+					"on_failure_action": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["on_failure_action"],
+						Optional:            true,
+					},
+					"on_failure_step_id": schema.Int64Attribute{
+						MarkdownDescription: attrDescriptions["on_failure_step_id"],
+						Optional:            true,
+					},
+					"retry_attempts": schema.Int64Attribute{
+						MarkdownDescription: attrDescriptions["retry_attempts"],
+						Optional:            true,
+					},
+					"retry_interval": schema.Int64Attribute{
+						MarkdownDescription: attrDescriptions["retry_interval"],
+						Optional:            true,
+					},
+				},
+			},
+		},
+		"schedule_ids": schema.SetAttribute{
+			MarkdownDescription: attrDescriptions["schedule_ids"],
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var (
+		settings sql.AgentJobSettings
+		job      sql.AgentJob
+	)
+
+	req.
+		Then(func() { settings = req.Plan.toSettings(ctx) }).
+		Then(func() { job = sql.CreateAgentJob(ctx, req.Conn, settings) }).
+		Then(func() {
+			resp.State = req.Plan.withSettings(job.GetSettings(ctx))
+			resp.State.Id = types.StringValue(fmt.Sprint(job.GetId(ctx)))
+		})
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		job    sql.AgentJob
+		exists bool
+	)
+
+	req.
+		Then(func() { job = sql.GetAgentJob(ctx, req.Conn, sql.AgentJobId(req.State.Id.ValueString())) }).
+		Then(func() { exists = job.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withSettings(job.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var (
+		settings sql.AgentJobSettings
+		job      sql.AgentJob
+	)
+
+	req.
+		Then(func() { settings = req.Plan.toSettings(ctx) }).
+		Then(func() { job = sql.GetAgentJob(ctx, req.Conn, sql.AgentJobId(req.Plan.Id.ValueString())) }).
+		Then(func() { job.UpdateSettings(ctx, settings) }).
+		Then(func() { resp.State = req.Plan.withSettings(job.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var job sql.AgentJob
+
+	req.
+		Then(func() { job = sql.GetAgentJob(ctx, req.Conn, sql.AgentJobId(req.State.Id.ValueString())) }).
+		Then(func() { job.Drop(ctx) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	isOneOf := func(value string, allowed []string) bool {
+		for _, a := range allowed {
+			if value == a {
+				return true
+			}
+		}
+		return false
+	}
+
+	validateFlow := func(stepIdx int, attrName string, action types.String, stepId types.Int64) {
+		attrPath := path.Root("steps").AtListIndex(stepIdx).AtName(attrName)
+
+		if !common.IsAttrSet(action) {
+			if common.IsAttrSet(stepId) {
+				utils.AddAttributeError(ctx, attrPath, "Invalid step flow", "Step ID can be set only with GO_TO_STEP action")
+			}
+			return
+		}
+
+		if !isOneOf(action.ValueString(), stepActions) {
+			utils.AddAttributeError(ctx, attrPath, "Invalid step action", fmt.Sprintf("Action %q is not supported", action.ValueString()))
+			return
+		}
+
+		isGoToStep := action.ValueString() == sql.AgentJobStepGoToStep
+		switch {
+		case isGoToStep && stepId.IsNull():
+			utils.AddAttributeError(ctx, attrPath, "Invalid step flow", "Step ID is required with GO_TO_STEP action")
+		case !isGoToStep && common.IsAttrSet(stepId):
+			utils.AddAttributeError(ctx, attrPath, "Invalid step flow", "Step ID can be set only with GO_TO_STEP action")
+		case common.IsAttrSet(stepId) && (stepId.ValueInt64() < 1 || stepId.ValueInt64() > int64(len(req.Config.Steps)) || stepId.ValueInt64() == int64(stepIdx+1)):
+			utils.AddAttributeError(ctx, attrPath, "Invalid step flow", fmt.Sprintf("Step ID must refer to another step of the job, between 1 and %d", len(req.Config.Steps)))
+		}
+	}
+
+	for i, step := range req.Config.Steps {
+		validateFlow(i, "on_success_action", step.OnSuccessAction, step.OnSuccessStepId)
+		validateFlow(i, "on_failure_action", step.OnFailureAction, step.OnFailureStepId)
+	}
+}
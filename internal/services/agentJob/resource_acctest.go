@@ -0,0 +1,143 @@
+package agentJob
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"regexp"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	if testCtx.IsAzureTest {
+		return
+	}
+
+	newResource := func(steps string, withSchedule bool) string {
+		schedules := ""
+		if withSchedule {
+			schedules = "schedule_ids = [mssql_agent_schedule.test.id]"
+		}
+
+		return fmt.Sprintf(`
+resource "mssql_agent_schedule" "test" {
+	name = "test_job_schedule"
+	frequency_type = "DAILY"
+}
+
+resource "mssql_agent_job" "test" {
+	name = "test_job"
+	description = "Test job"
+	steps = [%s]
+	%s
+}
+`, steps, schedules)
+	}
+
+	const (
+		checkStep = `{
+		name = "check"
+		command = "SELECT 1"
+		on_failure_action = "GO_TO_STEP"
+		on_failure_step_id = 2
+	}`
+		cleanupStep = `{
+		name = "cleanup"
+		command = "SELECT 2"
+		database_name = "tempdb"
+		retry_attempts = 3
+		retry_interval = 1
+	}`
+	)
+
+	type stepRow struct {
+		name, command, database string
+		onSuccess, onFail       int
+	}
+
+	fetchSteps := func(conn *sql.DB) ([]stepRow, error) {
+		var steps []stepRow
+
+		rows, err := conn.Query(`
+SELECT s.[step_name], s.[command], s.[database_name], s.[on_success_action], s.[on_fail_action]
+FROM msdb.dbo.sysjobsteps s
+INNER JOIN msdb.dbo.sysjobs j ON j.[job_id] = s.[job_id]
+WHERE j.[name]='test_job'
+ORDER BY s.[step_id]`)
+		if err != nil {
+			return steps, err
+		}
+
+		for rows.Next() {
+			var step stepRow
+			if err := rows.Scan(&step.name, &step.command, &step.database, &step.onSuccess, &step.onFail); err != nil {
+				return steps, err
+			}
+			steps = append(steps, step)
+		}
+
+		return steps, rows.Err()
+	}
+
+	var jobId string
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource(checkStep+", "+cleanupStep, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						var schedules int
+						err := conn.QueryRow(`
+SELECT CONVERT(NVARCHAR(36), j.[job_id]), (SELECT COUNT(*) FROM msdb.dbo.sysjobschedules s WHERE s.[job_id] = j.[job_id])
+FROM msdb.dbo.sysjobs j WHERE j.[name]='test_job'`).Scan(&jobId, &schedules)
+
+						testCtx.Assert.Equal(1, schedules, "schedules count")
+
+						return err
+					}),
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						steps, err := fetchSteps(conn)
+
+						testCtx.Assert.Equal([]stepRow{
+							{name: "check", command: "SELECT 1", database: "master", onSuccess: 3, onFail: 4},
+							{name: "cleanup", command: "SELECT 2", database: "tempdb", onSuccess: 1, onFail: 2},
+						}, steps)
+
+						return err
+					}),
+					resource.TestCheckResourceAttrPtr("mssql_agent_job.test", "id", &jobId),
+					resource.TestCheckResourceAttrSet("mssql_agent_job.test", "owner_login_name"),
+				),
+			},
+			{
+				Config: newResource(`{ name = "check", command = "SELECT 1" }`, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						var schedules int
+						err := conn.QueryRow("SELECT COUNT(*) FROM msdb.dbo.sysjobschedules WHERE [job_id]=@p1", jobId).Scan(&schedules)
+						testCtx.Assert.Equal(0, schedules, "schedules count")
+						return err
+					}),
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						steps, err := fetchSteps(conn)
+						testCtx.Assert.Len(steps, 1, "steps count")
+						return err
+					}),
+				),
+			},
+			{
+				Config:      newResource(`{ name = "invalid", command = "SELECT 1", on_success_action = "GO_TO_STEP" }`, false),
+				ExpectError: regexp.MustCompile("Step ID is required"),
+			},
+			{
+				Config: newResource(checkStep+", "+cleanupStep, false),
+			},
+			{
+				ResourceName:      "mssql_agent_job.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,62 @@
+package agentOperator
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+)
+
+var attrDescriptions = map[string]string{
+	"id":            "SQL Agent operator ID. Can be retrieved using `SELECT id FROM msdb.dbo.sysoperators WHERE [name]='<operator_name>'`.",
+	"name":          "Name of the operator. Cannot be longer than 128 chars.",
+	"enabled":       "When `false`, the operator does not receive notifications. Defaults to `true`.",
+	"email_address": "E-mail address used to notify the operator using Database Mail.",
+	"pager_address": "Pager address used to notify the operator.",
+}
+
+type resourceData struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	EmailAddress types.String `tfsdk:"email_address"`
+	PagerAddress types.String `tfsdk:"pager_address"`
+}
+
+func (d resourceData) toSettings() sql.AgentOperatorSettings {
+	return sql.AgentOperatorSettings{
+		Name:         d.Name.ValueString(),
+		Enabled:      d.Enabled.ValueBool() || !common.IsAttrSet(d.Enabled),
+		EmailAddress: d.EmailAddress.ValueString(),
+		PagerAddress: d.PagerAddress.ValueString(),
+	}
+}
+
+func (d resourceData) withSettings(settings sql.AgentOperatorSettings) resourceData {
+	optionalString := func(value string) types.String {
+		if value == "" {
+			return types.StringNull()
+		}
+
+		return types.StringValue(value)
+	}
+
+	d.Name = types.StringValue(settings.Name)
+	d.Enabled = types.BoolValue(settings.Enabled)
+	d.EmailAddress = optionalString(settings.EmailAddress)
+	d.PagerAddress = optionalString(settings.PagerAddress)
+	return d
+}
+
+func (d resourceData) getId(ctx context.Context) sql.AgentOperatorId {
+	if !common.IsAttrSet(d.Id) {
+		return 0
+	}
+
+	id, err := strconv.Atoi(d.Id.ValueString())
+	utils.AddError(ctx, fmt.Sprintf("Failed to convert resource ID '%s'", d.Id.ValueString()), err)
+	return sql.AgentOperatorId(id)
+}
@@ -0,0 +1,99 @@
+package agentOperator
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/planModifiers"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "agent_operator"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages SQL Server Agent operator, notified about job completion and alerts.\n\n" +
+		"-> **Note** SQL Server Agent is not available in Azure SQL Database."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.AgentObjectNameValidators,
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: attrDescriptions["enabled"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				planModifiers.BoolDefault(true),
+			},
+		},
+		"email_address": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["email_address"],
+			Optional:            true,
+		},
+		"pager_address": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["pager_address"],
+			Optional:            true,
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var operator sql.AgentOperator
+
+	req.
+		Then(func() { operator = sql.CreateAgentOperator(ctx, req.Conn, req.Plan.toSettings()) }).
+		Then(func() {
+			resp.State = req.Plan.withSettings(operator.GetSettings(ctx))
+			resp.State.Id = types.StringValue(fmt.Sprint(operator.GetId(ctx)))
+		})
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		operator sql.AgentOperator
+		exists   bool
+	)
+
+	req.
+		Then(func() { operator = sql.GetAgentOperator(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { exists = operator.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withSettings(operator.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var operator sql.AgentOperator
+
+	req.
+		Then(func() { operator = sql.GetAgentOperator(ctx, req.Conn, req.Plan.getId(ctx)) }).
+		Then(func() { operator.UpdateSettings(ctx, req.Plan.toSettings()) }).
+		Then(func() { resp.State = req.Plan.withSettings(operator.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var operator sql.AgentOperator
+
+	req.
+		Then(func() { operator = sql.GetAgentOperator(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { operator.Drop(ctx) })
+}
@@ -0,0 +1,72 @@
+package agentOperator
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	if testCtx.IsAzureTest {
+		return
+	}
+
+	newResource := func(name string, email string, enabled bool) string {
+		return fmt.Sprintf(`
+resource "mssql_agent_operator" "test" {
+	name = %q
+	email_address = %q
+	enabled = %t
+}
+`, name, email, enabled)
+	}
+
+	var operatorId string
+
+	fetchOperator := func(conn *sql.DB, name string) (string, string, bool, error) {
+		var (
+			id, email string
+			enabled   bool
+		)
+		err := conn.QueryRow("SELECT [id], [email_address], [enabled] FROM msdb.dbo.sysoperators WHERE [name]=@p1", name).Scan(&id, &email, &enabled)
+		return id, email, enabled, err
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("test_operator", "dba@example.com", true),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						id, email, enabled, err := fetchOperator(conn, "test_operator")
+						operatorId = id
+
+						testCtx.Assert.Equal("dba@example.com", email, "email_address")
+						testCtx.Assert.True(enabled, "enabled")
+
+						return err
+					}),
+					resource.TestCheckResourceAttrPtr("mssql_agent_operator.test", "id", &operatorId),
+				),
+			},
+			{
+				Config: newResource("test_operator_renamed", "team@example.com", false),
+				Check: testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+					id, email, enabled, err := fetchOperator(conn, "test_operator_renamed")
+
+					testCtx.Assert.Equal(operatorId, id, "operator should not be recreated")
+					testCtx.Assert.Equal("team@example.com", email, "email_address")
+					testCtx.Assert.False(enabled, "enabled")
+
+					return err
+				}),
+			},
+			{
+				ResourceName:      "mssql_agent_operator.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,119 @@
+package agentSchedule
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+)
+
+var frequencyTypes = []string{"ONCE", "DAILY", "WEEKLY", "MONTHLY", "MONTHLY_RELATIVE", "AGENT_START", "IDLE"}
+
+var subdayTypes = []string{"ONCE", "SECONDS", "MINUTES", "HOURS"}
+
+var attrDescriptions = map[string]string{
+	"id":                 "SQL Agent schedule ID. Can be retrieved using `SELECT schedule_id FROM msdb.dbo.sysschedules WHERE [name]='<schedule_name>'`.",
+	"name":               "Name of the schedule. Cannot be longer than 128 chars.",
+	"enabled":            "When `false`, jobs are not started by the schedule. Defaults to `true`.",
+	"frequency_type":     "How often jobs are started. One of `ONCE`, `DAILY`, `WEEKLY`, `MONTHLY`, `MONTHLY_RELATIVE`, `AGENT_START`, `IDLE`.",
+	"frequency_interval": "Days on which jobs are started, interpreted depending on `frequency_type`, e.g. every N days for `DAILY` or bitmask of week days for `WEEKLY`. Defaults to `1`.",
+	"frequency_subday_type": "Units of `frequency_subday_interval`. One of `ONCE`, `SECONDS`, `MINUTES`, `HOURS`. " +
+		"Defaults to `ONCE` for `DAILY`, `WEEKLY`, `MONTHLY` and `MONTHLY_RELATIVE` schedules.",
+	"frequency_subday_interval":   "Number of `frequency_subday_type` periods between job executions within a day.",
+	"frequency_relative_interval": "Occurrence of the `frequency_interval` day in each month, used by `MONTHLY_RELATIVE` schedules, e.g. `1` for the first or `16` for the last.",
+	"frequency_recurrence_factor": "Number of weeks or months between job executions of `WEEKLY`, `MONTHLY` and `MONTHLY_RELATIVE` schedules. Defaults to `1` for these schedules.",
+	"active_start_date":           "Date when schedule starts, formatted as `YYYYMMDD`. Defaults to the date of creation.",
+	"active_end_date":             "Date when schedule ends, formatted as `YYYYMMDD`. Defaults to `99991231`.",
+	"active_start_time":           "Time of day when jobs start, formatted as `HHMMSS`. Defaults to `0`.",
+	"active_end_time":             "Time of day after which jobs are no longer started, formatted as `HHMMSS`. Defaults to `235959`.",
+}
+
+type resourceData struct {
+	Id                        types.String `tfsdk:"id"`
+	Name                      types.String `tfsdk:"name"`
+	Enabled                   types.Bool   `tfsdk:"enabled"`
+	FrequencyType             types.String `tfsdk:"frequency_type"`
+	FrequencyInterval         types.Int64  `tfsdk:"frequency_interval"`
+	FrequencySubdayType       types.String `tfsdk:"frequency_subday_type"`
+	FrequencySubdayInterval   types.Int64  `tfsdk:"frequency_subday_interval"`
+	FrequencyRelativeInterval types.Int64  `tfsdk:"frequency_relative_interval"`
+	FrequencyRecurrenceFactor types.Int64  `tfsdk:"frequency_recurrence_factor"`
+	ActiveStartDate           types.Int64  `tfsdk:"active_start_date"`
+	ActiveEndDate             types.Int64  `tfsdk:"active_end_date"`
+	ActiveStartTime           types.Int64  `tfsdk:"active_start_time"`
+	ActiveEndTime             types.Int64  `tfsdk:"active_end_time"`
+}
+
+func (d resourceData) toSettings() sql.AgentScheduleSettings {
+	intOrDefault := func(value types.Int64, def int) int {
+		if common.IsAttrSet(value) {
+			return int(value.ValueInt64())
+		}
+
+		return def
+	}
+
+	freqType := d.FrequencyType.ValueString()
+	isRecurring := freqType == "DAILY" || freqType == "WEEKLY" || freqType == "MONTHLY" || freqType == "MONTHLY_RELATIVE"
+	isMultiDay := freqType == "WEEKLY" || freqType == "MONTHLY" || freqType == "MONTHLY_RELATIVE"
+
+	settings := sql.AgentScheduleSettings{
+		Name:                      d.Name.ValueString(),
+		Enabled:                   d.Enabled.ValueBool() || !common.IsAttrSet(d.Enabled),
+		FrequencyType:             freqType,
+		FrequencyInterval:         intOrDefault(d.FrequencyInterval, 1),
+		FrequencySubdayType:       d.FrequencySubdayType.ValueString(),
+		FrequencySubdayInterval:   intOrDefault(d.FrequencySubdayInterval, 0),
+		FrequencyRelativeInterval: intOrDefault(d.FrequencyRelativeInterval, 0),
+		FrequencyRecurrenceFactor: intOrDefault(d.FrequencyRecurrenceFactor, 0),
+		ActiveStartDate:           intOrDefault(d.ActiveStartDate, 0),
+		ActiveEndDate:             intOrDefault(d.ActiveEndDate, 99991231),
+		ActiveStartTime:           intOrDefault(d.ActiveStartTime, 0),
+		ActiveEndTime:             intOrDefault(d.ActiveEndTime, 235959),
+	}
+
+	if !common.IsAttrSet(d.FrequencySubdayType) && isRecurring {
+		settings.FrequencySubdayType = "ONCE"
+	}
+
+	if !common.IsAttrSet(d.FrequencyRecurrenceFactor) && isMultiDay {
+		settings.FrequencyRecurrenceFactor = 1
+	}
+
+	return settings
+}
+
+func (d resourceData) withSettings(settings sql.AgentScheduleSettings) resourceData {
+	d.Name = types.StringValue(settings.Name)
+	d.Enabled = types.BoolValue(settings.Enabled)
+	d.FrequencyType = types.StringValue(settings.FrequencyType)
+	d.FrequencyInterval = types.Int64Value(int64(settings.FrequencyInterval))
+	d.FrequencySubdayInterval = types.Int64Value(int64(settings.FrequencySubdayInterval))
+	d.FrequencyRelativeInterval = types.Int64Value(int64(settings.FrequencyRelativeInterval))
+	d.FrequencyRecurrenceFactor = types.Int64Value(int64(settings.FrequencyRecurrenceFactor))
+	d.ActiveStartDate = types.Int64Value(int64(settings.ActiveStartDate))
+	d.ActiveEndDate = types.Int64Value(int64(settings.ActiveEndDate))
+	d.ActiveStartTime = types.Int64Value(int64(settings.ActiveStartTime))
+	d.ActiveEndTime = types.Int64Value(int64(settings.ActiveEndTime))
+
+	if settings.FrequencySubdayType == "" {
+		d.FrequencySubdayType = types.StringNull()
+	} else {
+		d.FrequencySubdayType = types.StringValue(settings.FrequencySubdayType)
+	}
+
+	return d
+}
+
+func (d resourceData) getId(ctx context.Context) sql.AgentScheduleId {
+	if !common.IsAttrSet(d.Id) {
+		return 0
+	}
+
+	id, err := strconv.Atoi(d.Id.ValueString())
+	utils.AddError(ctx, fmt.Sprintf("Failed to convert resource ID '%s'", d.Id.ValueString()), err)
+	return sql.AgentScheduleId(id)
+}
@@ -0,0 +1,190 @@
+package agentSchedule
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/planModifiers"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "agent_schedule"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages SQL Server Agent schedule. Schedules are attached to jobs using `schedule_ids` attribute of `mssql_agent_job`.\n\n" +
+		"-> **Note** SQL Server Agent is not available in Azure SQL Database."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.AgentObjectNameValidators,
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: attrDescriptions["enabled"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				planModifiers.BoolDefault(true),
+			},
+		},
+		"frequency_type": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["frequency_type"],
+			Required:            true,
+		},
+		"frequency_interval": schema.Int64Attribute{
+			MarkdownDescription: attrDescriptions["frequency_interval"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"frequency_subday_type": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["frequency_subday_type"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"frequency_subday_interval": schema.Int64Attribute{
+			MarkdownDescription: attrDescriptions["frequency_subday_interval"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"frequency_relative_interval": schema.Int64Attribute{
+			MarkdownDescription: attrDescriptions["frequency_relative_interval"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"frequency_recurrence_factor": schema.Int64Attribute{
+			MarkdownDescription: attrDescriptions["frequency_recurrence_factor"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"active_start_date": schema.Int64Attribute{
+			MarkdownDescription: attrDescriptions["active_start_date"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"active_end_date": schema.Int64Attribute{
+			MarkdownDescription: attrDescriptions["active_end_date"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"active_start_time": schema.Int64Attribute{
+			MarkdownDescription: attrDescriptions["active_start_time"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"active_end_time": schema.Int64Attribute{
+			MarkdownDescription: attrDescriptions["active_end_time"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var schedule sql.AgentSchedule
+
+	req.
+		Then(func() { schedule = sql.CreateAgentSchedule(ctx, req.Conn, req.Plan.toSettings()) }).
+		Then(func() {
+			resp.State = req.Plan.withSettings(schedule.GetSettings(ctx))
+			resp.State.Id = types.StringValue(fmt.Sprint(schedule.GetId(ctx)))
+		})
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		schedule sql.AgentSchedule
+		exists   bool
+	)
+
+	req.
+		Then(func() { schedule = sql.GetAgentSchedule(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { exists = schedule.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withSettings(schedule.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var schedule sql.AgentSchedule
+
+	req.
+		Then(func() { schedule = sql.GetAgentSchedule(ctx, req.Conn, req.Plan.getId(ctx)) }).
+		Then(func() { schedule.UpdateSettings(ctx, req.Plan.toSettings()) }).
+		Then(func() { resp.State = req.Plan.withSettings(schedule.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var schedule sql.AgentSchedule
+
+	req.
+		Then(func() { schedule = sql.GetAgentSchedule(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { schedule.Drop(ctx) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	isOneOf := func(value string, allowed []string) bool {
+		for _, a := range allowed {
+			if value == a {
+				return true
+			}
+		}
+		return false
+	}
+
+	if common.IsAttrSet(req.Config.FrequencyType) && !isOneOf(req.Config.FrequencyType.ValueString(), frequencyTypes) {
+		utils.AddAttributeError(ctx, path.Root("frequency_type"), "Invalid frequency type", fmt.Sprintf("Frequency type %q is not supported", req.Config.FrequencyType.ValueString()))
+	}
+
+	if common.IsAttrSet(req.Config.FrequencySubdayType) && !isOneOf(req.Config.FrequencySubdayType.ValueString(), subdayTypes) {
+		utils.AddAttributeError(ctx, path.Root("frequency_subday_type"), "Invalid subday type", fmt.Sprintf("Subday type %q is not supported", req.Config.FrequencySubdayType.ValueString()))
+	}
+}
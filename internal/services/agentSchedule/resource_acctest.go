@@ -0,0 +1,80 @@
+package agentSchedule
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	if testCtx.IsAzureTest {
+		return
+	}
+
+	newResource := func(subdayType string, subdayInterval int) string {
+		return fmt.Sprintf(`
+resource "mssql_agent_schedule" "test" {
+	name = "test_schedule"
+	frequency_type = "DAILY"
+	frequency_subday_type = %q
+	frequency_subday_interval = %d
+	active_start_time = 10000
+}
+`, subdayType, subdayInterval)
+	}
+
+	var scheduleId string
+
+	fetchSchedule := func(conn *sql.DB) (string, int, int, int, error) {
+		var (
+			id                              string
+			freqType, subdayType, startTime int
+		)
+		err := conn.QueryRow("SELECT [schedule_id], [freq_type], [freq_subday_type], [active_start_time] FROM msdb.dbo.sysschedules WHERE [name]='test_schedule'").
+			Scan(&id, &freqType, &subdayType, &startTime)
+		return id, freqType, subdayType, startTime, err
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("ONCE", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						id, freqType, subdayType, startTime, err := fetchSchedule(conn)
+						scheduleId = id
+
+						testCtx.Assert.Equal(4, freqType, "freq_type")
+						testCtx.Assert.Equal(1, subdayType, "freq_subday_type")
+						testCtx.Assert.Equal(10000, startTime, "active_start_time")
+
+						return err
+					}),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPtr("mssql_agent_schedule.test", "id", &scheduleId),
+						resource.TestCheckResourceAttr("mssql_agent_schedule.test", "frequency_interval", "1"),
+						resource.TestCheckResourceAttr("mssql_agent_schedule.test", "active_end_time", "235959"),
+						resource.TestCheckResourceAttrSet("mssql_agent_schedule.test", "active_start_date"),
+					),
+				),
+			},
+			{
+				Config: newResource("HOURS", 2),
+				Check: testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+					id, _, subdayType, _, err := fetchSchedule(conn)
+
+					testCtx.Assert.Equal(scheduleId, id, "schedule should not be recreated")
+					testCtx.Assert.Equal(8, subdayType, "freq_subday_type")
+
+					return err
+				}),
+			},
+			{
+				ResourceName:      "mssql_agent_schedule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package asymmetricKey
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var algorithms = []string{"RSA_512", "RSA_1024", "RSA_2048", "RSA_3072", "RSA_4096"}
+
+var attrDescriptions = map[string]string{
+	"id":        "`<database_id>/<asymmetric_key_id>`. Asymmetric key ID can be retrieved using `SELECT asymmetric_key_id FROM sys.asymmetric_keys WHERE [name]='<key_name>'`.",
+	"name":      "Name of the asymmetric key. Cannot be longer than 128 chars.",
+	"algorithm": "Algorithm of the generated key. One of `RSA_512`, `RSA_1024`, `RSA_2048`, `RSA_3072`, `RSA_4096`. Required when generating the key, conflicts with `file_path`.",
+	"file_path": "Path, on the SQL Server host, of the strong-name file or executable to load the public key from. Conflicts with `algorithm`.",
+	"encryption_password": "Password used to encrypt the private key. When not set, the private key is protected by the database master key. Can be used only with generated keys.\n\n" +
+		"~> **Note** The password will be stored in the raw state as plain-text. [Read more about sensitive data in state](https://www.terraform.io/language/state/sensitive-data).",
+	"thumbprint": "SHA-1 hash of the key, e.g. `0x8E3A1C...`.",
+}
+
+type resourceData struct {
+	Id                 types.String `tfsdk:"id"`
+	DatabaseId         types.String `tfsdk:"database_id"`
+	Name               types.String `tfsdk:"name"`
+	Algorithm          types.String `tfsdk:"algorithm"`
+	FilePath           types.String `tfsdk:"file_path"`
+	EncryptionPassword types.String `tfsdk:"encryption_password"`
+	Thumbprint         types.String `tfsdk:"thumbprint"`
+}
+
+func (d resourceData) toSettings() sql.AsymmetricKeySettings {
+	return sql.AsymmetricKeySettings{
+		Name:               d.Name.ValueString(),
+		Algorithm:          d.Algorithm.ValueString(),
+		FilePath:           d.FilePath.ValueString(),
+		EncryptionPassword: d.EncryptionPassword.ValueString(),
+	}
+}
+
+func (d resourceData) withSettings(settings sql.AsymmetricKeySettings) resourceData {
+	d.Name = types.StringValue(settings.Name)
+	d.Algorithm = types.StringValue(settings.Algorithm)
+	d.Thumbprint = types.StringValue(settings.Thumbprint)
+	return d
+}
+
+func (d resourceData) withIds(ctx context.Context, key sql.AsymmetricKey) resourceData {
+	dbId := key.GetDb(ctx).GetId(ctx)
+	d.Id = types.StringValue(common.DbObjectId[sql.AsymmetricKeyId]{DbId: dbId, ObjectId: key.GetId(ctx)}.String())
+	d.DatabaseId = types.StringValue(fmt.Sprint(dbId))
+	return d
+}
@@ -0,0 +1,159 @@
+package asymmetricKey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "asymmetric_key"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages asymmetric key stored in a database, e.g. to sign modules or to create key-mapped logins and users. " +
+		"The key can be either generated by SQL Server or loaded from a file.\n\n" +
+		"-> **Note** Unless `encryption_password` is set, the database must contain a master key (see `mssql_master_key`) before the key can be generated."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"database_id": schema.StringAttribute{
+			MarkdownDescription: common.AttributeDescriptions["database_id"] + " Defaults to ID of `master`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.AsymmetricKeyNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"algorithm": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["algorithm"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"file_path": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["file_path"],
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"encryption_password": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["encryption_password"],
+			Optional:            true,
+			Sensitive:           true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"thumbprint": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["thumbprint"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var (
+		db  sql.Database
+		key sql.AsymmetricKey
+	)
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.Plan.DatabaseId.ValueString()) }).
+		Then(func() { key = sql.CreateAsymmetricKey(ctx, db, req.Plan.toSettings()) }).
+		Then(func() { resp.State = req.Plan.withIds(ctx, key).withSettings(key.GetSettings(ctx)) })
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		key    sql.AsymmetricKey
+		exists bool
+	)
+
+	req.
+		Then(func() { key = getAsymmetricKey(ctx, req.Conn, req.State) }).
+		Then(func() { exists = key.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withIds(ctx, key).withSettings(key.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var key sql.AsymmetricKey
+
+	req.
+		Then(func() { key = getAsymmetricKey(ctx, req.Conn, req.Plan) }).
+		Then(func() { resp.State = req.Plan.withSettings(key.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var key sql.AsymmetricKey
+
+	req.
+		Then(func() { key = getAsymmetricKey(ctx, req.Conn, req.State) }).
+		Then(func() { key.Drop(ctx) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	fromFile := !req.Config.FilePath.IsNull()
+
+	if fromFile == !req.Config.Algorithm.IsNull() {
+		utils.AddError(ctx, "Exactly one of algorithm or file_path must be provided", errors.New("asymmetric key must be either generated or loaded from file"))
+	}
+
+	if fromFile && !req.Config.EncryptionPassword.IsNull() {
+		utils.AddError(ctx, "Encryption password can be used only with generated keys", errors.New("encryption_password conflicts with file_path"))
+	}
+
+	if !common.IsAttrSet(req.Config.Algorithm) {
+		return
+	}
+
+	for _, algorithm := range algorithms {
+		if req.Config.Algorithm.ValueString() == algorithm {
+			return
+		}
+	}
+
+	utils.AddAttributeError(ctx, path.Root("algorithm"), "Invalid key algorithm", fmt.Sprintf("Algorithm %q is not supported", req.Config.Algorithm.ValueString()))
+}
+
+func getAsymmetricKey(ctx context.Context, conn sql.Connection, data resourceData) sql.AsymmetricKey {
+	id := common.ParseDbObjectId[sql.AsymmetricKeyId](ctx, data.Id.ValueString())
+	db := sql.GetDatabase(ctx, conn, id.DbId)
+	return sql.GetAsymmetricKey(ctx, db, id.ObjectId)
+}
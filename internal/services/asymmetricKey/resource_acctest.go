@@ -0,0 +1,69 @@
+package asymmetricKey
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	newResource := func(name string, algorithm string) string {
+		return fmt.Sprintf(`
+resource "mssql_asymmetric_key" "test" {
+	database_id = %d
+	name = %q
+	algorithm = %q
+	encryption_password = "Str0ngKeyPa$$w0rd"
+}
+`, testCtx.DefaultDBId, name, algorithm)
+	}
+
+	var keyId, thumbprint string
+
+	fetchKey := func(conn *sql.DB) (string, string, string, error) {
+		var id, algorithm, thumbprint string
+		err := conn.QueryRow("SELECT [asymmetric_key_id], [algorithm_desc], CONVERT(VARCHAR(MAX), [thumbprint], 1) FROM sys.asymmetric_keys WHERE [name]='test_key'").
+			Scan(&id, &algorithm, &thumbprint)
+		return id, algorithm, thumbprint, err
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("test_key", "RSA_2048"),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+						id, algorithm, tp, err := fetchKey(conn)
+						keyId, thumbprint = testCtx.DefaultDbId(id), tp
+
+						testCtx.Assert.Equal("RSA_2048", algorithm, "algorithm")
+
+						return err
+					}),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPtr("mssql_asymmetric_key.test", "id", &keyId),
+						resource.TestCheckResourceAttrPtr("mssql_asymmetric_key.test", "thumbprint", &thumbprint),
+					),
+				),
+			},
+			{
+				Config: newResource("test_key", "RSA_3072"),
+				Check: testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+					id, algorithm, _, err := fetchKey(conn)
+
+					testCtx.Assert.NotEqual(keyId, testCtx.DefaultDbId(id), "key should be recreated")
+					testCtx.Assert.Equal("RSA_3072", algorithm, "algorithm")
+
+					return err
+				}),
+			},
+			{
+				ResourceName:            "mssql_asymmetric_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"encryption_password"},
+			},
+		},
+	})
+}
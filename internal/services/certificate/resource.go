@@ -0,0 +1,173 @@
+package certificate
+
+import (
+	"context"
+	"errors"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "certificate"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages certificate stored in a database, e.g. to sign modules or to create certificate-mapped logins and users. " +
+		"The certificate can be either generated by SQL Server or loaded from a file.\n\n" +
+		"-> **Note** Unless `encryption_password` is set, the database must contain a master key (see `mssql_master_key`) before the certificate can be created."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"database_id": schema.StringAttribute{
+			MarkdownDescription: common.AttributeDescriptions["database_id"] + " Defaults to ID of `master`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.CertificateNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"subject": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["subject"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"expiry_date": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["expiry_date"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"file_path": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["file_path"],
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"private_key_file_path": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["private_key_file_path"],
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"private_key_password": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["private_key_password"],
+			Optional:            true,
+			Sensitive:           true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"encryption_password": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["encryption_password"],
+			Optional:            true,
+			Sensitive:           true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"thumbprint": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["thumbprint"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var (
+		db   sql.Database
+		cert sql.Certificate
+	)
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.Plan.DatabaseId.ValueString()) }).
+		Then(func() { cert = sql.CreateCertificate(ctx, db, req.Plan.toSettings()) }).
+		Then(func() { resp.State = req.Plan.withIds(ctx, cert).withSettings(cert.GetSettings(ctx)) })
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		cert   sql.Certificate
+		exists bool
+	)
+
+	req.
+		Then(func() { cert = getCertificate(ctx, req.Conn, req.State) }).
+		Then(func() { exists = cert.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withIds(ctx, cert).withSettings(cert.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var cert sql.Certificate
+
+	req.
+		Then(func() { cert = getCertificate(ctx, req.Conn, req.Plan) }).
+		Then(func() { resp.State = req.Plan.withSettings(cert.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var cert sql.Certificate
+
+	req.
+		Then(func() { cert = getCertificate(ctx, req.Conn, req.State) }).
+		Then(func() { cert.Drop(ctx) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	fromFile := !req.Config.FilePath.IsNull()
+
+	if fromFile == !req.Config.Subject.IsNull() {
+		utils.AddError(ctx, "Exactly one of subject or file_path must be provided", errors.New("certificate must be either generated or loaded from file"))
+	}
+
+	if !fromFile && (!req.Config.PrivateKeyFilePath.IsNull() || !req.Config.PrivateKeyPassword.IsNull()) {
+		utils.AddError(ctx, "Private key can be loaded only together with certificate file", errors.New("private_key_file_path and private_key_password require file_path"))
+	}
+
+	if fromFile && req.Config.PrivateKeyFilePath.IsNull() && !req.Config.EncryptionPassword.IsNull() {
+		utils.AddError(ctx, "Encryption password requires private key", errors.New("encryption_password requires private_key_file_path when loading certificate from file"))
+	}
+}
+
+func getCertificate(ctx context.Context, conn sql.Connection, data resourceData) sql.Certificate {
+	id := common.ParseDbObjectId[sql.CertificateId](ctx, data.Id.ValueString())
+	db := sql.GetDatabase(ctx, conn, id.DbId)
+	return sql.GetCertificate(ctx, db, id.ObjectId)
+}
@@ -0,0 +1,70 @@
+package certificate
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	newResource := func(name string, subject string) string {
+		return fmt.Sprintf(`
+resource "mssql_certificate" "test" {
+	database_id = %d
+	name = %q
+	subject = %q
+	expiry_date = "2099-12-31"
+	encryption_password = "Str0ngCertPa$$w0rd"
+}
+`, testCtx.DefaultDBId, name, subject)
+	}
+
+	var certId, thumbprint string
+
+	fetchCertificate := func(conn *sql.DB) (string, string, string, error) {
+		var id, subject, thumbprint string
+		err := conn.QueryRow("SELECT [certificate_id], [subject], CONVERT(VARCHAR(MAX), [thumbprint], 1) FROM sys.certificates WHERE [name]='test_cert'").
+			Scan(&id, &subject, &thumbprint)
+		return id, subject, thumbprint, err
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("test_cert", "Test certificate"),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+						id, subject, tp, err := fetchCertificate(conn)
+						certId, thumbprint = testCtx.DefaultDbId(id), tp
+
+						testCtx.Assert.Equal("Test certificate", subject, "subject")
+
+						return err
+					}),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPtr("mssql_certificate.test", "id", &certId),
+						resource.TestCheckResourceAttrPtr("mssql_certificate.test", "thumbprint", &thumbprint),
+						resource.TestCheckResourceAttr("mssql_certificate.test", "database_id", fmt.Sprint(testCtx.DefaultDBId)),
+					),
+				),
+			},
+			{
+				Config: newResource("test_cert", "Changed subject"),
+				Check: testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+					id, _, _, err := fetchCertificate(conn)
+
+					testCtx.Assert.NotEqual(certId, testCtx.DefaultDbId(id), "certificate should be recreated")
+
+					return err
+				}),
+			},
+			{
+				ResourceName:            "mssql_certificate.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"encryption_password"},
+			},
+		},
+	})
+}
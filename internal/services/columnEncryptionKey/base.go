@@ -0,0 +1,81 @@
+package columnEncryptionKey
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+var attrDescriptions = map[string]string{
+	"id":                   "`<database_id>/<column_encryption_key_id>`. Column encryption key ID can be retrieved using `SELECT column_encryption_key_id FROM sys.column_encryption_keys WHERE [name]='<key_name>'`.",
+	"name":                 "Name of the column encryption key. Cannot be longer than 128 chars.",
+	"values":               "Encrypted values of the key, one per column master key. Two values can be provided during column master key rotation.",
+	"column_master_key_id": "ID of `mssql_column_master_key` used to encrypt the value. Must be stored in the same database as the column encryption key.",
+	"algorithm":            "Algorithm used to encrypt the value. Currently only `RSA_OAEP` is supported.",
+	"encrypted_value":      "Hex-encoded value of the column encryption key, encrypted with the column master key, e.g. `0x016E0000...`. Usually generated by SSMS or the `SqlServer` PowerShell module.",
+}
+
+type valueData struct {
+	ColumnMasterKeyId types.String `tfsdk:"column_master_key_id"`
+	Algorithm         types.String `tfsdk:"algorithm"`
+	EncryptedValue    types.String `tfsdk:"encrypted_value"`
+}
+
+type resourceData struct {
+	Id         types.String `tfsdk:"id"`
+	DatabaseId types.String `tfsdk:"database_id"`
+	Name       types.String `tfsdk:"name"`
+	Values     []valueData  `tfsdk:"values"`
+}
+
+func (d resourceData) toSettings(ctx context.Context) sql.ColumnEncryptionKeySettings {
+	settings := sql.ColumnEncryptionKeySettings{Name: d.Name.ValueString()}
+
+	for _, val := range d.Values {
+		settings.Values = append(settings.Values, sql.ColumnEncryptionKeyValue{
+			ColumnMasterKeyId: common.ParseDbObjectId[sql.ColumnMasterKeyId](ctx, val.ColumnMasterKeyId.ValueString()).ObjectId,
+			Algorithm:         val.Algorithm.ValueString(),
+			EncryptedValue:    val.EncryptedValue.ValueString(),
+		})
+	}
+
+	return settings
+}
+
+func (d resourceData) withSettings(settings sql.ColumnEncryptionKeySettings) resourceData {
+	current := map[string]string{}
+	for _, val := range d.Values {
+		current[val.ColumnMasterKeyId.ValueString()] = val.EncryptedValue.ValueString()
+	}
+
+	d.Name = types.StringValue(settings.Name)
+	d.Values = nil
+
+	for _, val := range settings.Values {
+		cmkId := fmt.Sprintf("%s/%d", d.DatabaseId.ValueString(), val.ColumnMasterKeyId)
+		encryptedValue := val.EncryptedValue
+
+		// SQL Server returns upper-case hex digits, so keep configured value when it differs only by case.
+		if strings.EqualFold(current[cmkId], encryptedValue) {
+			encryptedValue = current[cmkId]
+		}
+
+		d.Values = append(d.Values, valueData{
+			ColumnMasterKeyId: types.StringValue(cmkId),
+			Algorithm:         types.StringValue(val.Algorithm),
+			EncryptedValue:    types.StringValue(encryptedValue),
+		})
+	}
+
+	return d
+}
+
+func (d resourceData) withIds(ctx context.Context, key sql.ColumnEncryptionKey) resourceData {
+	dbId := key.GetDb(ctx).GetId(ctx)
+	d.Id = types.StringValue(common.DbObjectId[sql.ColumnEncryptionKeyId]{DbId: dbId, ObjectId: key.GetId(ctx)}.String())
+	d.DatabaseId = types.StringValue(fmt.Sprint(dbId))
+	return d
+}
@@ -0,0 +1,138 @@
+package columnEncryptionKey
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"regexp"
+)
+
+var encryptedValueRegex = regexp.MustCompile("^0[xX][0-9a-fA-F]+$")
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "column_encryption_key"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages Always Encrypted column encryption key. Adding and removing `values` can be used to rotate column master keys without recreating the key.\n\n" +
+		"-> **Note** Changing the encrypted value of the only value of the key is not supported by SQL Server. Add a value encrypted with another column master key first."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"database_id": schema.StringAttribute{
+			MarkdownDescription: common.AttributeDescriptions["database_id"] + " Defaults to ID of `master`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.ColumnKeyNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"values": schema.SetNestedAttribute{
+			MarkdownDescription: attrDescriptions["values"],
+			Required:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"column_master_key_id": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["column_master_key_id"],
+						Required:            true,
+					},
+					"algorithm": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["algorithm"],
+						Required:            true,
+					},
+					"encrypted_value": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["encrypted_value"],
+						Required:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var (
+		db  sql.Database
+		key sql.ColumnEncryptionKey
+	)
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.Plan.DatabaseId.ValueString()) }).
+		Then(func() { key = sql.CreateColumnEncryptionKey(ctx, db, req.Plan.toSettings(ctx)) }).
+		Then(func() { resp.State = req.Plan.withIds(ctx, key).withSettings(key.GetSettings(ctx)) })
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		key    sql.ColumnEncryptionKey
+		exists bool
+	)
+
+	req.
+		Then(func() { key = getColumnEncryptionKey(ctx, req.Conn, req.State) }).
+		Then(func() { exists = key.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withIds(ctx, key).withSettings(key.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var key sql.ColumnEncryptionKey
+
+	req.
+		Then(func() { key = getColumnEncryptionKey(ctx, req.Conn, req.Plan) }).
+		Then(func() { key.UpdateSettings(ctx, req.Plan.toSettings(ctx)) }).
+		Then(func() { resp.State = req.Plan.withSettings(key.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var key sql.ColumnEncryptionKey
+
+	req.
+		Then(func() { key = getColumnEncryptionKey(ctx, req.Conn, req.State) }).
+		Then(func() { key.Drop(ctx) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	if len(req.Config.Values) > 2 {
+		utils.AddAttributeError(ctx, path.Root("values"), "Too many values", "Column encryption key can have at most 2 values")
+	}
+
+	for _, val := range req.Config.Values {
+		if common.IsAttrSet(val.EncryptedValue) && !encryptedValueRegex.MatchString(val.EncryptedValue.ValueString()) {
+			utils.AddAttributeError(ctx, path.Root("values"), "Invalid encrypted value", fmt.Sprintf("Encrypted value %q must be a hex-encoded binary, starting with 0x", val.EncryptedValue.ValueString()))
+		}
+	}
+}
+
+func getColumnEncryptionKey(ctx context.Context, conn sql.Connection, data resourceData) sql.ColumnEncryptionKey {
+	id := common.ParseDbObjectId[sql.ColumnEncryptionKeyId](ctx, data.Id.ValueString())
+	db := sql.GetDatabase(ctx, conn, id.DbId)
+	return sql.GetColumnEncryptionKey(ctx, db, id.ObjectId)
+}
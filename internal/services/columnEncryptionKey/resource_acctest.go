@@ -0,0 +1,81 @@
+package columnEncryptionKey
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Encrypted values are not validated by SQL Server when the key is created, so any binary can be used in tests.
+const (
+	testEncryptedValue1 = "0x016E000001630075007200720065006E0074007500730065007200"
+	testEncryptedValue2 = "0x016E000001630075007200720065006E0074007500730065007201"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	newResource := func(values ...string) string {
+		valueDefs := ""
+		for i, val := range values {
+			valueDefs += fmt.Sprintf(`
+		{
+			column_master_key_id = mssql_column_master_key.test[%d].id
+			algorithm = "RSA_OAEP"
+			encrypted_value = %q
+		},`, i, val)
+		}
+
+		return fmt.Sprintf(`
+resource "mssql_column_master_key" "test" {
+	count = 2
+	database_id = %[1]d
+	name = "test_cek_cmk_${count.index}"
+	key_store_provider_name = "MSSQL_CERTIFICATE_STORE"
+	key_path = "CurrentUser/My/000000000000000000000000000000000000000${count.index}"
+}
+
+resource "mssql_column_encryption_key" "test" {
+	database_id = %[1]d
+	name = "test_cek"
+	values = [%[2]s
+	]
+}
+`, testCtx.DefaultDBId, valueDefs)
+	}
+
+	countValues := func(conn *sql.DB) (int, error) {
+		var count int
+		err := conn.QueryRow("SELECT COUNT(*) FROM sys.column_encryption_key_values v INNER JOIN sys.column_encryption_keys k ON k.column_encryption_key_id = v.column_encryption_key_id WHERE k.[name]='test_cek'").
+			Scan(&count)
+		return count, err
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource(testEncryptedValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+						count, err := countValues(conn)
+						testCtx.Assert.Equal(1, count, "values count")
+						return err
+					}),
+					resource.TestCheckResourceAttr("mssql_column_encryption_key.test", "values.#", "1"),
+				),
+			},
+			{
+				Config: newResource(testEncryptedValue1, testEncryptedValue2),
+				Check: testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+					count, err := countValues(conn)
+					testCtx.Assert.Equal(2, count, "values count")
+					return err
+				}),
+			},
+			{
+				ResourceName:      "mssql_column_encryption_key.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
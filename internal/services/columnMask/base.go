@@ -0,0 +1,65 @@
+package columnMask
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+var attrDescriptions = map[string]string{
+	"id": "`<database_id>/<table_id>/<column_id>`. Table and column IDs can be retrieved using " +
+		"`SELECT OBJECT_ID('<schema_name>.<table_name>'), COLUMNPROPERTY(OBJECT_ID('<schema_name>.<table_name>'), '<column_name>', 'ColumnId')`.",
+	"table_name":  "Schema-qualified name of the table containing the masked column, e.g. `dbo.Customers`.",
+	"column_name": "Name of the masked column. Cannot be longer than 128 chars.",
+	"function": "Masking function applied to the column, e.g. `default()`, `email()`, `random(1, 100)` or `partial(1, \"XXXX\", 1)`. " +
+		"For full list of supported functions, see [docs](https://learn.microsoft.com/en-us/sql/relational-databases/security/dynamic-data-masking#defining-a-dynamic-data-mask).",
+}
+
+type resourceData struct {
+	Id         types.String `tfsdk:"id"`
+	DatabaseId types.String `tfsdk:"database_id"`
+	TableName  types.String `tfsdk:"table_name"`
+	ColumnName types.String `tfsdk:"column_name"`
+	Function   types.String `tfsdk:"function"`
+}
+
+func (d resourceData) toSettings() sql.ColumnMaskSettings {
+	return sql.ColumnMaskSettings{Function: d.Function.ValueString()}
+}
+
+func (d resourceData) withIds(ctx context.Context, mask sql.ColumnMask) resourceData {
+	table := mask.GetTable(ctx)
+	dbId := table.GetDb(ctx).GetId(ctx)
+
+	d.Id = types.StringValue(common.DbObjectMemberId[sql.TableId, sql.ColumnId]{
+		DbObjectId: common.DbObjectId[sql.TableId]{DbId: dbId, ObjectId: table.GetId(ctx)},
+		MemberId:   mask.GetColumnId(ctx),
+	}.String())
+	d.DatabaseId = types.StringValue(fmt.Sprint(dbId))
+
+	return d
+}
+
+func (d resourceData) withSettings(ctx context.Context, mask sql.ColumnMask, settings sql.ColumnMaskSettings) resourceData {
+	// SQL Server may normalize whitespaces in the function definition, which should not be reported as change
+	normalize := func(s string) string {
+		return strings.Join(strings.Fields(s), "")
+	}
+
+	if normalize(d.Function.ValueString()) != normalize(settings.Function) {
+		d.Function = types.StringValue(settings.Function)
+	}
+
+	if d.TableName.IsNull() {
+		d.TableName = types.StringValue(mask.GetTable(ctx).GetName(ctx))
+	}
+
+	if d.ColumnName.IsNull() {
+		d.ColumnName = types.StringValue(mask.GetTable(ctx).GetColumnName(ctx, mask.GetColumnId(ctx)))
+	}
+
+	return d
+}
@@ -0,0 +1,129 @@
+package columnMask
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"strings"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "column_mask"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages dynamic data mask of a table column. The table must be created beforehand, e.g. using `mssql_script`."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"database_id": schema.StringAttribute{
+			MarkdownDescription: common.AttributeDescriptions["database_id"] + " Defaults to ID of `master`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"table_name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["table_name"],
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"column_name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["column_name"],
+			Required:            true,
+			Validators:          validators.ColumnNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"function": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["function"],
+			Required:            true,
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var (
+		db    sql.Database
+		table sql.Table
+		mask  sql.ColumnMask
+	)
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.Plan.DatabaseId.ValueString()) }).
+		Then(func() { table = sql.GetTableByName(ctx, db, req.Plan.TableName.ValueString()) }).
+		Then(func() {
+			mask = sql.CreateColumnMask(ctx, table, req.Plan.ColumnName.ValueString(), req.Plan.toSettings())
+		}).
+		Then(func() { resp.State = req.Plan.withIds(ctx, mask).withSettings(ctx, mask, mask.GetSettings(ctx)) })
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		mask   sql.ColumnMask
+		exists bool
+	)
+
+	req.
+		Then(func() { mask = getColumnMask(ctx, req.Conn, req.State) }).
+		Then(func() { exists = mask.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withIds(ctx, mask).withSettings(ctx, mask, mask.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var mask sql.ColumnMask
+
+	req.
+		Then(func() { mask = getColumnMask(ctx, req.Conn, req.Plan) }).
+		Then(func() { mask.UpdateSettings(ctx, req.Plan.toSettings()) }).
+		Then(func() { resp.State = req.Plan.withSettings(ctx, mask, mask.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var mask sql.ColumnMask
+
+	req.
+		Then(func() { mask = getColumnMask(ctx, req.Conn, req.State) }).
+		Then(func() { mask.Drop(ctx) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	if !common.IsAttrSet(req.Config.TableName) {
+		return
+	}
+
+	name := req.Config.TableName.ValueString()
+	if parts := strings.SplitN(name, ".", 2); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		utils.AddAttributeError(ctx, path.Root("table_name"), "Invalid table name", fmt.Sprintf("Name %q must be schema-qualified, e.g. `dbo.%s`", name, name))
+	}
+}
+
+func getColumnMask(ctx context.Context, conn sql.Connection, data resourceData) sql.ColumnMask {
+	id := common.ParseDbObjectMemberId[sql.TableId, sql.ColumnId](ctx, data.Id.ValueString())
+	db := sql.GetDatabase(ctx, conn, id.DbId)
+	return sql.GetColumnMask(ctx, sql.GetTable(ctx, db, id.ObjectId), id.MemberId)
+}
@@ -0,0 +1,70 @@
+package columnMask
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	testCtx.ExecDefaultDB("IF OBJECT_ID('dbo.ddm_customers') IS NULL CREATE TABLE [dbo].[ddm_customers] ([id] INT, [email] NVARCHAR(100), [phone] VARCHAR(20))")
+	defer testCtx.ExecDefaultDB("DROP TABLE [dbo].[ddm_customers]")
+
+	newResource := func(function string) string {
+		return fmt.Sprintf(`
+resource "mssql_column_mask" "test" {
+	database_id = %d
+	table_name = "dbo.ddm_customers"
+	column_name = "phone"
+	function = %q
+}
+`, testCtx.DefaultDBId, function)
+	}
+
+	var tableId, maskId string
+
+	fetchMask := func(conn *sql.DB) (string, error) {
+		var function string
+		err := conn.QueryRow("SELECT OBJECT_ID('dbo.ddm_customers'), [masking_function] FROM sys.masked_columns WHERE [object_id]=OBJECT_ID('dbo.ddm_customers') AND [name]='phone'").
+			Scan(&tableId, &function)
+		return function, err
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource(`partial(0,"XXX-XXX-",4)`),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+						function, err := fetchMask(conn)
+						maskId = fmt.Sprintf("%s/3", testCtx.DefaultDbId(tableId))
+
+						testCtx.Assert.Equal(`partial(0, "XXX-XXX-", 4)`, function, "masking function")
+
+						return err
+					}),
+					resource.TestCheckResourceAttrPtr("mssql_column_mask.test", "id", &maskId),
+				),
+			},
+			{
+				Config: newResource("default()"),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+						function, err := fetchMask(conn)
+
+						testCtx.Assert.Equal("default()", function, "masking function")
+
+						return err
+					}),
+					resource.TestCheckResourceAttrPtr("mssql_column_mask.test", "id", &maskId),
+				),
+			},
+			{
+				ResourceName:      "mssql_column_mask.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,46 @@
+package columnMasterKey
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var attrDescriptions = map[string]string{
+	"id":                      "`<database_id>/<column_master_key_id>`. Column master key ID can be retrieved using `SELECT column_master_key_id FROM sys.column_master_keys WHERE [name]='<key_name>'`.",
+	"name":                    "Name of the column master key. Cannot be longer than 128 chars.",
+	"key_store_provider_name": "Name of the key store provider holding the key, e.g. `MSSQL_CERTIFICATE_STORE`, `AZURE_KEY_VAULT` or `MSSQL_CNG_STORE`.",
+	"key_path":                "Path of the key in the key store, e.g. `CurrentUser/My/<thumbprint>` or Azure Key Vault key URL.",
+}
+
+type resourceData struct {
+	Id                   types.String `tfsdk:"id"`
+	DatabaseId           types.String `tfsdk:"database_id"`
+	Name                 types.String `tfsdk:"name"`
+	KeyStoreProviderName types.String `tfsdk:"key_store_provider_name"`
+	KeyPath              types.String `tfsdk:"key_path"`
+}
+
+func (d resourceData) toSettings() sql.ColumnMasterKeySettings {
+	return sql.ColumnMasterKeySettings{
+		Name:                 d.Name.ValueString(),
+		KeyStoreProviderName: d.KeyStoreProviderName.ValueString(),
+		KeyPath:              d.KeyPath.ValueString(),
+	}
+}
+
+func (d resourceData) withSettings(settings sql.ColumnMasterKeySettings) resourceData {
+	d.Name = types.StringValue(settings.Name)
+	d.KeyStoreProviderName = types.StringValue(settings.KeyStoreProviderName)
+	d.KeyPath = types.StringValue(settings.KeyPath)
+	return d
+}
+
+func (d resourceData) withIds(ctx context.Context, key sql.ColumnMasterKey) resourceData {
+	dbId := key.GetDb(ctx).GetId(ctx)
+	d.Id = types.StringValue(common.DbObjectId[sql.ColumnMasterKeyId]{DbId: dbId, ObjectId: key.GetId(ctx)}.String())
+	d.DatabaseId = types.StringValue(fmt.Sprint(dbId))
+	return d
+}
@@ -0,0 +1,113 @@
+package columnMasterKey
+
+import (
+	"context"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "column_master_key"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages Always Encrypted column master key metadata. The key itself is stored outside of SQL Server, in the key store identified by `key_store_provider_name`.\n\n" +
+		"-> **Note** All attributes are immutable, so any change recreates the key. Column encryption keys protected by the column master key must be re-encrypted first."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"database_id": schema.StringAttribute{
+			MarkdownDescription: common.AttributeDescriptions["database_id"] + " Defaults to ID of `master`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.ColumnKeyNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"key_store_provider_name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["key_store_provider_name"],
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"key_path": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["key_path"],
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var (
+		db  sql.Database
+		key sql.ColumnMasterKey
+	)
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.Plan.DatabaseId.ValueString()) }).
+		Then(func() { key = sql.CreateColumnMasterKey(ctx, db, req.Plan.toSettings()) }).
+		Then(func() { resp.State = req.Plan.withIds(ctx, key).withSettings(key.GetSettings(ctx)) })
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		key    sql.ColumnMasterKey
+		exists bool
+	)
+
+	req.
+		Then(func() { key = getColumnMasterKey(ctx, req.Conn, req.State) }).
+		Then(func() { exists = key.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withIds(ctx, key).withSettings(key.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var key sql.ColumnMasterKey
+
+	req.
+		Then(func() { key = getColumnMasterKey(ctx, req.Conn, req.Plan) }).
+		Then(func() { resp.State = req.Plan.withSettings(key.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var key sql.ColumnMasterKey
+
+	req.
+		Then(func() { key = getColumnMasterKey(ctx, req.Conn, req.State) }).
+		Then(func() { key.Drop(ctx) })
+}
+
+func getColumnMasterKey(ctx context.Context, conn sql.Connection, data resourceData) sql.ColumnMasterKey {
+	id := common.ParseDbObjectId[sql.ColumnMasterKeyId](ctx, data.Id.ValueString())
+	db := sql.GetDatabase(ctx, conn, id.DbId)
+	return sql.GetColumnMasterKey(ctx, db, id.ObjectId)
+}
@@ -0,0 +1,63 @@
+package columnMasterKey
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	newResource := func(keyPath string) string {
+		return fmt.Sprintf(`
+resource "mssql_column_master_key" "test" {
+	database_id = %d
+	name = "test_cmk"
+	key_store_provider_name = "MSSQL_CERTIFICATE_STORE"
+	key_path = %q
+}
+`, testCtx.DefaultDBId, keyPath)
+	}
+
+	var keyId string
+
+	fetchKey := func(conn *sql.DB) (string, string, error) {
+		var id, keyPath string
+		err := conn.QueryRow("SELECT [column_master_key_id], [key_path] FROM sys.column_master_keys WHERE [name]='test_cmk'").Scan(&id, &keyPath)
+		return id, keyPath, err
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("CurrentUser/My/0102030405060708090A0B0C0D0E0F1011121314"),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+						id, keyPath, err := fetchKey(conn)
+						keyId = testCtx.DefaultDbId(id)
+
+						testCtx.Assert.Equal("CurrentUser/My/0102030405060708090A0B0C0D0E0F1011121314", keyPath, "key path")
+
+						return err
+					}),
+					resource.TestCheckResourceAttrPtr("mssql_column_master_key.test", "id", &keyId),
+				),
+			},
+			{
+				Config: newResource("CurrentUser/My/1413121110F0E0D0C0B0A090807060504030201"),
+				Check: testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+					id, _, err := fetchKey(conn)
+
+					testCtx.Assert.NotEqual(keyId, testCtx.DefaultDbId(id), "key should be recreated")
+
+					return err
+				}),
+			},
+			{
+				ResourceName:      "mssql_column_master_key.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
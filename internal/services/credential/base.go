@@ -0,0 +1,51 @@
+package credential
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+)
+
+var attrDescriptions = map[string]string{
+	"id":       "Credential ID. Can be retrieved using `SELECT credential_id FROM sys.credentials WHERE [name]='<credential_name>'`.",
+	"name":     "Name of the credential. Cannot be longer than 128 chars.",
+	"identity": "Name of the account to be used when connecting outside the server, e.g. `SHARED ACCESS SIGNATURE` or `Managed Identity`.",
+	"secret": "Secret required for outgoing authentication. Changing the secret does not recreate the credential.\n\n" +
+		"~> **Note** The secret cannot be read back from SQL Server, so changes made outside of Terraform will not be detected. " +
+		"It will be stored in the raw state as plain-text. [Read more about sensitive data in state](https://www.terraform.io/language/state/sensitive-data).",
+}
+
+type resourceData struct {
+	Id       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Identity types.String `tfsdk:"identity"`
+	Secret   types.String `tfsdk:"secret"`
+}
+
+func (d resourceData) toSettings() sql.CredentialSettings {
+	return sql.CredentialSettings{
+		Name:     d.Name.ValueString(),
+		Identity: d.Identity.ValueString(),
+		Secret:   d.Secret.ValueString(),
+	}
+}
+
+func (d resourceData) withSettings(settings sql.CredentialSettings) resourceData {
+	d.Name = types.StringValue(settings.Name)
+	d.Identity = types.StringValue(settings.Identity)
+	return d
+}
+
+func (d resourceData) getId(ctx context.Context) sql.CredentialId {
+	if !common.IsAttrSet(d.Id) {
+		return 0
+	}
+
+	id, err := strconv.Atoi(d.Id.ValueString())
+	utils.AddError(ctx, fmt.Sprintf("Failed to convert resource ID '%s'", d.Id.ValueString()), err)
+	return sql.CredentialId(id)
+}
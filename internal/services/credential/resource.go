@@ -0,0 +1,94 @@
+package credential
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "credential"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages server-level credential, used e.g. for backups to URL or SQL Agent proxies.\n\n" +
+		"-> **Note** Server-level credentials are not supported by Azure SQL Database. Use `mssql_database_scoped_credential` instead."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.CredentialNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"identity": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["identity"],
+			Required:            true,
+		},
+		"secret": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["secret"],
+			Optional:            true,
+			Sensitive:           true,
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var cred sql.Credential
+
+	req.
+		Then(func() { cred = sql.CreateCredential(ctx, req.Conn, req.Plan.toSettings()) }).
+		Then(func() {
+			resp.State = req.Plan.withSettings(cred.GetSettings(ctx))
+			resp.State.Id = types.StringValue(fmt.Sprint(cred.GetId(ctx)))
+		})
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		cred   sql.Credential
+		exists bool
+	)
+
+	req.
+		Then(func() { cred = sql.GetCredential(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { exists = cred.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withSettings(cred.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var cred sql.Credential
+
+	req.
+		Then(func() { cred = sql.GetCredential(ctx, req.Conn, req.Plan.getId(ctx)) }).
+		Then(func() { cred.UpdateSettings(ctx, req.Plan.toSettings()) }).
+		Then(func() { resp.State = req.Plan.withSettings(cred.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var cred sql.Credential
+
+	req.
+		Then(func() { cred = sql.GetCredential(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { cred.Drop(ctx) })
+}
@@ -0,0 +1,85 @@
+package credential
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	if testCtx.IsAzureTest {
+		return
+	}
+
+	newResource := func(resName string, name string, identity string, secret string) string {
+		return fmt.Sprintf(`
+resource "mssql_credential" %[1]q {
+	name = %[2]q
+	identity = %[3]q
+	secret = %[4]q
+}
+`, resName, name, identity, secret)
+	}
+
+	var credentialId string
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("test", "test_credential", "test_identity", "test_secret"),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						var identity string
+						err := conn.QueryRow("SELECT [credential_id], [credential_identity] FROM sys.credentials WHERE [name]='test_credential'").
+							Scan(&credentialId, &identity)
+
+						testCtx.Assert.Equal("test_identity", identity, "identity")
+
+						return err
+					}),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPtr("mssql_credential.test", "id", &credentialId),
+						resource.TestCheckResourceAttr("mssql_credential.test", "identity", "test_identity"),
+					),
+				),
+			},
+			{
+				Config: newResource("test", "test_credential", "new_identity", "rotated_secret"),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						var id, identity string
+						err := conn.QueryRow("SELECT [credential_id], [credential_identity] FROM sys.credentials WHERE [name]='test_credential'").
+							Scan(&id, &identity)
+
+						testCtx.Assert.Equal(credentialId, id, "credential should not be recreated")
+						testCtx.Assert.Equal("new_identity", identity, "identity")
+
+						return err
+					}),
+					resource.TestCheckResourceAttr("mssql_credential.test", "secret", "rotated_secret"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testCtx.ExecMasterDB("ALTER CREDENTIAL [test_credential] WITH IDENTITY = 'changed_outside'")
+				},
+				Config: newResource("test", "test_credential", "new_identity", "rotated_secret"),
+				Check: testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+					var identity string
+					err := conn.QueryRow("SELECT [credential_identity] FROM sys.credentials WHERE [name]='test_credential'").Scan(&identity)
+
+					testCtx.Assert.Equal("new_identity", identity, "identity drift should be reverted")
+
+					return err
+				}),
+			},
+			{
+				ResourceName:            "mssql_credential.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
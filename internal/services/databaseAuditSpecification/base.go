@@ -0,0 +1,133 @@
+package databaseAuditSpecification
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"strings"
+)
+
+var attrDescriptions = map[string]string{
+	"id":            "`<database_id>/<specification_id>`. Specification ID can be retrieved using `SELECT database_specification_id FROM sys.database_audit_specifications WHERE [name]='<specification_name>'`.",
+	"name":          "Name of the database audit specification. Cannot be longer than 128 chars.",
+	"audit_id":      "ID of `mssql_server_audit` collecting the events.",
+	"action_groups": "Set of database-level audit action groups, e.g. `SCHEMA_OBJECT_CHANGE_GROUP`.",
+	"audit_actions": "Set of audited actions performed on specific securables by specific principals.",
+	"action":        "Audited action. One of `SELECT`, `INSERT`, `UPDATE`, `DELETE`, `EXECUTE`, `RECEIVE`, `REFERENCES`.",
+	"securable":     "Securable the action is audited on, in form of `<class>::<name>`, e.g. `OBJECT::dbo.Orders`, `SCHEMA::sales` or `DATABASE::my_db`.",
+	"principal_id":  "`<database_id>/<principal_id>` of the principal whose actions are audited. Can be retrieved using `mssql_database_role`, `mssql_sql_user`, `mssql_azuread_user` or `mssql_azuread_service_principal`. Use `public` role to audit all users.",
+	"enabled":       "When `false`, the specification is created in disabled state and no events are collected. Defaults to `true`.",
+}
+
+type auditActionData struct {
+	Action      types.String `tfsdk:"action"`
+	Securable   types.String `tfsdk:"securable"`
+	PrincipalId types.String `tfsdk:"principal_id"`
+}
+
+func (d auditActionData) toAction(ctx context.Context) sql.DatabaseAuditAction {
+	return sql.DatabaseAuditAction{
+		Action:      d.Action.ValueString(),
+		Securable:   d.Securable.ValueString(),
+		PrincipalId: common.ParseDbObjectId[sql.GenericDatabasePrincipalId](ctx, d.PrincipalId.ValueString()).ObjectId,
+	}
+}
+
+type resourceData struct {
+	Id           types.String      `tfsdk:"id"`
+	DatabaseId   types.String      `tfsdk:"database_id"`
+	Name         types.String      `tfsdk:"name"`
+	AuditId      types.String      `tfsdk:"audit_id"`
+	ActionGroups []string          `tfsdk:"action_groups"`
+	AuditActions []auditActionData `tfsdk:"audit_actions"`
+	Enabled      types.Bool        `tfsdk:"enabled"`
+}
+
+func (d resourceData) toSettings(ctx context.Context) sql.DatabaseAuditSpecificationSettings {
+	auditId, err := strconv.Atoi(d.AuditId.ValueString())
+	utils.AddError(ctx, fmt.Sprintf("Failed to convert audit ID '%s'", d.AuditId.ValueString()), err)
+
+	settings := sql.DatabaseAuditSpecificationSettings{
+		Name:         d.Name.ValueString(),
+		AuditId:      sql.ServerAuditId(auditId),
+		ActionGroups: d.ActionGroups,
+		Enabled:      d.Enabled.ValueBool() || !common.IsAttrSet(d.Enabled),
+	}
+
+	for _, action := range d.AuditActions {
+		settings.Actions = append(settings.Actions, action.toAction(ctx))
+	}
+
+	return settings
+}
+
+func (d resourceData) withSettings(ctx context.Context, settings sql.DatabaseAuditSpecificationSettings) resourceData {
+	configured := d.AuditActions
+
+	d.Name = types.StringValue(settings.Name)
+	d.AuditId = types.StringValue(fmt.Sprint(settings.AuditId))
+	d.Enabled = types.BoolValue(settings.Enabled)
+	d.AuditActions = nil
+
+	if !equalIgnoreCase(d.ActionGroups, settings.ActionGroups) {
+		d.ActionGroups = settings.ActionGroups
+	}
+
+	dbId := common.ParseDbObjectId[sql.DatabaseAuditSpecificationId](ctx, d.Id.ValueString()).DbId
+	for _, action := range settings.Actions {
+		d.AuditActions = append(d.AuditActions, findConfiguredAction(ctx, configured, dbId, action))
+	}
+
+	return d
+}
+
+func (d resourceData) withIds(ctx context.Context, spec sql.DatabaseAuditSpecification) resourceData {
+	dbId := spec.GetDb(ctx).GetId(ctx)
+	d.Id = types.StringValue(common.DbObjectId[sql.DatabaseAuditSpecificationId]{DbId: dbId, ObjectId: spec.GetId(ctx)}.String())
+	d.DatabaseId = types.StringValue(fmt.Sprint(dbId))
+	return d
+}
+
+// findConfiguredAction returns configured action equivalent to the one read from DB, so differences in quoting
+// or casing introduced by SQL Server do not show up as changes.
+func findConfiguredAction(ctx context.Context, configured []auditActionData, dbId sql.DatabaseId, action sql.DatabaseAuditAction) auditActionData {
+	normalize := func(securable string) string {
+		return strings.ToUpper(strings.NewReplacer("[", "", "]", "").Replace(securable))
+	}
+
+	for _, conf := range configured {
+		confAction := conf.toAction(ctx)
+		if strings.EqualFold(confAction.Action, action.Action) && normalize(confAction.Securable) == normalize(action.Securable) && confAction.PrincipalId == action.PrincipalId {
+			return conf
+		}
+	}
+
+	return auditActionData{
+		Action:      types.StringValue(action.Action),
+		Securable:   types.StringValue(action.Securable),
+		PrincipalId: types.StringValue(common.DbObjectId[sql.GenericDatabasePrincipalId]{DbId: dbId, ObjectId: action.PrincipalId}.String()),
+	}
+}
+
+func equalIgnoreCase(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	values := map[string]bool{}
+	for _, v := range a {
+		values[strings.ToUpper(v)] = true
+	}
+
+	for _, v := range b {
+		if !values[strings.ToUpper(v)] {
+			return false
+		}
+	}
+
+	return true
+}
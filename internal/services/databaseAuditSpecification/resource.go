@@ -0,0 +1,170 @@
+package databaseAuditSpecification
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/planModifiers"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "database_audit_specification"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages database audit specification, selecting database-level action groups and actions collected by `mssql_server_audit`.\n\n" +
+		"-> **Note** Azure SQL Database does not support database audit specifications. Use Azure auditing settings instead."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"database_id": schema.StringAttribute{
+			MarkdownDescription: common.AttributeDescriptions["database_id"] + " Defaults to ID of `master`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.AuditNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"audit_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["audit_id"],
+			Required:            true,
+		},
+		"action_groups": schema.SetAttribute{
+			MarkdownDescription: attrDescriptions["action_groups"],
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"audit_actions": schema.SetNestedAttribute{
+			MarkdownDescription: attrDescriptions["audit_actions"],
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"action": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["action"],
+						Required:            true,
+					},
+					"securable": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["securable"],
+						Required:            true,
+					},
+					"principal_id": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["principal_id"],
+						Required:            true,
+					},
+				},
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: attrDescriptions["enabled"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				planModifiers.BoolDefault(true),
+			},
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var (
+		db       sql.Database
+		settings sql.DatabaseAuditSpecificationSettings
+		spec     sql.DatabaseAuditSpecification
+	)
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.Plan.DatabaseId.ValueString()) }).
+		Then(func() { settings = req.Plan.toSettings(ctx) }).
+		Then(func() { spec = sql.CreateDatabaseAuditSpecification(ctx, db, settings) }).
+		Then(func() {
+			state := req.Plan.withIds(ctx, spec)
+			resp.State = state.withSettings(ctx, spec.GetSettings(ctx))
+		})
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		spec   sql.DatabaseAuditSpecification
+		exists bool
+	)
+
+	req.
+		Then(func() { spec = getSpecification(ctx, req.Conn, req.State) }).
+		Then(func() { exists = spec.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				state := req.State.withIds(ctx, spec)
+				resp.SetState(state.withSettings(ctx, spec.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var (
+		settings sql.DatabaseAuditSpecificationSettings
+		spec     sql.DatabaseAuditSpecification
+	)
+
+	req.
+		Then(func() { settings = req.Plan.toSettings(ctx) }).
+		Then(func() { spec = getSpecification(ctx, req.Conn, req.Plan) }).
+		Then(func() { spec.UpdateSettings(ctx, settings) }).
+		Then(func() { resp.State = req.Plan.withSettings(ctx, spec.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var spec sql.DatabaseAuditSpecification
+
+	req.
+		Then(func() { spec = getSpecification(ctx, req.Conn, req.State) }).
+		Then(func() { spec.Drop(ctx) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	if req.Config.ActionGroups == nil && req.Config.AuditActions == nil {
+		utils.AddAttributeError(ctx, path.Root("action_groups"), "Missing audited actions", "At least one of action_groups or audit_actions must be set")
+	}
+
+	for _, action := range req.Config.AuditActions {
+		if !common.IsAttrSet(action.Securable) {
+			continue
+		}
+
+		parts := strings.SplitN(action.Securable.ValueString(), "::", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			utils.AddAttributeError(ctx, path.Root("audit_actions"), "Invalid securable", fmt.Sprintf("Securable %q must be in form of `<class>::<name>`, e.g. `OBJECT::dbo.Orders`", action.Securable.ValueString()))
+		}
+	}
+}
+
+func getSpecification(ctx context.Context, conn sql.Connection, data resourceData) sql.DatabaseAuditSpecification {
+	id := common.ParseDbObjectId[sql.DatabaseAuditSpecificationId](ctx, data.Id.ValueString())
+	db := sql.GetDatabase(ctx, conn, id.DbId)
+	return sql.GetDatabaseAuditSpecification(ctx, db, id.ObjectId)
+}
@@ -0,0 +1,123 @@
+package databaseAuditSpecification
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	if testCtx.IsAzureTest {
+		return
+	}
+
+	testCtx.ExecDefaultDB("IF OBJECT_ID('dbo.audited_orders') IS NULL CREATE TABLE [dbo].[audited_orders] ([id] INT)")
+
+	newResource := func(action string, enabled bool) string {
+		return fmt.Sprintf(`
+data "mssql_database_role" "public" {
+	name = "public"
+	database_id = %[1]d
+}
+
+resource "mssql_server_audit" "test" {
+	name = "test_db_audit_spec_audit"
+	destination = "APPLICATION_LOG"
+}
+
+resource "mssql_database_audit_specification" "test" {
+	database_id = %[1]d
+	name = "test_db_audit_spec"
+	audit_id = mssql_server_audit.test.id
+	action_groups = ["SCHEMA_OBJECT_CHANGE_GROUP"]
+	audit_actions = [
+		{
+			action = %[2]q
+			securable = "OBJECT::dbo.audited_orders"
+			principal_id = data.mssql_database_role.public.id
+		}
+	]
+	enabled = %[3]t
+}
+`, testCtx.DefaultDBId, action, enabled)
+	}
+
+	var specId string
+
+	fetchSpec := func(conn *sql.DB) (string, bool, []string, error) {
+		var (
+			id      string
+			enabled bool
+			actions []string
+		)
+
+		err := conn.QueryRow("SELECT [database_specification_id], [is_state_enabled] FROM sys.database_audit_specifications WHERE [name]='test_db_audit_spec'").
+			Scan(&id, &enabled)
+		if err != nil {
+			return id, enabled, actions, err
+		}
+
+		rows, err := conn.Query("SELECT [audit_action_name] FROM sys.database_audit_specification_details WHERE [database_specification_id]=@p1 ORDER BY [audit_action_name]", id)
+		if err != nil {
+			return id, enabled, actions, err
+		}
+
+		for rows.Next() {
+			var action string
+			if err := rows.Scan(&action); err != nil {
+				return id, enabled, actions, err
+			}
+			actions = append(actions, action)
+		}
+
+		return id, enabled, actions, rows.Err()
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("SELECT", true),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+						id, enabled, actions, err := fetchSpec(conn)
+						specId = testCtx.DefaultDbId(id)
+
+						testCtx.Assert.True(enabled, "enabled")
+						testCtx.Assert.Equal([]string{"SCHEMA_OBJECT_CHANGE_GROUP", "SELECT"}, actions, "actions")
+
+						return err
+					}),
+					resource.TestCheckResourceAttrPtr("mssql_database_audit_specification.test", "id", &specId),
+				),
+			},
+			{
+				Config: newResource("DELETE", false),
+				Check: testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+					_, enabled, actions, err := fetchSpec(conn)
+
+					testCtx.Assert.False(enabled, "enabled")
+					testCtx.Assert.Equal([]string{"DELETE", "SCHEMA_OBJECT_CHANGE_GROUP"}, actions, "actions")
+
+					return err
+				}),
+			},
+			{
+				PreConfig: func() {
+					testCtx.ExecDefaultDB("ALTER DATABASE AUDIT SPECIFICATION [test_db_audit_spec] WITH (STATE = ON)")
+				},
+				Config:             newResource("DELETE", false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: newResource("DELETE", false),
+			},
+			{
+				ResourceName:      "mssql_database_audit_specification.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package databaseEncryptionKey
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+)
+
+const defaultAlgorithm = "AES_256"
+
+var algorithms = []string{"AES_128", "AES_192", "AES_256", "TRIPLE_DES_3KEY"}
+
+var attrDescriptions = map[string]string{
+	"id":                    "ID of the encrypted database. There can be only one encryption key per database.",
+	"database_id":           common.AttributeDescriptions["database_id"],
+	"algorithm":             "Encryption algorithm. One of `AES_128`, `AES_192`, `AES_256`, `TRIPLE_DES_3KEY`. Defaults to `AES_256`. Changing the algorithm regenerates the key.",
+	"server_certificate_id": "ID of the server certificate protecting the key. Can be retrieved using `mssql_server_certificate` or `SELECT certificate_id FROM master.sys.certificates WHERE [name]='<certificate_name>'`.",
+	"encryption_enabled": "When `true`, Transparent Data Encryption is enabled for the database. Enabling or disabling the encryption waits until the whole database gets encrypted or decrypted, " +
+		"as reported by `sys.dm_database_encryption_keys`. Defaults to current state of the database.",
+}
+
+type resourceData struct {
+	Id                  types.String `tfsdk:"id"`
+	DatabaseId          types.String `tfsdk:"database_id"`
+	Algorithm           types.String `tfsdk:"algorithm"`
+	ServerCertificateId types.String `tfsdk:"server_certificate_id"`
+	EncryptionEnabled   types.Bool   `tfsdk:"encryption_enabled"`
+}
+
+func (d resourceData) toSettings(ctx context.Context) sql.DatabaseEncryptionKeySettings {
+	settings := sql.DatabaseEncryptionKeySettings{
+		Algorithm: d.Algorithm.ValueString(),
+	}
+
+	if !common.IsAttrSet(d.Algorithm) {
+		settings.Algorithm = defaultAlgorithm
+	}
+
+	certId, err := strconv.Atoi(d.ServerCertificateId.ValueString())
+	utils.AddError(ctx, fmt.Sprintf("Failed to convert server certificate ID '%s'", d.ServerCertificateId.ValueString()), err)
+	settings.ServerCertificateId = sql.CertificateId(certId)
+
+	return settings
+}
+
+func (d resourceData) withSettings(settings sql.DatabaseEncryptionKeySettings) resourceData {
+	d.Algorithm = types.StringValue(settings.Algorithm)
+	d.ServerCertificateId = types.StringValue(fmt.Sprint(settings.ServerCertificateId))
+	return d
+}
@@ -0,0 +1,164 @@
+package databaseEncryptionKey
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "database_encryption_key"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages database encryption key used by Transparent Data Encryption and, optionally, enables the encryption of the database.\n\n" +
+		"-> **Note** Encryption key is protected by a server certificate (see `mssql_server_certificate`). " +
+		"When the resource is destroyed, the encryption gets disabled first and the key is dropped once the database is fully decrypted. " +
+		"Not supported by Azure SQL Database, where TDE is managed by the service."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"database_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["database_id"],
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"algorithm": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["algorithm"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"server_certificate_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["server_certificate_id"],
+			Required:            true,
+		},
+		"encryption_enabled": schema.BoolAttribute{
+			MarkdownDescription: attrDescriptions["encryption_enabled"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var (
+		db  sql.Database
+		key sql.DatabaseEncryptionKey
+	)
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.Plan.DatabaseId.ValueString()) }).
+		Then(func() { key = sql.CreateDatabaseEncryptionKey(ctx, db, req.Plan.toSettings(ctx)) }).
+		Then(func() {
+			if req.Plan.EncryptionEnabled.ValueBool() {
+				db.SetEncryption(ctx, true)
+			}
+		}).
+		Then(func() {
+			resp.State = req.Plan.withSettings(key.GetSettings(ctx))
+			resp.State.Id = types.StringValue(fmt.Sprint(db.GetId(ctx)))
+			resp.State.DatabaseId = resp.State.Id
+			resp.State.EncryptionEnabled = types.BoolValue(db.IsEncrypted(ctx))
+		})
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		db     sql.Database
+		key    sql.DatabaseEncryptionKey
+		exists bool
+	)
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.State.Id.ValueString()) }).
+		Then(func() { exists = db.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				key = sql.GetDatabaseEncryptionKey(ctx, db)
+				exists = key.Exists(ctx)
+			}
+		}).
+		Then(func() {
+			if exists {
+				state := req.State.withSettings(key.GetSettings(ctx))
+				state.DatabaseId = state.Id
+				state.EncryptionEnabled = types.BoolValue(db.IsEncrypted(ctx))
+				resp.SetState(state)
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var (
+		db  sql.Database
+		key sql.DatabaseEncryptionKey
+	)
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.Plan.Id.ValueString()) }).
+		Then(func() {
+			key = sql.GetDatabaseEncryptionKey(ctx, db)
+			key.UpdateSettings(ctx, req.Plan.toSettings(ctx))
+		}).
+		Then(func() {
+			enabled := req.Plan.EncryptionEnabled
+			if common.IsAttrSet(enabled) && enabled.ValueBool() != req.State.EncryptionEnabled.ValueBool() {
+				db.SetEncryption(ctx, enabled.ValueBool())
+			}
+		}).
+		Then(func() {
+			resp.State = req.Plan.withSettings(key.GetSettings(ctx))
+			resp.State.EncryptionEnabled = types.BoolValue(db.IsEncrypted(ctx))
+		})
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var db sql.Database
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.State.Id.ValueString()) }).
+		Then(func() {
+			if db.IsEncrypted(ctx) {
+				db.SetEncryption(ctx, false)
+			}
+		}).
+		Then(func() { sql.GetDatabaseEncryptionKey(ctx, db).Drop(ctx) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	if !common.IsAttrSet(req.Config.Algorithm) {
+		return
+	}
+
+	for _, algorithm := range algorithms {
+		if req.Config.Algorithm.ValueString() == algorithm {
+			return
+		}
+	}
+
+	utils.AddAttributeError(ctx, path.Root("algorithm"), "Invalid encryption algorithm", fmt.Sprintf("Algorithm %q is not supported", req.Config.Algorithm.ValueString()))
+}
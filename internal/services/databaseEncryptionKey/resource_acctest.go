@@ -0,0 +1,84 @@
+package databaseEncryptionKey
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	if testCtx.IsAzureTest {
+		return
+	}
+
+	testCtx.ExecMasterDB("IF NOT EXISTS (SELECT 1 FROM sys.symmetric_keys WHERE [name]='##MS_DatabaseMasterKey##') CREATE MASTER KEY ENCRYPTION BY PASSWORD='Str0ngMasterKeyPa$$w0rd'")
+	dbId := testCtx.CreateDB("tde_test")
+
+	newResource := func(algorithm string, encryptionEnabled bool) string {
+		return fmt.Sprintf(`
+resource "mssql_server_certificate" "test" {
+	name = "tde_test_cert"
+	subject = "TDE test certificate"
+}
+
+resource "mssql_database_encryption_key" "test" {
+	database_id = %d
+	algorithm = %q
+	server_certificate_id = mssql_server_certificate.test.id
+	encryption_enabled = %t
+}
+`, dbId, algorithm, encryptionEnabled)
+	}
+
+	checkEncryption := func(algorithm string, encrypted bool) resource.TestCheckFunc {
+		return testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+			var (
+				keyAlgorithm string
+				keyLength    int
+				isEncrypted  bool
+			)
+
+			err := conn.QueryRow("SELECT dek.[key_algorithm], dek.[key_length], db.[is_encrypted] FROM sys.dm_database_encryption_keys dek JOIN sys.databases db ON db.[database_id] = dek.[database_id] WHERE dek.[database_id] = @p1", dbId).
+				Scan(&keyAlgorithm, &keyLength, &isEncrypted)
+
+			testCtx.Assert.Equal(algorithm, fmt.Sprintf("%s_%d", keyAlgorithm, keyLength), "algorithm")
+			testCtx.Assert.Equal(encrypted, isEncrypted, "is_encrypted")
+
+			return err
+		})
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("AES_256", true),
+				Check: resource.ComposeTestCheckFunc(
+					checkEncryption("AES_256", true),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mssql_database_encryption_key.test", "id", fmt.Sprint(dbId)),
+						resource.TestCheckResourceAttrPair("mssql_database_encryption_key.test", "server_certificate_id", "mssql_server_certificate.test", "id"),
+						resource.TestCheckResourceAttr("mssql_database_encryption_key.test", "encryption_enabled", "true"),
+					),
+				),
+			},
+			{
+				Config: newResource("AES_128", true),
+				Check:  checkEncryption("AES_128", true),
+			},
+			{
+				Config: newResource("AES_128", false),
+				Check: resource.ComposeTestCheckFunc(
+					checkEncryption("AES_128", false),
+					resource.TestCheckResourceAttr("mssql_database_encryption_key.test", "encryption_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "mssql_database_encryption_key.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprint(dbId),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
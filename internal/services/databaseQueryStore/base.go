@@ -0,0 +1,90 @@
+package databaseQueryStore
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var operationModes = []string{sql.QueryStoreOff, sql.QueryStoreReadOnly, sql.QueryStoreReadWrite}
+
+var captureModes = []string{"ALL", "AUTO", "NONE", "CUSTOM"}
+
+var intervalLengths = []int64{1, 5, 10, 15, 30, 60, 1440}
+
+var attrDescriptions = map[string]string{
+	"id":                         "ID of the database.",
+	"database_id":                common.AttributeDescriptions["database_id"],
+	"operation_mode":             "Desired Query Store operation mode. One of `OFF`, `READ_ONLY`, `READ_WRITE`. Defaults to `READ_WRITE`.",
+	"query_capture_mode":         "Query capture mode. One of `ALL`, `AUTO`, `NONE`, `CUSTOM`. Defaults to current setting of the database.",
+	"max_storage_size_mb":        "Maximum size of Query Store data, in megabytes. Defaults to current setting of the database.",
+	"stale_query_threshold_days": "Number of days Query Store retains data of a query. Defaults to current setting of the database.",
+	"interval_length_minutes":    "Length of runtime statistics aggregation interval, in minutes. One of `1`, `5`, `10`, `15`, `30`, `60`, `1440`. Defaults to current setting of the database.",
+	"wait_stats_capture_mode":    "When `true`, wait statistics are captured per query. Defaults to current setting of the database.",
+	"actual_state":               "Operation mode Query Store is actually in, as reported by `actual_state_desc` column of `sys.database_query_store_options`. Can differ from `operation_mode` e.g. when storage size limit has been reached.",
+}
+
+type resourceData struct {
+	Id                      types.String `tfsdk:"id"`
+	DatabaseId              types.String `tfsdk:"database_id"`
+	OperationMode           types.String `tfsdk:"operation_mode"`
+	QueryCaptureMode        types.String `tfsdk:"query_capture_mode"`
+	MaxStorageSizeMb        types.Int64  `tfsdk:"max_storage_size_mb"`
+	StaleQueryThresholdDays types.Int64  `tfsdk:"stale_query_threshold_days"`
+	IntervalLengthMinutes   types.Int64  `tfsdk:"interval_length_minutes"`
+	WaitStatsCaptureMode    types.Bool   `tfsdk:"wait_stats_capture_mode"`
+	ActualState             types.String `tfsdk:"actual_state"`
+}
+
+// toSettings overrides current settings of the database with attributes set in the plan.
+func (d resourceData) toSettings(current sql.QueryStoreSettings) sql.QueryStoreSettings {
+	settings := current
+	settings.OperationMode = sql.QueryStoreReadWrite
+
+	if common.IsAttrSet(d.OperationMode) {
+		settings.OperationMode = d.OperationMode.ValueString()
+	}
+
+	if common.IsAttrSet(d.QueryCaptureMode) {
+		settings.QueryCaptureMode = d.QueryCaptureMode.ValueString()
+	}
+
+	if common.IsAttrSet(d.MaxStorageSizeMb) {
+		settings.MaxStorageSizeMb = int(d.MaxStorageSizeMb.ValueInt64())
+	}
+
+	if common.IsAttrSet(d.StaleQueryThresholdDays) {
+		settings.StaleQueryThresholdDays = int(d.StaleQueryThresholdDays.ValueInt64())
+	}
+
+	if common.IsAttrSet(d.IntervalLengthMinutes) {
+		settings.IntervalLengthMinutes = int(d.IntervalLengthMinutes.ValueInt64())
+	}
+
+	if common.IsAttrSet(d.WaitStatsCaptureMode) {
+		settings.WaitStatsCaptureMode = d.WaitStatsCaptureMode.ValueBool()
+	}
+
+	return settings
+}
+
+func (d resourceData) withSettings(settings sql.QueryStoreSettings, status sql.QueryStoreStatus) resourceData {
+	d.OperationMode = types.StringValue(settings.OperationMode)
+	d.QueryCaptureMode = types.StringValue(settings.QueryCaptureMode)
+	d.MaxStorageSizeMb = types.Int64Value(int64(settings.MaxStorageSizeMb))
+	d.StaleQueryThresholdDays = types.Int64Value(int64(settings.StaleQueryThresholdDays))
+	d.IntervalLengthMinutes = types.Int64Value(int64(settings.IntervalLengthMinutes))
+	d.WaitStatsCaptureMode = types.BoolValue(settings.WaitStatsCaptureMode)
+	d.ActualState = types.StringValue(status.ActualState)
+	return d
+}
+
+func warnIfStorageFull(ctx context.Context, status sql.QueryStoreStatus) {
+	if status.IsStorageFull() {
+		utils.AddWarning(ctx, "Query Store is read-only due to size",
+			fmt.Sprintf("Query Store reached its maximum storage size (%d MB in use) and stopped capturing new data. Increase max_storage_size_mb or clean up Query Store data.", status.CurrentStorageSize))
+	}
+}
@@ -0,0 +1,186 @@
+package databaseQueryStore
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "database_query_store"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages Query Store configuration of a database. Settings are read from `sys.database_query_store_options`. " +
+		"A warning is reported when Query Store switched to read-only mode because it reached `max_storage_size_mb`.\n\n" +
+		"-> **Note** When the resource is destroyed, Query Store gets turned `OFF`."
+
+	stringAttr := func(name string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: attrDescriptions[name],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	int64Attr := func(name string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: attrDescriptions[name],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"database_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["database_id"],
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"operation_mode":             stringAttr("operation_mode"),
+		"query_capture_mode":         stringAttr("query_capture_mode"),
+		"max_storage_size_mb":        int64Attr("max_storage_size_mb"),
+		"stale_query_threshold_days": int64Attr("stale_query_threshold_days"),
+		"interval_length_minutes":    int64Attr("interval_length_minutes"),
+		"wait_stats_capture_mode": schema.BoolAttribute{
+			MarkdownDescription: attrDescriptions["wait_stats_capture_mode"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"actual_state": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["actual_state"],
+			Computed:            true,
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var db sql.Database
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.Plan.DatabaseId.ValueString()) }).
+		Then(func() {
+			resp.State = r.apply(ctx, db, req.Plan)
+			resp.State.Id = types.StringValue(fmt.Sprint(db.GetId(ctx)))
+		})
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		db         sql.Database
+		queryStore sql.QueryStore
+		exists     bool
+	)
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.State.Id.ValueString()) }).
+		Then(func() { exists = db.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				queryStore = sql.GetQueryStore(ctx, db)
+				status := queryStore.GetStatus(ctx)
+				warnIfStorageFull(ctx, status)
+
+				state := req.State.withSettings(queryStore.GetSettings(ctx), status)
+				state.DatabaseId = state.Id
+				resp.SetState(state)
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var db sql.Database
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.Plan.Id.ValueString()) }).
+		Then(func() { resp.State = r.apply(ctx, db, req.Plan) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var db sql.Database
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.State.Id.ValueString()) }).
+		Then(func() {
+			sql.GetQueryStore(ctx, db).UpdateSettings(ctx, sql.QueryStoreSettings{OperationMode: sql.QueryStoreOff})
+		})
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	validateString := func(attrName string, value types.String, allowed []string) {
+		if !common.IsAttrSet(value) {
+			return
+		}
+
+		for _, a := range allowed {
+			if value.ValueString() == a {
+				return
+			}
+		}
+
+		utils.AddAttributeError(ctx, path.Root(attrName), "Invalid attribute value", fmt.Sprintf("Value %q is not supported", value.ValueString()))
+	}
+
+	validateString("operation_mode", req.Config.OperationMode, operationModes)
+	validateString("query_capture_mode", req.Config.QueryCaptureMode, captureModes)
+
+	if common.IsAttrSet(req.Config.IntervalLengthMinutes) {
+		valid := false
+		for _, length := range intervalLengths {
+			valid = valid || req.Config.IntervalLengthMinutes.ValueInt64() == length
+		}
+
+		if !valid {
+			utils.AddAttributeError(ctx, path.Root("interval_length_minutes"), "Invalid interval length", fmt.Sprintf("Interval length of %d minutes is not supported", req.Config.IntervalLengthMinutes.ValueInt64()))
+		}
+	}
+}
+
+func (r *res) apply(ctx context.Context, db sql.Database, plan resourceData) resourceData {
+	var (
+		queryStore = sql.GetQueryStore(ctx, db)
+		current    sql.QueryStoreSettings
+		status     sql.QueryStoreStatus
+	)
+
+	utils.StopOnError(ctx).
+		Then(func() { current = queryStore.GetSettings(ctx) }).
+		Then(func() { queryStore.UpdateSettings(ctx, plan.toSettings(current)) }).
+		Then(func() { status = queryStore.GetStatus(ctx) }).
+		Then(func() {
+			warnIfStorageFull(ctx, status)
+			plan = plan.withSettings(queryStore.GetSettings(ctx), status)
+		})
+
+	return plan
+}
@@ -0,0 +1,79 @@
+package databaseQueryStore
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	dbId := testCtx.CreateDB("query_store_test")
+
+	newResource := func(operationMode string, captureMode string, maxStorage int) string {
+		return fmt.Sprintf(`
+resource "mssql_database_query_store" "test" {
+	database_id                = %d
+	operation_mode             = %q
+	query_capture_mode         = %q
+	max_storage_size_mb        = %d
+	stale_query_threshold_days = 14
+	interval_length_minutes    = 15
+}
+`, dbId, operationMode, captureMode, maxStorage)
+	}
+
+	checkQueryStore := func(operationMode string, captureMode string, maxStorage int) resource.TestCheckFunc {
+		return testCtx.SqlCheck("query_store_test", func(conn *sql.DB) error {
+			var (
+				actualMode, actualCapture          string
+				actualStorage, staleDays, interval int
+			)
+
+			err := conn.QueryRow("SELECT [desired_state_desc], [query_capture_mode_desc], [max_storage_size_mb], [stale_query_threshold_days], [interval_length_minutes] FROM sys.database_query_store_options").
+				Scan(&actualMode, &actualCapture, &actualStorage, &staleDays, &interval)
+
+			testCtx.Assert.Equal(operationMode, actualMode, "operation_mode")
+			testCtx.Assert.Equal(captureMode, actualCapture, "query_capture_mode")
+			testCtx.Assert.Equal(maxStorage, actualStorage, "max_storage_size_mb")
+			testCtx.Assert.Equal(14, staleDays, "stale_query_threshold_days")
+			testCtx.Assert.Equal(15, interval, "interval_length_minutes")
+
+			return err
+		})
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("READ_WRITE", "AUTO", 500),
+				Check: resource.ComposeTestCheckFunc(
+					checkQueryStore("READ_WRITE", "AUTO", 500),
+					resource.TestCheckResourceAttr("mssql_database_query_store.test", "id", fmt.Sprint(dbId)),
+					resource.TestCheckResourceAttr("mssql_database_query_store.test", "actual_state", "READ_WRITE"),
+					resource.TestCheckResourceAttrSet("mssql_database_query_store.test", "wait_stats_capture_mode"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testCtx.ExecDB("query_store_test", "ALTER DATABASE CURRENT SET QUERY_STORE (QUERY_CAPTURE_MODE = ALL)")
+				},
+				Config:             newResource("READ_WRITE", "AUTO", 500),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: newResource("READ_ONLY", "ALL", 1000),
+				Check: resource.ComposeTestCheckFunc(
+					checkQueryStore("READ_ONLY", "ALL", 1000),
+					resource.TestCheckResourceAttr("mssql_database_query_store.test", "actual_state", "READ_ONLY"),
+				),
+			},
+			{
+				ResourceName:      "mssql_database_query_store.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
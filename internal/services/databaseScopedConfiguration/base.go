@@ -0,0 +1,104 @@
+package databaseScopedConfiguration
+
+import (
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"strings"
+)
+
+var attrDescriptions = map[string]string{
+	"id":          "ID of the configured database.",
+	"database_id": common.AttributeDescriptions["database_id"],
+	"configurations": "Map of configuration names, e.g. `MAXDOP` or `LEGACY_CARDINALITY_ESTIMATION`, to values applied on the primary replica. " +
+		"Configuration names are case-insensitive. `ON` and `OFF` values are equivalent to `1` and `0` reported by `sys.database_scoped_configurations`.",
+	"secondary_configurations": "Map of configuration names to values applied on secondary replicas (`FOR SECONDARY`). Value `PRIMARY` makes secondary replicas use the primary value.",
+}
+
+type resourceData struct {
+	Id                      types.String      `tfsdk:"id"`
+	DatabaseId              types.String      `tfsdk:"database_id"`
+	Configurations          map[string]string `tfsdk:"configurations"`
+	SecondaryConfigurations map[string]string `tfsdk:"secondary_configurations"`
+}
+
+func (d resourceData) withConfigurations(configs []sql.DatabaseScopedConfiguration) resourceData {
+	if d.Configurations == nil && d.SecondaryConfigurations == nil {
+		return d.withImportedConfigurations(configs)
+	}
+
+	refresh := func(configured map[string]string, getValue func(sql.DatabaseScopedConfiguration) string) map[string]string {
+		if configured == nil {
+			return nil
+		}
+
+		values := map[string]string{}
+		for name, value := range configured {
+			if config, ok := findConfiguration(configs, name); ok {
+				if actual := getValue(config); sql.ScopedConfigurationValuesEqual(value, actual) {
+					values[name] = value
+				} else {
+					values[name] = actual
+				}
+			}
+		}
+
+		return values
+	}
+
+	d.Configurations = refresh(d.Configurations, func(c sql.DatabaseScopedConfiguration) string { return c.Value })
+	d.SecondaryConfigurations = refresh(d.SecondaryConfigurations, func(c sql.DatabaseScopedConfiguration) string { return c.ValueForSecondary })
+	return d
+}
+
+// withImportedConfigurations populates state with all configurations differing from their defaults.
+func (d resourceData) withImportedConfigurations(configs []sql.DatabaseScopedConfiguration) resourceData {
+	for _, config := range configs {
+		if !config.IsDefault {
+			if d.Configurations == nil {
+				d.Configurations = map[string]string{}
+			}
+			d.Configurations[config.Name] = config.Value
+		}
+
+		if config.ValueForSecondary != sql.DatabaseScopedConfigurationPrimary {
+			if d.SecondaryConfigurations == nil {
+				d.SecondaryConfigurations = map[string]string{}
+			}
+			d.SecondaryConfigurations[config.Name] = config.ValueForSecondary
+		}
+	}
+
+	return d
+}
+
+func findConfiguration(configs []sql.DatabaseScopedConfiguration, name string) (sql.DatabaseScopedConfiguration, bool) {
+	for _, config := range configs {
+		if strings.EqualFold(config.Name, name) {
+			return config, true
+		}
+	}
+
+	return sql.DatabaseScopedConfiguration{}, false
+}
+
+func containsKey(m map[string]string, key string) bool {
+	for k := range m {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
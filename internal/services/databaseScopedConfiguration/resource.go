@@ -0,0 +1,156 @@
+package databaseScopedConfiguration
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "database_scoped_configuration"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages database scoped configurations, set using `ALTER DATABASE SCOPED CONFIGURATION`.\n\n" +
+		"-> **Note** Destroying the resource or removing configuration from `configurations` leaves the current value in place. " +
+		"Configurations removed from `secondary_configurations` are reset to `PRIMARY`."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"database_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["database_id"],
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"configurations": schema.MapAttribute{
+			MarkdownDescription: attrDescriptions["configurations"],
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"secondary_configurations": schema.MapAttribute{
+			MarkdownDescription: attrDescriptions["secondary_configurations"],
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var db sql.Database
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.Plan.DatabaseId.ValueString()) }).
+		Then(func() { r.apply(ctx, db, req.Plan, resourceData{}) }).
+		Then(func() {
+			resp.State = req.Plan.withConfigurations(sql.GetDatabaseScopedConfigurations(ctx, db))
+			resp.State.Id = types.StringValue(fmt.Sprint(db.GetId(ctx)))
+		})
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		db     sql.Database
+		exists bool
+	)
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.State.Id.ValueString()) }).
+		Then(func() { exists = db.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				state := req.State.withConfigurations(sql.GetDatabaseScopedConfigurations(ctx, db))
+				state.DatabaseId = state.Id
+				resp.SetState(state)
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var db sql.Database
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.Plan.Id.ValueString()) }).
+		Then(func() { r.apply(ctx, db, req.Plan, req.State) }).
+		Then(func() { resp.State = req.Plan.withConfigurations(sql.GetDatabaseScopedConfigurations(ctx, db)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var db sql.Database
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.State.Id.ValueString()) }).
+		Then(func() { r.apply(ctx, db, resourceData{}, req.State) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	validate := func(attrName string, configs map[string]string) {
+		seen := map[string]string{}
+
+		for name := range configs {
+			if other, ok := seen[strings.ToUpper(name)]; ok {
+				utils.AddAttributeError(ctx, path.Root(attrName), "Duplicated configuration", fmt.Sprintf("Keys '%s' and '%s' refer to the same configuration", other, name))
+			}
+			seen[strings.ToUpper(name)] = name
+		}
+	}
+
+	validate("configurations", req.Config.Configurations)
+	validate("secondary_configurations", req.Config.SecondaryConfigurations)
+}
+
+// apply sets configurations which differ from the plan and resets secondary configurations removed since the previous state.
+func (r *res) apply(ctx context.Context, db sql.Database, plan resourceData, state resourceData) {
+	configs := sql.GetDatabaseScopedConfigurations(ctx, db)
+	if utils.HasError(ctx) {
+		return
+	}
+
+	set := func(name string, value string, forSecondary bool) {
+		config, ok := findConfiguration(configs, name)
+		if !ok {
+			utils.AddError(ctx, "Unknown database scoped configuration", fmt.Errorf("configuration '%s' does not exist in sys.database_scoped_configurations", name))
+			return
+		}
+
+		current := config.Value
+		if forSecondary {
+			current = config.ValueForSecondary
+		}
+
+		if !sql.ScopedConfigurationValuesEqual(value, current) {
+			sql.SetDatabaseScopedConfiguration(ctx, db, name, value, forSecondary)
+		}
+	}
+
+	for _, name := range sortedKeys(plan.Configurations) {
+		utils.StopOnError(ctx).Then(func() { set(name, plan.Configurations[name], false) })
+	}
+
+	for _, name := range sortedKeys(plan.SecondaryConfigurations) {
+		utils.StopOnError(ctx).Then(func() { set(name, plan.SecondaryConfigurations[name], true) })
+	}
+
+	for _, name := range sortedKeys(state.SecondaryConfigurations) {
+		if !containsKey(plan.SecondaryConfigurations, name) {
+			utils.StopOnError(ctx).Then(func() { set(name, sql.DatabaseScopedConfigurationPrimary, true) })
+		}
+	}
+}
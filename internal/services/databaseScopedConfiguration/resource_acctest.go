@@ -0,0 +1,77 @@
+package databaseScopedConfiguration
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	dbId := testCtx.CreateDB("scoped_config_test")
+
+	newResource := func(maxdop int, legacyCE string, secondaryMaxdop string) string {
+		return fmt.Sprintf(`
+resource "mssql_database_scoped_configuration" "test" {
+	database_id = %d
+
+	configurations = {
+		MAXDOP                        = "%d"
+		LEGACY_CARDINALITY_ESTIMATION = %q
+	}
+
+	secondary_configurations = {
+		MAXDOP = %q
+	}
+}
+`, dbId, maxdop, legacyCE, secondaryMaxdop)
+	}
+
+	checkConfiguration := func(name string, value string, valueForSecondary string) resource.TestCheckFunc {
+		return testCtx.SqlCheck("scoped_config_test", func(conn *sql.DB) error {
+			var actualValue, actualSecondary string
+			err := conn.QueryRow("SELECT CONVERT(NVARCHAR(MAX), [value]), ISNULL(CONVERT(NVARCHAR(MAX), [value_for_secondary]), 'PRIMARY') FROM sys.database_scoped_configurations WHERE [name]=@p1", name).
+				Scan(&actualValue, &actualSecondary)
+
+			testCtx.Assert.Equal(value, actualValue, name)
+			testCtx.Assert.Equal(valueForSecondary, actualSecondary, "%s for secondary", name)
+
+			return err
+		})
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource(4, "ON", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					checkConfiguration("MAXDOP", "4", "1"),
+					checkConfiguration("LEGACY_CARDINALITY_ESTIMATION", "1", "PRIMARY"),
+					resource.TestCheckResourceAttr("mssql_database_scoped_configuration.test", "id", fmt.Sprint(dbId)),
+					resource.TestCheckResourceAttr("mssql_database_scoped_configuration.test", "configurations.LEGACY_CARDINALITY_ESTIMATION", "ON"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testCtx.ExecDB("scoped_config_test", "ALTER DATABASE SCOPED CONFIGURATION SET MAXDOP = 2")
+				},
+				Config:             newResource(4, "ON", "1"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: newResource(8, "OFF", "PRIMARY"),
+				Check: resource.ComposeTestCheckFunc(
+					checkConfiguration("MAXDOP", "8", "PRIMARY"),
+					checkConfiguration("LEGACY_CARDINALITY_ESTIMATION", "0", "PRIMARY"),
+				),
+			},
+			{
+				ResourceName:            "mssql_database_scoped_configuration.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"configurations", "secondary_configurations"},
+			},
+		},
+	})
+}
@@ -0,0 +1,108 @@
+package databaseScopedCredential
+
+import (
+	"context"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "database_scoped_credential"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages database scoped credential, used e.g. by external data sources.\n\n" +
+		"-> **Note** The database must contain a master key before a database scoped credential can be created."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"database_id": schema.StringAttribute{
+			MarkdownDescription: common.AttributeDescriptions["database_id"] + " Defaults to ID of `master`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.CredentialNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"identity": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["identity"],
+			Required:            true,
+		},
+		"secret": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["secret"],
+			Optional:            true,
+			Sensitive:           true,
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var (
+		db   sql.Database
+		cred sql.DatabaseScopedCredential
+	)
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.Plan.DatabaseId.ValueString()) }).
+		Then(func() { cred = sql.CreateDatabaseScopedCredential(ctx, db, req.Plan.toSettings()) }).
+		Then(func() { resp.State = req.Plan.withIds(ctx, cred).withSettings(cred.GetSettings(ctx)) })
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		cred   sql.DatabaseScopedCredential
+		exists bool
+	)
+
+	req.
+		Then(func() { cred = getCredential(ctx, req.Conn, req.State) }).
+		Then(func() { exists = cred.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withIds(ctx, cred).withSettings(cred.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var cred sql.DatabaseScopedCredential
+
+	req.
+		Then(func() { cred = getCredential(ctx, req.Conn, req.Plan) }).
+		Then(func() { cred.UpdateSettings(ctx, req.Plan.toSettings()) }).
+		Then(func() { resp.State = req.Plan.withSettings(cred.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var cred sql.DatabaseScopedCredential
+
+	req.
+		Then(func() { cred = getCredential(ctx, req.Conn, req.State) }).
+		Then(func() { cred.Drop(ctx) })
+}
+
+func getCredential(ctx context.Context, conn sql.Connection, data resourceData) sql.DatabaseScopedCredential {
+	id := common.ParseDbObjectId[sql.DatabaseScopedCredentialId](ctx, data.Id.ValueString())
+	db := sql.GetDatabase(ctx, conn, id.DbId)
+	return sql.GetDatabaseScopedCredential(ctx, db, id.ObjectId)
+}
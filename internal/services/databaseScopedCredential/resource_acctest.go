@@ -0,0 +1,87 @@
+package databaseScopedCredential
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	testCtx.ExecDefaultDB("IF NOT EXISTS (SELECT 1 FROM sys.symmetric_keys WHERE [name]='##MS_DatabaseMasterKey##') CREATE MASTER KEY ENCRYPTION BY PASSWORD='Str0ngMasterKeyPa$$w0rd'")
+
+	newResource := func(resName string, name string, identity string, secret string) string {
+		return fmt.Sprintf(`
+resource "mssql_database_scoped_credential" %[1]q {
+	database_id = %[5]d
+	name = %[2]q
+	identity = %[3]q
+	secret = %[4]q
+}
+`, resName, name, identity, secret, testCtx.DefaultDBId)
+	}
+
+	var credentialId string
+
+	fetchCredential := func(conn *sql.DB) (string, string, error) {
+		var id, identity string
+		err := conn.QueryRow("SELECT [credential_id], [credential_identity] FROM sys.database_scoped_credentials WHERE [name]='test_db_credential'").
+			Scan(&id, &identity)
+		return id, identity, err
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("test", "test_db_credential", "SHARED ACCESS SIGNATURE", "sv=2022-11-02&sig=first"),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+						id, identity, err := fetchCredential(conn)
+						credentialId = testCtx.DefaultDbId(id)
+
+						testCtx.Assert.Equal("SHARED ACCESS SIGNATURE", identity, "identity")
+
+						return err
+					}),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPtr("mssql_database_scoped_credential.test", "id", &credentialId),
+						resource.TestCheckResourceAttr("mssql_database_scoped_credential.test", "database_id", fmt.Sprint(testCtx.DefaultDBId)),
+					),
+				),
+			},
+			{
+				Config: newResource("test", "test_db_credential", "SHARED ACCESS SIGNATURE", "sv=2022-11-02&sig=rotated"),
+				Check: testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+					id, _, err := fetchCredential(conn)
+
+					testCtx.Assert.Equal(credentialId, testCtx.DefaultDbId(id), "credential should not be recreated")
+
+					return err
+				}),
+			},
+			{
+				PreConfig: func() {
+					testCtx.ExecDefaultDB("ALTER DATABASE SCOPED CREDENTIAL [test_db_credential] WITH IDENTITY = 'changed_outside'")
+				},
+				Config: newResource("test", "test_db_credential", "SHARED ACCESS SIGNATURE", "sv=2022-11-02&sig=rotated"),
+				Check: testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+					_, identity, err := fetchCredential(conn)
+
+					testCtx.Assert.Equal("SHARED ACCESS SIGNATURE", identity, "identity drift should be reverted")
+
+					return err
+				}),
+			},
+			{
+				ResourceName:      "mssql_database_scoped_credential.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return credentialId, nil
+				},
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
@@ -0,0 +1,165 @@
+package eventSession
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"strings"
+)
+
+var attrDescriptions = map[string]string{
+	"id": "`<session_id>` for server-scoped sessions or `<database_id>/<session_id>` for database-scoped sessions. " +
+		"Session ID can be retrieved using `SELECT event_session_id FROM sys.server_event_sessions WHERE [name]='<session_name>'` (or `sys.database_event_sessions` in case of database-scoped sessions).",
+	"database_id":   "ID of database in which database-scoped session is created (Azure SQL Database only). When not set, server-scoped session is created.",
+	"name":          "Name of the event session. Cannot be longer than 128 chars.",
+	"events":        "Set of events collected by the session.",
+	"event_name":    "Package-qualified name of the event, e.g. `sqlserver.rpc_completed`.",
+	"predicate":     "Predicate expression filtering collected events, e.g. `[duration] > 1000000`.",
+	"actions":       "Set of package-qualified actions executed when event is collected, e.g. `sqlserver.sql_text`.",
+	"targets":       "Set of targets consuming collected events.",
+	"target_name":   "Package-qualified name of the target, e.g. `package0.ring_buffer` or `package0.event_file`.",
+	"options":       "Target options, e.g. `filename` and `max_file_size` of `package0.event_file` or `max_memory` of `package0.ring_buffer`.",
+	"startup_state": "When `true`, the session is started automatically with SQL Server. Defaults to `false`.",
+	"running":       "When `false`, the session is stopped and no events are collected. Defaults to `true`.",
+}
+
+type eventData struct {
+	Name      types.String `tfsdk:"name"`
+	Predicate types.String `tfsdk:"predicate"`
+	Actions   []string     `tfsdk:"actions"`
+}
+
+func (d eventData) toEvent() sql.EventSessionEvent {
+	return sql.EventSessionEvent{
+		Name:      d.Name.ValueString(),
+		Predicate: d.Predicate.ValueString(),
+		Actions:   d.Actions,
+	}
+}
+
+type targetData struct {
+	Name    types.String      `tfsdk:"name"`
+	Options map[string]string `tfsdk:"options"`
+}
+
+func (d targetData) toTarget() sql.EventSessionTarget {
+	return sql.EventSessionTarget{
+		Name:    d.Name.ValueString(),
+		Options: d.Options,
+	}
+}
+
+type resourceData struct {
+	Id           types.String `tfsdk:"id"`
+	DatabaseId   types.String `tfsdk:"database_id"`
+	Name         types.String `tfsdk:"name"`
+	Events       []eventData  `tfsdk:"events"`
+	Targets      []targetData `tfsdk:"targets"`
+	StartupState types.Bool   `tfsdk:"startup_state"`
+	Running      types.Bool   `tfsdk:"running"`
+}
+
+func (d resourceData) toSettings() sql.EventSessionSettings {
+	settings := sql.EventSessionSettings{
+		Name:         d.Name.ValueString(),
+		StartupState: d.StartupState.ValueBool(),
+		Running:      d.Running.ValueBool() || !common.IsAttrSet(d.Running),
+	}
+
+	for _, event := range d.Events {
+		settings.Events = append(settings.Events, event.toEvent())
+	}
+
+	for _, target := range d.Targets {
+		settings.Targets = append(settings.Targets, target.toTarget())
+	}
+
+	return settings
+}
+
+// withSettings keeps configured events and targets equivalent to the ones read from DB, so normalization of
+// predicates and casing introduced by SQL Server do not show up as changes.
+func (d resourceData) withSettings(settings sql.EventSessionSettings) resourceData {
+	configuredEvents, configuredTargets := d.Events, d.Targets
+
+	d.Name = types.StringValue(settings.Name)
+	d.StartupState = types.BoolValue(settings.StartupState)
+	d.Running = types.BoolValue(settings.Running)
+	d.Events = nil
+	d.Targets = nil
+
+	for _, event := range settings.Events {
+		d.Events = append(d.Events, findConfiguredEvent(configuredEvents, event))
+	}
+
+	for _, target := range settings.Targets {
+		d.Targets = append(d.Targets, findConfiguredTarget(configuredTargets, target))
+	}
+
+	return d
+}
+
+func findConfiguredEvent(configured []eventData, event sql.EventSessionEvent) eventData {
+	for _, conf := range configured {
+		if conf.toEvent().IsEquivalent(event) {
+			return conf
+		}
+	}
+
+	data := eventData{
+		Name:      types.StringValue(event.Name),
+		Predicate: types.StringNull(),
+		Actions:   event.Actions,
+	}
+
+	if event.Predicate != "" {
+		data.Predicate = types.StringValue(event.Predicate)
+	}
+
+	return data
+}
+
+func findConfiguredTarget(configured []targetData, target sql.EventSessionTarget) targetData {
+	for _, conf := range configured {
+		if conf.toTarget().IsEquivalent(target) {
+			return conf
+		}
+	}
+
+	return targetData{
+		Name:    types.StringValue(target.Name),
+		Options: target.Options,
+	}
+}
+
+func (d resourceData) withIds(ctx context.Context, session sql.EventSession, db sql.Database) resourceData {
+	if db == nil {
+		d.Id = types.StringValue(fmt.Sprint(session.GetId(ctx)))
+		d.DatabaseId = types.StringNull()
+		return d
+	}
+
+	dbId := db.GetId(ctx)
+	d.Id = types.StringValue(common.DbObjectId[sql.EventSessionId]{DbId: dbId, ObjectId: session.GetId(ctx)}.String())
+	d.DatabaseId = types.StringValue(fmt.Sprint(dbId))
+	return d
+}
+
+// getSession returns session identified by resource ID, together with its database in case of database-scoped sessions.
+func getSession(ctx context.Context, conn sql.Connection, data resourceData) (sql.EventSession, sql.Database) {
+	idString := data.Id.ValueString()
+
+	if strings.Contains(idString, "/") {
+		id := common.ParseDbObjectId[sql.EventSessionId](ctx, idString)
+		db := sql.GetDatabase(ctx, conn, id.DbId)
+		return sql.GetDatabaseEventSession(ctx, db, id.ObjectId), db
+	}
+
+	id, err := strconv.Atoi(idString)
+	utils.AddError(ctx, fmt.Sprintf("Failed to convert resource ID '%s'", idString), err)
+	return sql.GetServerEventSession(ctx, conn, sql.EventSessionId(id)), nil
+}
@@ -0,0 +1,159 @@
+package eventSession
+
+import (
+	"context"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/planModifiers"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "event_session"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages Extended Events session. Server-scoped sessions are created by default. " +
+		"In Azure SQL Database, sessions are database-scoped and `database_id` must be set."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"database_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["database_id"],
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.EventSessionNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"events": schema.SetNestedAttribute{
+			MarkdownDescription: attrDescriptions["events"],
+			Required:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["event_name"],
+						Required:            true,
+					},
+					"predicate": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["predicate"],
+						Optional:            true,
+					},
+					"actions": schema.SetAttribute{
+						MarkdownDescription: attrDescriptions["actions"],
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+		},
+		"targets": schema.SetNestedAttribute{
+			MarkdownDescription: attrDescriptions["targets"],
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["target_name"],
+						Required:            true,
+					},
+					"options": schema.MapAttribute{
+						MarkdownDescription: attrDescriptions["options"],
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+		},
+		"startup_state": schema.BoolAttribute{
+			MarkdownDescription: attrDescriptions["startup_state"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				planModifiers.BoolDefault(false),
+			},
+		},
+		"running": schema.BoolAttribute{
+			MarkdownDescription: attrDescriptions["running"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				planModifiers.BoolDefault(true),
+			},
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var (
+		db      sql.Database
+		session sql.EventSession
+	)
+
+	req.
+		Then(func() {
+			if common.IsAttrSet(req.Plan.DatabaseId) {
+				db = common.GetResourceDb(ctx, req.Conn, req.Plan.DatabaseId.ValueString())
+			}
+		}).
+		Then(func() {
+			if db == nil {
+				session = sql.CreateServerEventSession(ctx, req.Conn, req.Plan.toSettings())
+			} else {
+				session = sql.CreateDatabaseEventSession(ctx, db, req.Plan.toSettings())
+			}
+		}).
+		Then(func() { resp.State = req.Plan.withIds(ctx, session, db).withSettings(session.GetSettings(ctx)) })
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		session sql.EventSession
+		db      sql.Database
+		exists  bool
+	)
+
+	req.
+		Then(func() { session, db = getSession(ctx, req.Conn, req.State) }).
+		Then(func() { exists = session.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withIds(ctx, session, db).withSettings(session.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var session sql.EventSession
+
+	req.
+		Then(func() { session, _ = getSession(ctx, req.Conn, req.Plan) }).
+		Then(func() { session.UpdateSettings(ctx, req.Plan.toSettings()) }).
+		Then(func() { resp.State = req.Plan.withSettings(session.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var session sql.EventSession
+
+	req.
+		Then(func() { session, _ = getSession(ctx, req.Conn, req.State) }).
+		Then(func() { session.Drop(ctx) })
+}
@@ -0,0 +1,117 @@
+package eventSession
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	scope, dbAttr, sqlCheck := "server", "", testCtx.SqlCheckMaster
+	if testCtx.IsAzureTest {
+		scope, dbAttr, sqlCheck = "database", fmt.Sprintf("database_id = %d", testCtx.DefaultDBId), testCtx.SqlCheckDefaultDB
+	}
+
+	newResource := func(predicate string, running bool) string {
+		return fmt.Sprintf(`
+resource "mssql_event_session" "test" {
+	%s
+	name = "test_event_session"
+	events = [
+		{
+			name = "sqlserver.rpc_completed"
+			predicate = %q
+			actions = ["sqlserver.sql_text"]
+		}
+	]
+	targets = [
+		{
+			name = "package0.ring_buffer"
+			options = {
+				max_memory = "4096"
+			}
+		}
+	]
+	running = %t
+}
+`, dbAttr, predicate, running)
+	}
+
+	fetchSession := func(conn *sql.DB) (string, bool, string, error) {
+		var (
+			id        string
+			running   bool
+			predicate string
+		)
+
+		err := conn.QueryRow(fmt.Sprintf(`
+SELECT s.[event_session_id], CAST(CASE WHEN x.[name] IS NULL THEN 0 ELSE 1 END AS BIT), ISNULL(CAST(e.[predicate] AS NVARCHAR(MAX)), '')
+FROM sys.%[1]s_event_sessions s
+INNER JOIN sys.%[1]s_event_session_events e ON e.[event_session_id] = s.[event_session_id]
+LEFT JOIN sys.%[2]s x ON x.[name] = s.[name]
+WHERE s.[name]='test_event_session'`, scope, map[string]string{"server": "dm_xe_sessions", "database": "dm_xe_database_sessions"}[scope])).
+			Scan(&id, &running, &predicate)
+
+		return id, running, predicate, err
+	}
+
+	var sessionId string
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("[duration] > 1000", true),
+				Check: resource.ComposeTestCheckFunc(
+					sqlCheck(func(conn *sql.DB) error {
+						id, running, predicate, err := fetchSession(conn)
+
+						sessionId = id
+						if testCtx.IsAzureTest {
+							sessionId = testCtx.DefaultDbId(id)
+						}
+
+						testCtx.Assert.True(running, "running")
+						testCtx.Assert.Contains(predicate, "1000", "predicate")
+
+						return err
+					}),
+					resource.TestCheckResourceAttrPtr("mssql_event_session.test", "id", &sessionId),
+				),
+			},
+			{
+				Config: newResource("[duration] > 5000", false),
+				Check: sqlCheck(func(conn *sql.DB) error {
+					_, running, predicate, err := fetchSession(conn)
+
+					testCtx.Assert.False(running, "running")
+					testCtx.Assert.Contains(predicate, "5000", "predicate")
+
+					return err
+				}),
+			},
+			{
+				PreConfig: func() {
+					stat := fmt.Sprintf("ALTER EVENT SESSION [test_event_session] ON %s STATE = START", scope)
+					if testCtx.IsAzureTest {
+						testCtx.ExecDefaultDB(stat)
+					} else {
+						testCtx.ExecMasterDB(stat)
+					}
+				},
+				Config:             newResource("[duration] > 5000", false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: newResource("[duration] > 5000", false),
+			},
+			{
+				ResourceName:            "mssql_event_session.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"events"},
+			},
+		},
+	})
+}
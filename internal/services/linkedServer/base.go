@@ -0,0 +1,89 @@
+package linkedServer
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+)
+
+var attrDescriptions = map[string]string{
+	"id":   "Linked server ID. Can be retrieved using `SELECT server_id FROM sys.servers WHERE [name]='<linked_server_name>'`.",
+	"name": "Name of the linked server. Cannot be longer than 128 chars.",
+	"product": "Product name of the OLE DB data source. When set to `SQL Server`, `name` is used as network name of the remote server and `provider_name` and `data_source` must not be set. " +
+		"Defaults to empty string.",
+	"provider_name":        "OLE DB provider used to connect to the data source, e.g. `MSOLEDBSQL`.",
+	"data_source":          "Name of the data source, as understood by the provider. For SQL Server providers, this is the network name of the remote instance, e.g. `remote-host,1433`.",
+	"catalog":              "Default catalog (database) used when connecting to the data source.",
+	"rpc_enabled":          "When `true`, remote procedure calls from the linked server are allowed (`rpc` option). Defaults to `false`.",
+	"rpc_out_enabled":      "When `true`, remote procedure calls to the linked server are allowed (`rpc out` option). Defaults to `false`.",
+	"data_access_enabled":  "When `false`, distributed queries to the linked server are disabled (`data access` option). Defaults to `true`.",
+	"collation_compatible": "When `true`, the linked server is assumed to use the same collation as the local server (`collation compatible` option). Defaults to `false`.",
+	"use_remote_collation": "When `true`, collation of remote columns is used (`use remote collation` option). Defaults to `true`.",
+	"collation_name":       "Collation used by the linked server when `use_remote_collation` is `true` and the data source is not SQL Server.",
+}
+
+type resourceData struct {
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Product             types.String `tfsdk:"product"`
+	ProviderName        types.String `tfsdk:"provider_name"`
+	DataSource          types.String `tfsdk:"data_source"`
+	Catalog             types.String `tfsdk:"catalog"`
+	RpcEnabled          types.Bool   `tfsdk:"rpc_enabled"`
+	RpcOutEnabled       types.Bool   `tfsdk:"rpc_out_enabled"`
+	DataAccessEnabled   types.Bool   `tfsdk:"data_access_enabled"`
+	CollationCompatible types.Bool   `tfsdk:"collation_compatible"`
+	UseRemoteCollation  types.Bool   `tfsdk:"use_remote_collation"`
+	CollationName       types.String `tfsdk:"collation_name"`
+}
+
+func (d resourceData) toSettings() sql.LinkedServerSettings {
+	return sql.LinkedServerSettings{
+		Name:                d.Name.ValueString(),
+		Product:             d.Product.ValueString(),
+		Provider:            d.ProviderName.ValueString(),
+		DataSource:          d.DataSource.ValueString(),
+		Catalog:             d.Catalog.ValueString(),
+		RpcEnabled:          d.RpcEnabled.ValueBool(),
+		RpcOutEnabled:       d.RpcOutEnabled.ValueBool(),
+		DataAccessEnabled:   d.DataAccessEnabled.ValueBool() || !common.IsAttrSet(d.DataAccessEnabled),
+		CollationCompatible: d.CollationCompatible.ValueBool(),
+		UseRemoteCollation:  d.UseRemoteCollation.ValueBool() || !common.IsAttrSet(d.UseRemoteCollation),
+		CollationName:       d.CollationName.ValueString(),
+	}
+}
+
+func (d resourceData) withSettings(settings sql.LinkedServerSettings) resourceData {
+	d.Name = types.StringValue(settings.Name)
+	d.Product = types.StringValue(settings.Product)
+	d.ProviderName = types.StringValue(settings.Provider)
+	d.DataSource = types.StringValue(settings.DataSource)
+	d.Catalog = types.StringValue(settings.Catalog)
+	d.RpcEnabled = types.BoolValue(settings.RpcEnabled)
+	d.RpcOutEnabled = types.BoolValue(settings.RpcOutEnabled)
+	d.DataAccessEnabled = types.BoolValue(settings.DataAccessEnabled)
+	d.CollationCompatible = types.BoolValue(settings.CollationCompatible)
+	d.UseRemoteCollation = types.BoolValue(settings.UseRemoteCollation)
+
+	if settings.CollationName == "" {
+		d.CollationName = types.StringNull()
+	} else {
+		d.CollationName = types.StringValue(settings.CollationName)
+	}
+
+	return d
+}
+
+func (d resourceData) getId(ctx context.Context) sql.LinkedServerId {
+	if !common.IsAttrSet(d.Id) {
+		return 0
+	}
+
+	id, err := strconv.Atoi(d.Id.ValueString())
+	utils.AddError(ctx, fmt.Sprintf("Failed to convert resource ID '%s'", d.Id.ValueString()), err)
+	return sql.LinkedServerId(id)
+}
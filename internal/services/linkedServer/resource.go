@@ -0,0 +1,140 @@
+package linkedServer
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/planModifiers"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "linked_server"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages linked server, allowing distributed queries and remote procedure calls against other data sources. " +
+		"Created using `sp_addlinkedserver` and configured with `sp_serveroption`.\n\n" +
+		"-> **Note** Linked servers are not available in Azure SQL Database. Logins used to connect to the remote server can be mapped using `mssql_linked_server_login`."
+
+	connectionAttr := func(name string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: attrDescriptions[name],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+
+	optionAttr := func(name string, defaultValue bool) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			MarkdownDescription: attrDescriptions[name],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				planModifiers.BoolDefault(defaultValue),
+			},
+		}
+	}
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.LinkedServerNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"product":              connectionAttr("product"),
+		"provider_name":        connectionAttr("provider_name"),
+		"data_source":          connectionAttr("data_source"),
+		"catalog":              connectionAttr("catalog"),
+		"rpc_enabled":          optionAttr("rpc_enabled", false),
+		"rpc_out_enabled":      optionAttr("rpc_out_enabled", false),
+		"data_access_enabled":  optionAttr("data_access_enabled", true),
+		"collation_compatible": optionAttr("collation_compatible", false),
+		"use_remote_collation": optionAttr("use_remote_collation", true),
+		"collation_name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["collation_name"],
+			Optional:            true,
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var server sql.LinkedServer
+
+	req.
+		Then(func() { server = sql.CreateLinkedServer(ctx, req.Conn, req.Plan.toSettings()) }).
+		Then(func() {
+			resp.State = req.Plan.withSettings(server.GetSettings(ctx))
+			resp.State.Id = types.StringValue(fmt.Sprint(server.GetId(ctx)))
+		})
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		server sql.LinkedServer
+		exists bool
+	)
+
+	req.
+		Then(func() { server = sql.GetLinkedServer(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { exists = server.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withSettings(server.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var server sql.LinkedServer
+
+	req.
+		Then(func() { server = sql.GetLinkedServer(ctx, req.Conn, req.Plan.getId(ctx)) }).
+		Then(func() { server.UpdateSettings(ctx, req.Plan.toSettings()) }).
+		Then(func() { resp.State = req.Plan.withSettings(server.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var server sql.LinkedServer
+
+	req.
+		Then(func() { server = sql.GetLinkedServer(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { server.Drop(ctx) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	if !common.IsAttrSet(req.Config.Product) || !strings.EqualFold(req.Config.Product.ValueString(), "SQL Server") {
+		return
+	}
+
+	for attrName, value := range map[string]types.String{"provider_name": req.Config.ProviderName, "data_source": req.Config.DataSource, "catalog": req.Config.Catalog} {
+		if common.IsAttrSet(value) {
+			utils.AddAttributeError(ctx, path.Root(attrName), "Conflicting attributes", fmt.Sprintf("%s cannot be set when product is 'SQL Server'", attrName))
+		}
+	}
+}
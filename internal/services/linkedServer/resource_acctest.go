@@ -0,0 +1,78 @@
+package linkedServer
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	if testCtx.IsAzureTest {
+		return
+	}
+
+	newResource := func(rpcOut bool, collationCompatible bool) string {
+		return fmt.Sprintf(`
+resource "mssql_linked_server" "test" {
+	name                 = "test_linked_server"
+	provider_name        = "MSOLEDBSQL"
+	data_source          = "localhost"
+	catalog              = "master"
+	rpc_out_enabled      = %t
+	collation_compatible = %t
+}
+`, rpcOut, collationCompatible)
+	}
+
+	var serverId string
+
+	fetchServer := func(conn *sql.DB) (string, string, bool, bool, error) {
+		var (
+			id, dataSource              string
+			rpcOut, collationCompatible bool
+		)
+		err := conn.QueryRow("SELECT [server_id], [data_source], [is_rpc_out_enabled], [is_collation_compatible] FROM sys.servers WHERE [name]='test_linked_server'").
+			Scan(&id, &dataSource, &rpcOut, &collationCompatible)
+		return id, dataSource, rpcOut, collationCompatible, err
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource(true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						id, dataSource, rpcOut, collationCompatible, err := fetchServer(conn)
+						serverId = id
+
+						testCtx.Assert.Equal("localhost", dataSource, "data_source")
+						testCtx.Assert.True(rpcOut, "rpc_out_enabled")
+						testCtx.Assert.False(collationCompatible, "collation_compatible")
+
+						return err
+					}),
+					resource.TestCheckResourceAttrPtr("mssql_linked_server.test", "id", &serverId),
+					resource.TestCheckResourceAttr("mssql_linked_server.test", "data_access_enabled", "true"),
+				),
+			},
+			{
+				Config: newResource(false, true),
+				Check: testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+					id, _, rpcOut, collationCompatible, err := fetchServer(conn)
+
+					testCtx.Assert.Equal(serverId, id, "linked server should not be recreated")
+					testCtx.Assert.False(rpcOut, "rpc_out_enabled")
+					testCtx.Assert.True(collationCompatible, "collation_compatible")
+
+					return err
+				}),
+			},
+			{
+				ResourceName:      "mssql_linked_server.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,89 @@
+package linkedServerLogin
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"strings"
+)
+
+var attrDescriptions = map[string]string{
+	"id":               "`<linked_server_id>/<login_id>` or, for the mapping of all local logins, `<linked_server_id>`.",
+	"linked_server_id": "ID of `mssql_linked_server`.",
+	"login_id":         "ID of the local login, e.g. `mssql_sql_login`. When not set, the mapping applies to all local logins without explicit mapping.",
+	"use_self":         "When `true`, local login connects to the linked server using its own credentials. Defaults to `false`.",
+	"remote_user":      "Name of the remote login used to connect to the linked server. Conflicts with `use_self`.",
+	"remote_password":  "Password of the remote login. Cannot be read back from the server, so changes made outside of Terraform are not detected.",
+}
+
+type resourceData struct {
+	Id             types.String `tfsdk:"id"`
+	LinkedServerId types.String `tfsdk:"linked_server_id"`
+	LoginId        types.String `tfsdk:"login_id"`
+	UseSelf        types.Bool   `tfsdk:"use_self"`
+	RemoteUser     types.String `tfsdk:"remote_user"`
+	RemotePassword types.String `tfsdk:"remote_password"`
+}
+
+func (d resourceData) toSettings() sql.LinkedServerLoginSettings {
+	return sql.LinkedServerLoginSettings{
+		LocalLoginId:   sql.LoginId(d.LoginId.ValueString()),
+		UseSelf:        d.UseSelf.ValueBool(),
+		RemoteUser:     d.RemoteUser.ValueString(),
+		RemotePassword: d.RemotePassword.ValueString(),
+	}
+}
+
+// withSettings does not touch remote_password, as it cannot be retrieved from the server.
+func (d resourceData) withSettings(settings sql.LinkedServerLoginSettings) resourceData {
+	d.UseSelf = types.BoolValue(settings.UseSelf)
+
+	if settings.RemoteUser == "" {
+		d.RemoteUser = types.StringNull()
+	} else {
+		d.RemoteUser = types.StringValue(settings.RemoteUser)
+	}
+
+	return d
+}
+
+func (d resourceData) withLogin(ctx context.Context, login sql.LinkedServerLogin) resourceData {
+	serverId := fmt.Sprint(login.GetServer(ctx).GetId(ctx))
+	d.LinkedServerId = types.StringValue(serverId)
+
+	if loginId := login.GetLocalLoginId(ctx); loginId == "" {
+		d.Id = types.StringValue(serverId)
+		d.LoginId = types.StringNull()
+	} else {
+		d.Id = types.StringValue(fmt.Sprintf("%s/%s", serverId, loginId))
+		d.LoginId = types.StringValue(string(loginId))
+	}
+
+	return d
+}
+
+func (d resourceData) getLinkedServerId(ctx context.Context) sql.LinkedServerId {
+	id, err := strconv.Atoi(d.LinkedServerId.ValueString())
+	utils.AddError(ctx, fmt.Sprintf("Failed to convert linked server ID '%s'", d.LinkedServerId.ValueString()), err)
+	return sql.LinkedServerId(id)
+}
+
+func getLogin(ctx context.Context, conn sql.Connection, id types.String) sql.LinkedServerLogin {
+	if !common.IsAttrSet(id) {
+		return nil
+	}
+
+	serverId, loginId, _ := strings.Cut(id.ValueString(), "/")
+
+	numericServerId, err := strconv.Atoi(serverId)
+	if err != nil {
+		utils.AddError(ctx, fmt.Sprintf("Failed to parse resource ID '%s'", id.ValueString()), err)
+		return nil
+	}
+
+	return sql.GetLinkedServerLogin(ctx, conn, sql.LinkedServerId(numericServerId), sql.LoginId(loginId))
+}
@@ -0,0 +1,118 @@
+package linkedServerLogin
+
+import (
+	"context"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/planModifiers"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "linked_server_login"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages mapping of local login to credentials used to connect to a linked server. " +
+		"Created using `sp_addlinkedsrvlogin` and read back from `sys.linked_logins`."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"linked_server_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["linked_server_id"],
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"login_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["login_id"],
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"use_self": schema.BoolAttribute{
+			MarkdownDescription: attrDescriptions["use_self"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				planModifiers.BoolDefault(false),
+			},
+		},
+		"remote_user": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["remote_user"],
+			Optional:            true,
+		},
+		"remote_password": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["remote_password"],
+			Optional:            true,
+			Sensitive:           true,
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var login sql.LinkedServerLogin
+
+	req.
+		Then(func() {
+			login = sql.CreateLinkedServerLogin(ctx, req.Conn, req.Plan.getLinkedServerId(ctx), req.Plan.toSettings())
+		}).
+		Then(func() { resp.State = req.Plan.withLogin(ctx, login).withSettings(login.GetSettings(ctx)) })
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		login  sql.LinkedServerLogin
+		exists bool
+	)
+
+	req.
+		Then(func() { login = getLogin(ctx, req.Conn, req.State.Id) }).
+		Then(func() { exists = login.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withLogin(ctx, login).withSettings(login.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var login sql.LinkedServerLogin
+
+	req.
+		Then(func() { login = getLogin(ctx, req.Conn, req.Plan.Id) }).
+		Then(func() { login.UpdateSettings(ctx, req.Plan.toSettings()) }).
+		Then(func() { resp.State = req.Plan.withSettings(login.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var login sql.LinkedServerLogin
+
+	req.
+		Then(func() { login = getLogin(ctx, req.Conn, req.State.Id) }).
+		Then(func() { login.Drop(ctx) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	if !req.Config.UseSelf.ValueBool() {
+		return
+	}
+
+	if common.IsAttrSet(req.Config.RemoteUser) || common.IsAttrSet(req.Config.RemotePassword) {
+		utils.AddAttributeError(ctx, path.Root("use_self"), "Conflicting attributes", "remote_user and remote_password cannot be set when use_self is true")
+	}
+}
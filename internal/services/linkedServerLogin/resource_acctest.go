@@ -0,0 +1,85 @@
+package linkedServerLogin
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	if testCtx.IsAzureTest {
+		return
+	}
+
+	newResource := func(remoteUser string) string {
+		return fmt.Sprintf(`
+resource "mssql_linked_server" "test" {
+	name          = "test_linked_server_login"
+	provider_name = "MSOLEDBSQL"
+	data_source   = "localhost"
+}
+
+resource "mssql_sql_login" "test" {
+	name     = "test_linked_server_local"
+	password = "Str0ngPa$$w0rd124"
+}
+
+resource "mssql_linked_server_login" "test" {
+	linked_server_id = mssql_linked_server.test.id
+	login_id         = mssql_sql_login.test.id
+	remote_user      = %q
+	remote_password  = "RemotePa$$w0rd"
+}
+
+resource "mssql_linked_server_login" "default" {
+	linked_server_id = mssql_linked_server.test.id
+	use_self         = true
+}
+`, remoteUser)
+	}
+
+	fetchRemoteName := func(conn *sql.DB) (string, error) {
+		var remoteName string
+		err := conn.QueryRow(`SELECT ll.[remote_name] FROM sys.linked_logins ll
+			JOIN sys.servers s ON s.[server_id] = ll.[server_id]
+			JOIN sys.server_principals sp ON sp.[principal_id] = ll.[local_principal_id]
+			WHERE s.[name]='test_linked_server_login' AND sp.[name]='test_linked_server_local'`).Scan(&remoteName)
+		return remoteName, err
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("remote_user"),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						remoteName, err := fetchRemoteName(conn)
+						testCtx.Assert.Equal("remote_user", remoteName, "remote_user")
+						return err
+					}),
+					resource.TestCheckResourceAttrPair("mssql_linked_server_login.default", "id", "mssql_linked_server.test", "id"),
+				),
+			},
+			{
+				Config: newResource("other_remote_user"),
+				Check: testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+					remoteName, err := fetchRemoteName(conn)
+					testCtx.Assert.Equal("other_remote_user", remoteName, "remote_user")
+					return err
+				}),
+			},
+			{
+				ResourceName:            "mssql_linked_server_login.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"remote_password"},
+			},
+			{
+				ResourceName:      "mssql_linked_server_login.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,18 @@
+package masterKey
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var attrDescriptions = map[string]string{
+	"id": "ID of the database containing the master key. There can be only one master key per database.",
+	"password": "Password used to encrypt the master key. Changing the password regenerates the master key and re-encrypts all keys it protects.\n\n" +
+		"~> **Note** The password cannot be read back from the database, so changes made outside of Terraform will not be detected. " +
+		"It will be stored in the raw state as plain-text. [Read more about sensitive data in state](https://www.terraform.io/language/state/sensitive-data).",
+}
+
+type resourceData struct {
+	Id         types.String `tfsdk:"id"`
+	DatabaseId types.String `tfsdk:"database_id"`
+	Password   types.String `tfsdk:"password"`
+}
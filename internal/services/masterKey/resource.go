@@ -0,0 +1,97 @@
+package masterKey
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "master_key"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages database master key. Master key of the `master` database protects server certificates used e.g. by Transparent Data Encryption."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"database_id": schema.StringAttribute{
+			MarkdownDescription: common.AttributeDescriptions["database_id"] + " Defaults to ID of `master`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"password": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["password"],
+			Required:            true,
+			Sensitive:           true,
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var db sql.Database
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.Plan.DatabaseId.ValueString()) }).
+		Then(func() { sql.CreateMasterKey(ctx, db, req.Plan.Password.ValueString()) }).
+		Then(func() {
+			resp.State = req.Plan
+			resp.State.Id = types.StringValue(fmt.Sprint(db.GetId(ctx)))
+			resp.State.DatabaseId = resp.State.Id
+		})
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		key    sql.MasterKey
+		exists bool
+	)
+
+	req.
+		Then(func() { key = getMasterKey(ctx, req.Conn, req.State) }).
+		Then(func() { exists = key.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				state := req.State
+				state.DatabaseId = state.Id
+				resp.SetState(state)
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var key sql.MasterKey
+
+	req.
+		Then(func() { key = getMasterKey(ctx, req.Conn, req.Plan) }).
+		Then(func() { key.SetPassword(ctx, req.Plan.Password.ValueString()) }).
+		Then(func() { resp.State = req.Plan })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var key sql.MasterKey
+
+	req.
+		Then(func() { key = getMasterKey(ctx, req.Conn, req.State) }).
+		Then(func() { key.Drop(ctx) })
+}
+
+func getMasterKey(ctx context.Context, conn sql.Connection, data resourceData) sql.MasterKey {
+	return sql.GetMasterKey(ctx, common.GetResourceDb(ctx, conn, data.Id.ValueString()))
+}
@@ -0,0 +1,62 @@
+package masterKey
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	dbId := testCtx.CreateDB("master_key_test")
+
+	newResource := func(password string) string {
+		return fmt.Sprintf(`
+resource "mssql_master_key" "test" {
+	database_id = %d
+	password = %q
+}
+`, dbId, password)
+	}
+
+	checkMasterKeyExists := testCtx.SqlCheck("master_key_test", func(conn *sql.DB) error {
+		var count int
+		err := conn.QueryRow("SELECT COUNT(*) FROM sys.symmetric_keys WHERE [name]='##MS_DatabaseMasterKey##'").Scan(&count)
+
+		testCtx.Assert.Equal(1, count, "master key count")
+
+		return err
+	})
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("Str0ngPa$$word1"),
+				Check: resource.ComposeTestCheckFunc(
+					checkMasterKeyExists,
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mssql_master_key.test", "id", fmt.Sprint(dbId)),
+						resource.TestCheckResourceAttr("mssql_master_key.test", "database_id", fmt.Sprint(dbId)),
+					),
+				),
+			},
+			{
+				Config: newResource("Str0ngPa$$word2"),
+				Check: resource.ComposeTestCheckFunc(
+					checkMasterKeyExists,
+					testCtx.SqlCheck("master_key_test", func(conn *sql.DB) error {
+						_, err := conn.Exec("OPEN MASTER KEY DECRYPTION BY PASSWORD = 'Str0ngPa$$word2'; CLOSE MASTER KEY")
+						return err
+					}),
+				),
+			},
+			{
+				ResourceName:            "mssql_master_key.test",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprint(dbId),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
@@ -0,0 +1,78 @@
+package resourceGovernorClassifier
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"strings"
+)
+
+var attrDescriptions = map[string]string{
+	"id":                "`<function_schema>.<function_name>`.",
+	"function_schema":   "Name of the schema in `master` database containing the classifier function. Defaults to `dbo`.",
+	"function_name":     "Name of the classifier function in `master` database.",
+	"login_routes":      "Set of login to workload group mappings. When set, the classifier function is generated and owned by the provider, assigning sessions of listed logins to the workload groups and all other sessions to `default` group. When not set, the function must already exist and is only bound to Resource Governor.",
+	"login_id":          "ID of the login, e.g. `mssql_sql_login`.",
+	"workload_group_id": "ID of the workload group sessions of the login are assigned to.",
+}
+
+type routeData struct {
+	LoginId         types.String `tfsdk:"login_id"`
+	WorkloadGroupId types.String `tfsdk:"workload_group_id"`
+}
+
+type resourceData struct {
+	Id             types.String `tfsdk:"id"`
+	FunctionSchema types.String `tfsdk:"function_schema"`
+	FunctionName   types.String `tfsdk:"function_name"`
+	LoginRoutes    []routeData  `tfsdk:"login_routes"`
+}
+
+func (d resourceData) toSettings(ctx context.Context) sql.ResourceGovernorClassifierSettings {
+	settings := sql.ResourceGovernorClassifierSettings{
+		FunctionSchema: "dbo",
+		FunctionName:   d.FunctionName.ValueString(),
+	}
+
+	if common.IsAttrSet(d.FunctionSchema) {
+		settings.FunctionSchema = d.FunctionSchema.ValueString()
+	}
+
+	if d.LoginRoutes != nil {
+		settings.Routes = []sql.ResourceGovernorClassifierRoute{}
+	}
+
+	for _, route := range d.LoginRoutes {
+		groupId, err := strconv.Atoi(route.WorkloadGroupId.ValueString())
+		utils.AddError(ctx, fmt.Sprintf("Failed to convert workload group ID '%s'", route.WorkloadGroupId.ValueString()), err)
+
+		settings.Routes = append(settings.Routes, sql.ResourceGovernorClassifierRoute{
+			LoginId:         sql.LoginId(route.LoginId.ValueString()),
+			WorkloadGroupId: sql.WorkloadGroupId(groupId),
+		})
+	}
+
+	return settings
+}
+
+func (d resourceData) withSettings(settings sql.ResourceGovernorClassifierSettings) resourceData {
+	d.Id = types.StringValue(fmt.Sprintf("%s.%s", settings.FunctionSchema, settings.FunctionName))
+	d.FunctionSchema = types.StringValue(settings.FunctionSchema)
+	d.FunctionName = types.StringValue(settings.FunctionName)
+	return d
+}
+
+// parseId splits resource ID into schema and function names. Function names containing dots are supported,
+// as the schema name is always taken from the first segment.
+func parseId(ctx context.Context, id string) (string, string) {
+	schemaName, functionName, ok := strings.Cut(id, ".")
+	if !ok || schemaName == "" || functionName == "" {
+		utils.AddError(ctx, "Invalid ID", fmt.Errorf("ID '%s' is not in format <function_schema>.<function_name>", id))
+	}
+
+	return schemaName, functionName
+}
@@ -0,0 +1,150 @@
+package resourceGovernorClassifier
+
+import (
+	"context"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "resource_governor_classifier"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages Resource Governor classifier function, assigning new sessions to workload groups. " +
+		"There can be only one classifier per instance, so the resource should not be declared more than once.\n\n" +
+		"When `login_routes` are set, the function is generated by the provider and dropped when the resource is destroyed. " +
+		"Otherwise, an existing function is bound to Resource Governor and only unbound on destroy.\n\n" +
+		"-> **Note** Resource Governor is not available in Azure SQL Database."
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"function_schema": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["function_schema"],
+			Optional:            true,
+			Computed:            true,
+			Validators:          validators.ResourceGovernorNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"function_name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["function_name"],
+			Required:            true,
+			Validators:          validators.ResourceGovernorNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"login_routes": schema.SetNestedAttribute{
+			MarkdownDescription: attrDescriptions["login_routes"],
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"login_id": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["login_id"],
+						Required:            true,
+					},
+					"workload_group_id": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["workload_group_id"],
+						Required:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var settings sql.ResourceGovernorClassifierSettings
+
+	req.
+		Then(func() { settings = req.Plan.toSettings(ctx) }).
+		Then(func() { sql.SetResourceGovernorClassifier(ctx, req.Conn, settings) }).
+		Then(func() { resp.State = req.Plan.withSettings(settings) })
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		settings sql.ResourceGovernorClassifierSettings
+		current  sql.ResourceGovernorClassifierSettings
+		upToDate = true
+	)
+
+	req.
+		Then(func() {
+			settings = req.State.toSettings(ctx)
+			settings.FunctionSchema, settings.FunctionName = parseId(ctx, req.State.Id.ValueString())
+		}).
+		Then(func() { current = sql.GetResourceGovernorClassifier(ctx, req.Conn) }).
+		Then(func() {
+			if settings.IsManaged() && current.FunctionSchema == settings.FunctionSchema && current.FunctionName == settings.FunctionName {
+				upToDate = sql.IsResourceGovernorClassifierUpToDate(ctx, req.Conn, settings)
+			}
+		}).
+		Then(func() {
+			if current.FunctionName == "" {
+				return
+			}
+
+			state := req.State.withSettings(current)
+
+			// Routes cannot be read back from function definition. When the definition has been modified outside of Terraform,
+			// routes are removed from the state to force regeneration of the function.
+			if !upToDate {
+				state.LoginRoutes = nil
+			}
+
+			resp.SetState(state)
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var settings sql.ResourceGovernorClassifierSettings
+
+	req.
+		Then(func() { settings = req.Plan.toSettings(ctx) }).
+		Then(func() { sql.SetResourceGovernorClassifier(ctx, req.Conn, settings) }).
+		Then(func() { resp.State = req.Plan.withSettings(settings) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var settings sql.ResourceGovernorClassifierSettings
+
+	req.
+		Then(func() { settings = req.State.toSettings(ctx) }).
+		Then(func() { sql.RemoveResourceGovernorClassifier(ctx, req.Conn, settings) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	loginIds := map[string]bool{}
+
+	for _, route := range req.Config.LoginRoutes {
+		if !common.IsAttrSet(route.LoginId) {
+			continue
+		}
+
+		if loginIds[route.LoginId.ValueString()] {
+			utils.AddAttributeError(ctx, path.Root("login_routes"), "Duplicated login", "Each login can be routed to only one workload group")
+			return
+		}
+
+		loginIds[route.LoginId.ValueString()] = true
+	}
+}
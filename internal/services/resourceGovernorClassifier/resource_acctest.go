@@ -0,0 +1,91 @@
+package resourceGovernorClassifier
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"strings"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	if testCtx.IsAzureTest {
+		return
+	}
+
+	newResource := func(routes string) string {
+		return fmt.Sprintf(`
+resource "mssql_sql_login" "test" {
+	name     = "test_classifier_login"
+	password = "C0mplicatedPa$$w0rd123"
+}
+
+resource "mssql_resource_pool" "test" {
+	name = "test_classifier_pool"
+}
+
+resource "mssql_workload_group" "test" {
+	name             = "test_classifier_group"
+	resource_pool_id = mssql_resource_pool.test.id
+}
+
+resource "mssql_resource_governor_classifier" "test" {
+	function_name = "test_classifier"
+	%s
+}
+`, routes)
+	}
+
+	const routes = `
+	login_routes = [{
+		login_id          = mssql_sql_login.test.id
+		workload_group_id = mssql_workload_group.test.id
+	}]`
+
+	fetchClassifier := func(conn *sql.DB) (string, string, error) {
+		var name, definition string
+		err := conn.QueryRow(`SELECT ISNULL(OBJECT_NAME([classifier_function_id], 1), ''), ISNULL(OBJECT_DEFINITION([classifier_function_id]), '')
+			FROM sys.resource_governor_configuration`).
+			Scan(&name, &definition)
+		return name, definition, err
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource(routes),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						name, definition, err := fetchClassifier(conn)
+
+						testCtx.Assert.Equal("test_classifier", name, "classifier function")
+						testCtx.Assert.True(strings.Contains(definition, "N'test_classifier_group'"), "function should route login to workload group")
+
+						return err
+					}),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("mssql_resource_governor_classifier.test", "id", "dbo.test_classifier"),
+						resource.TestCheckResourceAttr("mssql_resource_governor_classifier.test", "function_schema", "dbo"),
+					),
+				),
+			},
+			{
+				PreConfig: func() {
+					testCtx.ExecMasterDB("ALTER RESOURCE GOVERNOR WITH (CLASSIFIER_FUNCTION = NULL); ALTER RESOURCE GOVERNOR RECONFIGURE")
+					testCtx.ExecMasterDB("ALTER FUNCTION [dbo].[test_classifier]() RETURNS SYSNAME WITH SCHEMABINDING AS BEGIN RETURN N'default' END")
+					testCtx.ExecMasterDB("ALTER RESOURCE GOVERNOR WITH (CLASSIFIER_FUNCTION = [dbo].[test_classifier]); ALTER RESOURCE GOVERNOR RECONFIGURE")
+				},
+				Config:             newResource(routes),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				ResourceName:      "mssql_resource_governor_classifier.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Routes cannot be read from the function definition
+				ImportStateVerifyIgnore: []string{"login_routes"},
+			},
+		},
+	})
+}
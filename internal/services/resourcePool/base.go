@@ -0,0 +1,70 @@
+package resourcePool
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+)
+
+var attrDescriptions = map[string]string{
+	"id":                 "Resource pool ID. Can be retrieved using `SELECT pool_id FROM sys.resource_governor_resource_pools WHERE [name]='<pool_name>'`.",
+	"name":               "Name of the resource pool. Cannot be longer than 128 chars.",
+	"min_cpu_percent":    "Guaranteed average CPU bandwidth for all requests in the pool, when there is CPU contention. Defaults to `0`.",
+	"max_cpu_percent":    "Maximum average CPU bandwidth for all requests in the pool, when there is CPU contention. Defaults to `100`.",
+	"cap_cpu_percent":    "Hard cap on CPU bandwidth of all requests in the pool. Defaults to `100`.",
+	"min_memory_percent": "Minimum amount of memory reserved for the pool, not shared with other pools. Defaults to `0`.",
+	"max_memory_percent": "Maximum amount of memory requests in the pool can use. Defaults to `100`.",
+}
+
+type resourceData struct {
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	MinCpuPercent    types.Int64  `tfsdk:"min_cpu_percent"`
+	MaxCpuPercent    types.Int64  `tfsdk:"max_cpu_percent"`
+	CapCpuPercent    types.Int64  `tfsdk:"cap_cpu_percent"`
+	MinMemoryPercent types.Int64  `tfsdk:"min_memory_percent"`
+	MaxMemoryPercent types.Int64  `tfsdk:"max_memory_percent"`
+}
+
+func (d resourceData) toSettings() sql.ResourcePoolSettings {
+	intOrDefault := func(value types.Int64, def int) int {
+		if common.IsAttrSet(value) {
+			return int(value.ValueInt64())
+		}
+
+		return def
+	}
+
+	return sql.ResourcePoolSettings{
+		Name:             d.Name.ValueString(),
+		MinCpuPercent:    intOrDefault(d.MinCpuPercent, 0),
+		MaxCpuPercent:    intOrDefault(d.MaxCpuPercent, 100),
+		CapCpuPercent:    intOrDefault(d.CapCpuPercent, 100),
+		MinMemoryPercent: intOrDefault(d.MinMemoryPercent, 0),
+		MaxMemoryPercent: intOrDefault(d.MaxMemoryPercent, 100),
+	}
+}
+
+func (d resourceData) withSettings(settings sql.ResourcePoolSettings) resourceData {
+	d.Name = types.StringValue(settings.Name)
+	d.MinCpuPercent = types.Int64Value(int64(settings.MinCpuPercent))
+	d.MaxCpuPercent = types.Int64Value(int64(settings.MaxCpuPercent))
+	d.CapCpuPercent = types.Int64Value(int64(settings.CapCpuPercent))
+	d.MinMemoryPercent = types.Int64Value(int64(settings.MinMemoryPercent))
+	d.MaxMemoryPercent = types.Int64Value(int64(settings.MaxMemoryPercent))
+	return d
+}
+
+func (d resourceData) getId(ctx context.Context) sql.ResourcePoolId {
+	if !common.IsAttrSet(d.Id) {
+		return 0
+	}
+
+	id, err := strconv.Atoi(d.Id.ValueString())
+	utils.AddError(ctx, fmt.Sprintf("Failed to convert resource ID '%s'", d.Id.ValueString()), err)
+	return sql.ResourcePoolId(id)
+}
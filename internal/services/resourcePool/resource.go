@@ -0,0 +1,132 @@
+package resourcePool
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "resource_pool"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages Resource Governor resource pool, limiting CPU and memory available to workload groups using it. " +
+		"Every change is followed by `ALTER RESOURCE GOVERNOR RECONFIGURE`.\n\n" +
+		"-> **Note** Resource Governor is not available in Azure SQL Database."
+
+	percentAttr := func(name string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: attrDescriptions[name],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.ResourceGovernorNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"min_cpu_percent":    percentAttr("min_cpu_percent"),
+		"max_cpu_percent":    percentAttr("max_cpu_percent"),
+		"cap_cpu_percent":    percentAttr("cap_cpu_percent"),
+		"min_memory_percent": percentAttr("min_memory_percent"),
+		"max_memory_percent": percentAttr("max_memory_percent"),
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var pool sql.ResourcePool
+
+	req.
+		Then(func() { pool = sql.CreateResourcePool(ctx, req.Conn, req.Plan.toSettings()) }).
+		Then(func() {
+			resp.State = req.Plan.withSettings(pool.GetSettings(ctx))
+			resp.State.Id = types.StringValue(fmt.Sprint(pool.GetId(ctx)))
+		})
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		pool   sql.ResourcePool
+		exists bool
+	)
+
+	req.
+		Then(func() { pool = sql.GetResourcePool(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { exists = pool.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withSettings(pool.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var pool sql.ResourcePool
+
+	req.
+		Then(func() { pool = sql.GetResourcePool(ctx, req.Conn, req.Plan.getId(ctx)) }).
+		Then(func() { pool.UpdateSettings(ctx, req.Plan.toSettings()) }).
+		Then(func() { resp.State = req.Plan.withSettings(pool.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var pool sql.ResourcePool
+
+	req.
+		Then(func() { pool = sql.GetResourcePool(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { pool.Drop(ctx) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	percents := map[string]types.Int64{
+		"min_cpu_percent":    req.Config.MinCpuPercent,
+		"max_cpu_percent":    req.Config.MaxCpuPercent,
+		"cap_cpu_percent":    req.Config.CapCpuPercent,
+		"min_memory_percent": req.Config.MinMemoryPercent,
+		"max_memory_percent": req.Config.MaxMemoryPercent,
+	}
+
+	for attrName, value := range percents {
+		if common.IsAttrSet(value) && (value.ValueInt64() < 0 || value.ValueInt64() > 100) {
+			utils.AddAttributeError(ctx, path.Root(attrName), "Invalid percentage", fmt.Sprintf("%s must be between 0 and 100", attrName))
+		}
+	}
+
+	validateRange := func(minAttr string, min types.Int64, maxAttr string, max types.Int64) {
+		if common.IsAttrSet(min) && common.IsAttrSet(max) && min.ValueInt64() > max.ValueInt64() {
+			utils.AddAttributeError(ctx, path.Root(minAttr), "Invalid range", fmt.Sprintf("%s cannot be greater than %s", minAttr, maxAttr))
+		}
+	}
+
+	validateRange("min_cpu_percent", req.Config.MinCpuPercent, "max_cpu_percent", req.Config.MaxCpuPercent)
+	validateRange("min_memory_percent", req.Config.MinMemoryPercent, "max_memory_percent", req.Config.MaxMemoryPercent)
+}
@@ -0,0 +1,86 @@
+package resourcePool
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	if testCtx.IsAzureTest {
+		return
+	}
+
+	newResource := func(maxCpuPercent int) string {
+		return fmt.Sprintf(`
+resource "mssql_resource_pool" "test" {
+	name               = "test_pool"
+	min_cpu_percent    = 5
+	max_cpu_percent    = %d
+	max_memory_percent = 50
+}
+`, maxCpuPercent)
+	}
+
+	var poolId string
+
+	fetchPool := func(conn *sql.DB) (string, int, int, int, error) {
+		var (
+			id                        string
+			minCpu, maxCpu, maxMemory int
+		)
+		err := conn.QueryRow("SELECT [pool_id], [min_cpu_percent], [max_cpu_percent], [max_memory_percent] FROM sys.resource_governor_resource_pools WHERE [name]='test_pool'").
+			Scan(&id, &minCpu, &maxCpu, &maxMemory)
+		return id, minCpu, maxCpu, maxMemory, err
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource(60),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						id, minCpu, maxCpu, maxMemory, err := fetchPool(conn)
+						poolId = id
+
+						testCtx.Assert.Equal(5, minCpu, "min_cpu_percent")
+						testCtx.Assert.Equal(60, maxCpu, "max_cpu_percent")
+						testCtx.Assert.Equal(50, maxMemory, "max_memory_percent")
+
+						return err
+					}),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPtr("mssql_resource_pool.test", "id", &poolId),
+						resource.TestCheckResourceAttr("mssql_resource_pool.test", "cap_cpu_percent", "100"),
+						resource.TestCheckResourceAttr("mssql_resource_pool.test", "min_memory_percent", "0"),
+					),
+				),
+			},
+			{
+				Config: newResource(80),
+				Check: testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+					id, _, maxCpu, _, err := fetchPool(conn)
+
+					testCtx.Assert.Equal(poolId, id, "pool should not be recreated")
+					testCtx.Assert.Equal(80, maxCpu, "max_cpu_percent")
+
+					return err
+				}),
+			},
+			{
+				PreConfig: func() {
+					testCtx.ExecMasterDB("ALTER RESOURCE POOL [test_pool] WITH (MAX_CPU_PERCENT = 70); ALTER RESOURCE GOVERNOR RECONFIGURE")
+				},
+				Config:             newResource(80),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				ResourceName:      "mssql_resource_pool.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
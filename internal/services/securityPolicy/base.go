@@ -0,0 +1,122 @@
+package securityPolicy
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var predicateTypes = []string{"FILTER", "BLOCK"}
+
+var blockOperations = []string{"AFTER_INSERT", "AFTER_UPDATE", "BEFORE_UPDATE", "BEFORE_DELETE"}
+
+var attrDescriptions = map[string]string{
+	"id":             "`<database_id>/<policy_id>`. Policy ID can be retrieved using `SELECT OBJECT_ID('<schema_name>.<policy_name>')`.",
+	"name":           "Name of the security policy. Cannot be longer than 128 chars.",
+	"schema_name":    "Name of the schema containing the policy. Defaults to `dbo`.",
+	"enabled":        "When `false`, the policy is created in `OFF` state and its predicates are not enforced. Defaults to `true`.",
+	"schema_binding": "When `true`, predicate functions are schema-bound and cannot be altered while used by the policy. Defaults to `true`.",
+	"predicates":     "Set of filter and block predicates of the policy.",
+	"type":           "Type of the predicate. One of `FILTER`, `BLOCK`.",
+	"function":       "Schema-qualified name of inline table-valued function used as predicate, e.g. `rls.fn_tenant_access`.",
+	"arguments":      "Comma-separated arguments passed to the predicate function, usually column names of the `table`, e.g. `TenantId`.",
+	"table":          "Schema-qualified name of the table the predicate is applied to, e.g. `dbo.Orders`.",
+	"operation": "Operation the block predicate is applied to. One of `AFTER_INSERT`, `AFTER_UPDATE`, `BEFORE_UPDATE`, `BEFORE_DELETE`. " +
+		"When not set, the block predicate is applied to all operations. Can be used only with `BLOCK` predicates.",
+}
+
+type predicateData struct {
+	Type      types.String `tfsdk:"type"`
+	Function  types.String `tfsdk:"function"`
+	Arguments types.String `tfsdk:"arguments"`
+	Table     types.String `tfsdk:"table"`
+	Operation types.String `tfsdk:"operation"`
+}
+
+func (d predicateData) toPredicate() sql.SecurityPredicate {
+	return sql.SecurityPredicate{
+		Type:      d.Type.ValueString(),
+		Function:  d.Function.ValueString(),
+		Arguments: d.Arguments.ValueString(),
+		Table:     d.Table.ValueString(),
+		Operation: d.Operation.ValueString(),
+	}
+}
+
+type resourceData struct {
+	Id            types.String    `tfsdk:"id"`
+	DatabaseId    types.String    `tfsdk:"database_id"`
+	Name          types.String    `tfsdk:"name"`
+	SchemaName    types.String    `tfsdk:"schema_name"`
+	Enabled       types.Bool      `tfsdk:"enabled"`
+	SchemaBinding types.Bool      `tfsdk:"schema_binding"`
+	Predicates    []predicateData `tfsdk:"predicates"`
+}
+
+func (d resourceData) toSettings() sql.SecurityPolicySettings {
+	settings := sql.SecurityPolicySettings{
+		Name:          d.Name.ValueString(),
+		SchemaName:    "dbo",
+		Enabled:       d.Enabled.ValueBool() || !common.IsAttrSet(d.Enabled),
+		SchemaBinding: d.SchemaBinding.ValueBool() || !common.IsAttrSet(d.SchemaBinding),
+	}
+
+	if common.IsAttrSet(d.SchemaName) {
+		settings.SchemaName = d.SchemaName.ValueString()
+	}
+
+	for _, pred := range d.Predicates {
+		settings.Predicates = append(settings.Predicates, pred.toPredicate())
+	}
+
+	return settings
+}
+
+func (d resourceData) withSettings(settings sql.SecurityPolicySettings) resourceData {
+	configured := d.Predicates
+
+	d.Name = types.StringValue(settings.Name)
+	d.SchemaName = types.StringValue(settings.SchemaName)
+	d.Enabled = types.BoolValue(settings.Enabled)
+	d.SchemaBinding = types.BoolValue(settings.SchemaBinding)
+	d.Predicates = nil
+
+	for _, pred := range settings.Predicates {
+		d.Predicates = append(d.Predicates, findConfiguredPredicate(configured, pred))
+	}
+
+	return d
+}
+
+// findConfiguredPredicate returns configured predicate equivalent to the one read from DB, so differences in quoting
+// or casing introduced by SQL Server do not show up as changes.
+func findConfiguredPredicate(configured []predicateData, pred sql.SecurityPredicate) predicateData {
+	for _, conf := range configured {
+		if conf.toPredicate().IsEquivalent(pred) {
+			return conf
+		}
+	}
+
+	data := predicateData{
+		Type:      types.StringValue(pred.Type),
+		Function:  types.StringValue(pred.Function),
+		Arguments: types.StringValue(pred.Arguments),
+		Table:     types.StringValue(pred.Table),
+		Operation: types.StringNull(),
+	}
+
+	if pred.Operation != "" {
+		data.Operation = types.StringValue(pred.Operation)
+	}
+
+	return data
+}
+
+func (d resourceData) withIds(ctx context.Context, policy sql.SecurityPolicy) resourceData {
+	dbId := policy.GetDb(ctx).GetId(ctx)
+	d.Id = types.StringValue(common.DbObjectId[sql.SecurityPolicyId]{DbId: dbId, ObjectId: policy.GetId(ctx)}.String())
+	d.DatabaseId = types.StringValue(fmt.Sprint(dbId))
+	return d
+}
@@ -0,0 +1,199 @@
+package securityPolicy
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/planModifiers"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "security_policy"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages row-level security policy. Predicate functions must be created beforehand, e.g. using `mssql_script`."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"database_id": schema.StringAttribute{
+			MarkdownDescription: common.AttributeDescriptions["database_id"] + " Defaults to ID of `master`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.SecurityPolicyNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"schema_name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["schema_name"],
+			Optional:            true,
+			Computed:            true,
+			Validators:          validators.SchemaNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: attrDescriptions["enabled"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				planModifiers.BoolDefault(true),
+			},
+		},
+		"schema_binding": schema.BoolAttribute{
+			MarkdownDescription: attrDescriptions["schema_binding"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+		"predicates": schema.SetNestedAttribute{
+			MarkdownDescription: attrDescriptions["predicates"],
+			Required:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["type"],
+						Required:            true,
+					},
+					"function": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["function"],
+						Required:            true,
+					},
+					"arguments": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["arguments"],
+						Optional:            true,
+					},
+					"table": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["table"],
+						Required:            true,
+					},
+					"operation": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["operation"],
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var (
+		db     sql.Database
+		policy sql.SecurityPolicy
+	)
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.Plan.DatabaseId.ValueString()) }).
+		Then(func() { policy = sql.CreateSecurityPolicy(ctx, db, req.Plan.toSettings()) }).
+		Then(func() { resp.State = req.Plan.withIds(ctx, policy).withSettings(policy.GetSettings(ctx)) })
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		policy sql.SecurityPolicy
+		exists bool
+	)
+
+	req.
+		Then(func() { policy = getSecurityPolicy(ctx, req.Conn, req.State) }).
+		Then(func() { exists = policy.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withIds(ctx, policy).withSettings(policy.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var policy sql.SecurityPolicy
+
+	req.
+		Then(func() { policy = getSecurityPolicy(ctx, req.Conn, req.Plan) }).
+		Then(func() { policy.UpdateSettings(ctx, req.Plan.toSettings()) }).
+		Then(func() { resp.State = req.Plan.withSettings(policy.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var policy sql.SecurityPolicy
+
+	req.
+		Then(func() { policy = getSecurityPolicy(ctx, req.Conn, req.State) }).
+		Then(func() { policy.Drop(ctx) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	isOneOf := func(value string, allowed []string) bool {
+		for _, a := range allowed {
+			if value == a {
+				return true
+			}
+		}
+		return false
+	}
+
+	isSchemaQualified := func(name string) bool {
+		parts := strings.SplitN(name, ".", 2)
+		return len(parts) == 2 && parts[0] != "" && parts[1] != ""
+	}
+
+	for _, pred := range req.Config.Predicates {
+		if common.IsAttrSet(pred.Type) && !isOneOf(pred.Type.ValueString(), predicateTypes) {
+			utils.AddAttributeError(ctx, path.Root("predicates"), "Invalid predicate type", fmt.Sprintf("Predicate type %q is not supported", pred.Type.ValueString()))
+		}
+
+		if common.IsAttrSet(pred.Operation) {
+			if !isOneOf(pred.Operation.ValueString(), blockOperations) {
+				utils.AddAttributeError(ctx, path.Root("predicates"), "Invalid predicate operation", fmt.Sprintf("Operation %q is not supported", pred.Operation.ValueString()))
+			}
+
+			if common.IsAttrSet(pred.Type) && pred.Type.ValueString() != "BLOCK" {
+				utils.AddAttributeError(ctx, path.Root("predicates"), "Invalid predicate operation", "Operation can be set only for BLOCK predicates")
+			}
+		}
+
+		for _, name := range []types.String{pred.Function, pred.Table} {
+			if common.IsAttrSet(name) && !isSchemaQualified(name.ValueString()) {
+				utils.AddAttributeError(ctx, path.Root("predicates"), "Invalid object name", fmt.Sprintf("Name %q must be schema-qualified, e.g. `dbo.%s`", name.ValueString(), name.ValueString()))
+			}
+		}
+	}
+}
+
+func getSecurityPolicy(ctx context.Context, conn sql.Connection, data resourceData) sql.SecurityPolicy {
+	id := common.ParseDbObjectId[sql.SecurityPolicyId](ctx, data.Id.ValueString())
+	db := sql.GetDatabase(ctx, conn, id.DbId)
+	return sql.GetSecurityPolicy(ctx, db, id.ObjectId)
+}
@@ -0,0 +1,117 @@
+package securityPolicy
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	testCtx.ExecDefaultDB("IF OBJECT_ID('dbo.rls_orders') IS NULL CREATE TABLE [dbo].[rls_orders] ([id] INT, [tenant_id] INT)")
+	testCtx.ExecDefaultDB(`CREATE OR ALTER FUNCTION [dbo].[fn_rls_tenant](@tenant_id INT) RETURNS TABLE WITH SCHEMABINDING AS
+RETURN SELECT 1 AS [result] WHERE @tenant_id = CAST(SESSION_CONTEXT(N'tenant_id') AS INT)`)
+
+	newResource := func(enabled bool, withBlock bool) string {
+		block := ""
+		if withBlock {
+			block = `
+		{
+			type = "BLOCK"
+			function = "dbo.fn_rls_tenant"
+			arguments = "tenant_id"
+			table = "dbo.rls_orders"
+			operation = "AFTER_INSERT"
+		},`
+		}
+
+		return fmt.Sprintf(`
+resource "mssql_security_policy" "test" {
+	database_id = %d
+	name = "test_rls_policy"
+	enabled = %t
+	predicates = [
+		{
+			type = "FILTER"
+			function = "dbo.fn_rls_tenant"
+			arguments = "tenant_id"
+			table = "dbo.rls_orders"
+		},%s
+	]
+}
+`, testCtx.DefaultDBId, enabled, block)
+	}
+
+	var policyId string
+
+	fetchPolicy := func(conn *sql.DB) (string, bool, int, error) {
+		var (
+			id         string
+			enabled    bool
+			predicates int
+		)
+		err := conn.QueryRow(`
+SELECT p.[object_id], p.[is_enabled], (SELECT COUNT(*) FROM sys.security_predicates sp WHERE sp.[object_id] = p.[object_id])
+FROM sys.security_policies p WHERE p.[name]='test_rls_policy'`).
+			Scan(&id, &enabled, &predicates)
+		return id, enabled, predicates, err
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource(true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+						id, enabled, predicates, err := fetchPolicy(conn)
+						policyId = testCtx.DefaultDbId(id)
+
+						testCtx.Assert.True(enabled, "enabled")
+						testCtx.Assert.Equal(1, predicates, "predicates count")
+
+						return err
+					}),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPtr("mssql_security_policy.test", "id", &policyId),
+						resource.TestCheckResourceAttr("mssql_security_policy.test", "schema_name", "dbo"),
+						resource.TestCheckResourceAttr("mssql_security_policy.test", "schema_binding", "true"),
+					),
+				),
+			},
+			{
+				Config: newResource(true, true),
+				Check: testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+					id, _, predicates, err := fetchPolicy(conn)
+
+					testCtx.Assert.Equal(policyId, testCtx.DefaultDbId(id), "policy should not be recreated")
+					testCtx.Assert.Equal(2, predicates, "predicates count")
+
+					return err
+				}),
+			},
+			{
+				PreConfig: func() {
+					testCtx.ExecDefaultDB("ALTER SECURITY POLICY [dbo].[test_rls_policy] WITH (STATE = OFF)")
+				},
+				Config: newResource(true, true),
+				Check: testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+					_, enabled, _, err := fetchPolicy(conn)
+
+					testCtx.Assert.True(enabled, "disabled policy should be re-enabled")
+
+					return err
+				}),
+			},
+			{
+				Config: newResource(false, true),
+				Check:  resource.TestCheckResourceAttr("mssql_security_policy.test", "enabled", "false"),
+			},
+			{
+				ResourceName:            "mssql_security_policy.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"predicates"},
+			},
+		},
+	})
+}
@@ -0,0 +1,84 @@
+package sensitivityClassification
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var ranks = []string{"NONE", "LOW", "MEDIUM", "HIGH", "CRITICAL"}
+
+var attrDescriptions = map[string]string{
+	"id": "`<database_id>/<table_id>/<column_id>`. Table and column IDs can be retrieved using " +
+		"`SELECT OBJECT_ID('<schema_name>.<table_name>'), COLUMNPROPERTY(OBJECT_ID('<schema_name>.<table_name>'), '<column_name>', 'ColumnId')`.",
+	"table_name":          "Schema-qualified name of the table containing the classified column, e.g. `dbo.Customers`.",
+	"column_name":         "Name of the classified column. Cannot be longer than 128 chars.",
+	"label":               "Name of the sensitivity label, e.g. `Confidential`.",
+	"label_id":            "Identifier of the sensitivity label, usually a GUID of the label defined in the information protection policy.",
+	"information_type":    "Type of information stored in the column, e.g. `Contact Info`.",
+	"information_type_id": "Identifier of the information type, usually a GUID of the type defined in the information protection policy.",
+	"rank":                "Sensitivity rank of the column. One of `NONE`, `LOW`, `MEDIUM`, `HIGH`, `CRITICAL`.",
+}
+
+type resourceData struct {
+	Id                types.String `tfsdk:"id"`
+	DatabaseId        types.String `tfsdk:"database_id"`
+	TableName         types.String `tfsdk:"table_name"`
+	ColumnName        types.String `tfsdk:"column_name"`
+	Label             types.String `tfsdk:"label"`
+	LabelId           types.String `tfsdk:"label_id"`
+	InformationType   types.String `tfsdk:"information_type"`
+	InformationTypeId types.String `tfsdk:"information_type_id"`
+	Rank              types.String `tfsdk:"rank"`
+}
+
+func (d resourceData) toSettings() sql.SensitivityClassificationSettings {
+	return sql.SensitivityClassificationSettings{
+		Label:             d.Label.ValueString(),
+		LabelId:           d.LabelId.ValueString(),
+		InformationType:   d.InformationType.ValueString(),
+		InformationTypeId: d.InformationTypeId.ValueString(),
+		Rank:              d.Rank.ValueString(),
+	}
+}
+
+func (d resourceData) withIds(ctx context.Context, classification sql.SensitivityClassification) resourceData {
+	table := classification.GetTable(ctx)
+	dbId := table.GetDb(ctx).GetId(ctx)
+
+	d.Id = types.StringValue(common.DbObjectMemberId[sql.TableId, sql.ColumnId]{
+		DbObjectId: common.DbObjectId[sql.TableId]{DbId: dbId, ObjectId: table.GetId(ctx)},
+		MemberId:   classification.GetColumnId(ctx),
+	}.String())
+	d.DatabaseId = types.StringValue(fmt.Sprint(dbId))
+
+	return d
+}
+
+func (d resourceData) withSettings(ctx context.Context, classification sql.SensitivityClassification, settings sql.SensitivityClassificationSettings) resourceData {
+	optionalValue := func(s string) types.String {
+		if s == "" {
+			return types.StringNull()
+		}
+
+		return types.StringValue(s)
+	}
+
+	d.Label = optionalValue(settings.Label)
+	d.LabelId = optionalValue(settings.LabelId)
+	d.InformationType = optionalValue(settings.InformationType)
+	d.InformationTypeId = optionalValue(settings.InformationTypeId)
+	d.Rank = optionalValue(settings.Rank)
+
+	if d.TableName.IsNull() {
+		d.TableName = types.StringValue(classification.GetTable(ctx).GetName(ctx))
+	}
+
+	if d.ColumnName.IsNull() {
+		d.ColumnName = types.StringValue(classification.GetTable(ctx).GetColumnName(ctx, classification.GetColumnId(ctx)))
+	}
+
+	return d
+}
@@ -0,0 +1,85 @@
+package sensitivityClassification
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/datasource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type listDataSourceData struct {
+	Id              types.String   `tfsdk:"id"`
+	DatabaseId      types.String   `tfsdk:"database_id"`
+	Classifications []resourceData `tfsdk:"classifications"`
+}
+
+type listDataSource struct{}
+
+func (l listDataSource) GetName() string {
+	return "sensitivity_classifications"
+}
+
+func (l listDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{}
+	for _, attrName := range []string{"id", "table_name", "column_name", "label", "label_id", "information_type", "information_type_id", "rank"} {
+		attrs[attrName] = schema.StringAttribute{
+			MarkdownDescription: attrDescriptions[attrName],
+			Computed:            true,
+		}
+	}
+
+	attrs["database_id"] = schema.StringAttribute{
+		MarkdownDescription: common.AttributeDescriptions["database_id"],
+		Computed:            true,
+	}
+
+	resp.Schema.MarkdownDescription = "Obtains information about all classified columns found in SQL database."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the data source, equals to database ID",
+			Computed:            true,
+		},
+		"database_id": schema.StringAttribute{
+			MarkdownDescription: common.AttributeDescriptions["database_id"] + " Defaults to ID of `master`.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"classifications": schema.SetNestedAttribute{
+			MarkdownDescription: "Set of sensitivity classifications found in the DB.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: attrs,
+			},
+		},
+	}
+}
+
+func (l listDataSource) Read(ctx context.Context, req datasource.ReadRequest[listDataSourceData], resp *datasource.ReadResponse[listDataSourceData]) {
+	var classifications []sql.SensitivityClassification
+	var dbId sql.DatabaseId
+
+	db := common.GetResourceDb(ctx, req.Conn, req.Config.DatabaseId.ValueString())
+
+	req.
+		Then(func() {
+			dbId = db.GetId(ctx)
+			classifications = sql.GetSensitivityClassifications(ctx, db)
+		}).
+		Then(func() {
+			data := listDataSourceData{
+				DatabaseId:      types.StringValue(fmt.Sprint(dbId)),
+				Classifications: []resourceData{},
+			}
+			data.Id = data.DatabaseId
+
+			for _, classification := range classifications {
+				item := resourceData{}.withIds(ctx, classification)
+				data.Classifications = append(data.Classifications, item.withSettings(ctx, classification, classification.GetSettings(ctx)))
+			}
+
+			resp.SetState(data)
+		})
+}
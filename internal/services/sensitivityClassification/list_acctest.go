@@ -0,0 +1,39 @@
+package sensitivityClassification
+
+import (
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testListDataSource(testCtx *acctest.TestContext) {
+	testCtx.ExecDefaultDB("CREATE TABLE [dbo].[test_classification_list] ([id] INT, [phone] VARCHAR(20))")
+	defer testCtx.ExecDefaultDB("DROP TABLE [dbo].[test_classification_list]")
+
+	testCtx.ExecDefaultDB("ADD SENSITIVITY CLASSIFICATION TO [dbo].[test_classification_list].[phone] WITH (LABEL = 'Confidential', RANK = LOW)")
+
+	var tableId int
+	err := testCtx.GetDefaultDBConnection().QueryRow("SELECT OBJECT_ID('dbo.test_classification_list')").Scan(&tableId)
+	testCtx.Require.NoError(err, "Fetching IDs")
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "mssql_sensitivity_classifications" "all" {
+	database_id = %d
+}
+`, testCtx.DefaultDBId),
+
+				Check: resource.TestCheckTypeSetElemNestedAttrs("data.mssql_sensitivity_classifications.all", "classifications.*", map[string]string{
+					"id":          fmt.Sprintf("%s/2", testCtx.DefaultDbId(tableId)),
+					"database_id": fmt.Sprint(testCtx.DefaultDBId),
+					"table_name":  "dbo.test_classification_list",
+					"column_name": "phone",
+					"label":       "Confidential",
+					"rank":        "LOW",
+				}),
+			},
+		},
+	})
+}
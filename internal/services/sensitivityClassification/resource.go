@@ -0,0 +1,168 @@
+package sensitivityClassification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "sensitivity_classification"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages sensitivity classification of a table column. At least one of `label`, `label_id`, `information_type`, `information_type_id` or `rank` must be set."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"database_id": schema.StringAttribute{
+			MarkdownDescription: common.AttributeDescriptions["database_id"] + " Defaults to ID of `master`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"table_name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["table_name"],
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"column_name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["column_name"],
+			Required:            true,
+			Validators:          validators.ColumnNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"label": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["label"],
+			Optional:            true,
+		},
+		"label_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["label_id"],
+			Optional:            true,
+		},
+		"information_type": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["information_type"],
+			Optional:            true,
+		},
+		"information_type_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["information_type_id"],
+			Optional:            true,
+		},
+		"rank": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["rank"],
+			Optional:            true,
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var (
+		db             sql.Database
+		table          sql.Table
+		classification sql.SensitivityClassification
+	)
+
+	req.
+		Then(func() { db = common.GetResourceDb(ctx, req.Conn, req.Plan.DatabaseId.ValueString()) }).
+		Then(func() { table = sql.GetTableByName(ctx, db, req.Plan.TableName.ValueString()) }).
+		Then(func() {
+			classification = sql.CreateSensitivityClassification(ctx, table, req.Plan.ColumnName.ValueString(), req.Plan.toSettings())
+		}).
+		Then(func() {
+			resp.State = req.Plan.withIds(ctx, classification).withSettings(ctx, classification, classification.GetSettings(ctx))
+		})
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		classification sql.SensitivityClassification
+		exists         bool
+	)
+
+	req.
+		Then(func() { classification = getSensitivityClassification(ctx, req.Conn, req.State) }).
+		Then(func() { exists = classification.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withIds(ctx, classification).withSettings(ctx, classification, classification.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var classification sql.SensitivityClassification
+
+	req.
+		Then(func() { classification = getSensitivityClassification(ctx, req.Conn, req.Plan) }).
+		Then(func() { classification.UpdateSettings(ctx, req.Plan.toSettings()) }).
+		Then(func() {
+			resp.State = req.Plan.withSettings(ctx, classification, classification.GetSettings(ctx))
+		})
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var classification sql.SensitivityClassification
+
+	req.
+		Then(func() { classification = getSensitivityClassification(ctx, req.Conn, req.State) }).
+		Then(func() { classification.Drop(ctx) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	if common.IsAttrSet(req.Config.TableName) {
+		name := req.Config.TableName.ValueString()
+		if parts := strings.SplitN(name, ".", 2); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			utils.AddAttributeError(ctx, path.Root("table_name"), "Invalid table name", fmt.Sprintf("Name %q must be schema-qualified, e.g. `dbo.%s`", name, name))
+		}
+	}
+
+	if common.IsAttrSet(req.Config.Rank) {
+		isValid := false
+		for _, rank := range ranks {
+			isValid = isValid || rank == req.Config.Rank.ValueString()
+		}
+
+		if !isValid {
+			utils.AddAttributeError(ctx, path.Root("rank"), "Invalid rank", fmt.Sprintf("Rank %q is not supported", req.Config.Rank.ValueString()))
+		}
+	}
+
+	for _, attr := range []types.String{req.Config.Label, req.Config.LabelId, req.Config.InformationType, req.Config.InformationTypeId, req.Config.Rank} {
+		if !attr.IsNull() {
+			return
+		}
+	}
+
+	utils.AddError(ctx, "At least one of label, label_id, information_type, information_type_id or rank must be provided", errors.New("classification requires at least one attribute"))
+}
+
+func getSensitivityClassification(ctx context.Context, conn sql.Connection, data resourceData) sql.SensitivityClassification {
+	id := common.ParseDbObjectMemberId[sql.TableId, sql.ColumnId](ctx, data.Id.ValueString())
+	db := sql.GetDatabase(ctx, conn, id.DbId)
+	return sql.GetSensitivityClassification(ctx, sql.GetTable(ctx, db, id.ObjectId), id.MemberId)
+}
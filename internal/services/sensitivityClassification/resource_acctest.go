@@ -0,0 +1,71 @@
+package sensitivityClassification
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	testCtx.ExecDefaultDB("CREATE TABLE [dbo].[test_classification] ([id] INT, [email] NVARCHAR(100))")
+	defer testCtx.ExecDefaultDB("DROP TABLE [dbo].[test_classification]")
+
+	newResource := func(label string, rank string) string {
+		return fmt.Sprintf(`
+resource "mssql_sensitivity_classification" "test" {
+	database_id = %d
+	table_name = "dbo.test_classification"
+	column_name = "email"
+	label = %q
+	information_type = "Contact Info"
+	rank = %q
+}
+`, testCtx.DefaultDBId, label, rank)
+	}
+
+	var classificationId string
+
+	fetchClassification := func(conn *sql.DB) (string, string, error) {
+		var tableId, label, rank string
+		err := conn.QueryRow("SELECT [major_id], [label], [rank_desc] FROM sys.sensitivity_classifications WHERE [major_id]=OBJECT_ID('dbo.test_classification') AND [minor_id]=2").
+			Scan(&tableId, &label, &rank)
+		classificationId = fmt.Sprintf("%s/2", testCtx.DefaultDbId(tableId))
+		return label, rank, err
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("Confidential", "MEDIUM"),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+						label, rank, err := fetchClassification(conn)
+
+						testCtx.Assert.Equal("Confidential", label, "label")
+						testCtx.Assert.Equal("MEDIUM", rank, "rank")
+
+						return err
+					}),
+					resource.TestCheckResourceAttrPtr("mssql_sensitivity_classification.test", "id", &classificationId),
+				),
+			},
+			{
+				Config: newResource("Highly Confidential", "HIGH"),
+				Check: testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+					label, rank, err := fetchClassification(conn)
+
+					testCtx.Assert.Equal("Highly Confidential", label, "label")
+					testCtx.Assert.Equal("HIGH", rank, "rank")
+
+					return err
+				}),
+			},
+			{
+				ResourceName:      "mssql_sensitivity_classification.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,105 @@
+package serverAudit
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"strings"
+)
+
+var destinations = []string{sql.AuditDestinationFile, sql.AuditDestinationApplicationLog, sql.AuditDestinationSecurityLog}
+
+var onFailureActions = []string{"CONTINUE", "SHUTDOWN", "FAIL_OPERATION"}
+
+var attrDescriptions = map[string]string{
+	"id":                 "Server audit ID. Can be retrieved using `SELECT audit_id FROM sys.server_audits WHERE [name]='<audit_name>'`.",
+	"name":               "Name of the server audit. Cannot be longer than 128 chars.",
+	"destination":        "Target of the audit. One of `FILE`, `APPLICATION_LOG`, `SECURITY_LOG`.",
+	"file_path":          "Path to the directory where audit log files are written. Required when `destination` is `FILE`.",
+	"max_file_size_mb":   "Maximum size of single audit log file in megabytes. Applicable only to `FILE` destination. When not set, file size is unlimited.",
+	"max_rollover_files": "Maximum number of audit log files retained. Applicable only to `FILE` destination. When not set, number of files is unlimited.",
+	"queue_delay":        "Time in milliseconds that can elapse before audit actions are forced to be processed. `0` means synchronous delivery. Defaults to `1000`.",
+	"on_failure":         "Action taken when the target cannot be written to. One of `CONTINUE`, `SHUTDOWN`, `FAIL_OPERATION`. Defaults to `CONTINUE`.",
+	"enabled":            "When `false`, the audit is created in disabled state and no events are collected. Defaults to `true`.",
+}
+
+type resourceData struct {
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Destination      types.String `tfsdk:"destination"`
+	FilePath         types.String `tfsdk:"file_path"`
+	MaxFileSizeMb    types.Int64  `tfsdk:"max_file_size_mb"`
+	MaxRolloverFiles types.Int64  `tfsdk:"max_rollover_files"`
+	QueueDelay       types.Int64  `tfsdk:"queue_delay"`
+	OnFailure        types.String `tfsdk:"on_failure"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+}
+
+func (d resourceData) toSettings() sql.ServerAuditSettings {
+	settings := sql.ServerAuditSettings{
+		Name:             d.Name.ValueString(),
+		Destination:      d.Destination.ValueString(),
+		FilePath:         d.FilePath.ValueString(),
+		MaxFileSizeMb:    int(d.MaxFileSizeMb.ValueInt64()),
+		MaxRolloverFiles: int(d.MaxRolloverFiles.ValueInt64()),
+		QueueDelay:       1000,
+		OnFailure:        "CONTINUE",
+		Enabled:          d.Enabled.ValueBool() || !common.IsAttrSet(d.Enabled),
+	}
+
+	if common.IsAttrSet(d.QueueDelay) {
+		settings.QueueDelay = int(d.QueueDelay.ValueInt64())
+	}
+
+	if common.IsAttrSet(d.OnFailure) {
+		settings.OnFailure = d.OnFailure.ValueString()
+	}
+
+	return settings
+}
+
+func (d resourceData) withSettings(settings sql.ServerAuditSettings) resourceData {
+	d.Name = types.StringValue(settings.Name)
+	d.Destination = types.StringValue(settings.Destination)
+	d.QueueDelay = types.Int64Value(int64(settings.QueueDelay))
+	d.OnFailure = types.StringValue(settings.OnFailure)
+	d.Enabled = types.BoolValue(settings.Enabled)
+
+	// SQL Server appends path separator to the configured directory, which should not be reported as change
+	trimPath := func(path string) string {
+		return strings.TrimRight(path, `/\`)
+	}
+
+	if settings.FilePath == "" {
+		d.FilePath = types.StringNull()
+	} else if trimPath(d.FilePath.ValueString()) != trimPath(settings.FilePath) {
+		d.FilePath = types.StringValue(settings.FilePath)
+	}
+
+	optionalLimit := func(value int) types.Int64 {
+		if value == 0 {
+			return types.Int64Null()
+		}
+
+		return types.Int64Value(int64(value))
+	}
+
+	d.MaxFileSizeMb = optionalLimit(settings.MaxFileSizeMb)
+	d.MaxRolloverFiles = optionalLimit(settings.MaxRolloverFiles)
+
+	return d
+}
+
+func (d resourceData) getId(ctx context.Context) sql.ServerAuditId {
+	if !common.IsAttrSet(d.Id) {
+		return 0
+	}
+
+	id, err := strconv.Atoi(d.Id.ValueString())
+	utils.AddError(ctx, fmt.Sprintf("Failed to convert resource ID '%s'", d.Id.ValueString()), err)
+	return sql.ServerAuditId(id)
+}
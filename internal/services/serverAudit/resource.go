@@ -0,0 +1,172 @@
+package serverAudit
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/planModifiers"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "server_audit"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages server audit. Audited events are selected using `mssql_server_audit_specification` and `mssql_database_audit_specification`.\n\n" +
+		"-> **Note** Azure SQL Database does not support server audits. Use Azure auditing settings instead."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.AuditNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"destination": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["destination"],
+			Required:            true,
+		},
+		"file_path": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["file_path"],
+			Optional:            true,
+		},
+		"max_file_size_mb": schema.Int64Attribute{
+			MarkdownDescription: attrDescriptions["max_file_size_mb"],
+			Optional:            true,
+		},
+		"max_rollover_files": schema.Int64Attribute{
+			MarkdownDescription: attrDescriptions["max_rollover_files"],
+			Optional:            true,
+		},
+		"queue_delay": schema.Int64Attribute{
+			MarkdownDescription: attrDescriptions["queue_delay"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"on_failure": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["on_failure"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: attrDescriptions["enabled"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				planModifiers.BoolDefault(true),
+			},
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var audit sql.ServerAudit
+
+	req.
+		Then(func() { audit = sql.CreateServerAudit(ctx, req.Conn, req.Plan.toSettings()) }).
+		Then(func() {
+			resp.State = req.Plan.withSettings(audit.GetSettings(ctx))
+			resp.State.Id = types.StringValue(fmt.Sprint(audit.GetId(ctx)))
+		})
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		audit  sql.ServerAudit
+		exists bool
+	)
+
+	req.
+		Then(func() { audit = sql.GetServerAudit(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { exists = audit.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withSettings(audit.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var audit sql.ServerAudit
+
+	req.
+		Then(func() { audit = sql.GetServerAudit(ctx, req.Conn, req.Plan.getId(ctx)) }).
+		Then(func() { audit.UpdateSettings(ctx, req.Plan.toSettings()) }).
+		Then(func() { resp.State = req.Plan.withSettings(audit.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var audit sql.ServerAudit
+
+	req.
+		Then(func() { audit = sql.GetServerAudit(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { audit.Drop(ctx) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	isOneOf := func(value string, allowed []string) bool {
+		for _, a := range allowed {
+			if value == a {
+				return true
+			}
+		}
+		return false
+	}
+
+	if common.IsAttrSet(req.Config.OnFailure) && !isOneOf(req.Config.OnFailure.ValueString(), onFailureActions) {
+		utils.AddAttributeError(ctx, path.Root("on_failure"), "Invalid on_failure action", fmt.Sprintf("Action %q is not supported", req.Config.OnFailure.ValueString()))
+	}
+
+	if !common.IsAttrSet(req.Config.Destination) {
+		return
+	}
+
+	destination := req.Config.Destination.ValueString()
+	if !isOneOf(destination, destinations) {
+		utils.AddAttributeError(ctx, path.Root("destination"), "Invalid destination", fmt.Sprintf("Destination %q is not supported", destination))
+		return
+	}
+
+	if destination == sql.AuditDestinationFile {
+		if req.Config.FilePath.IsNull() {
+			utils.AddAttributeError(ctx, path.Root("file_path"), "Missing file path", "file_path is required when destination is FILE")
+		}
+		return
+	}
+
+	for attrName, isSet := range map[string]bool{
+		"file_path":          !req.Config.FilePath.IsNull(),
+		"max_file_size_mb":   !req.Config.MaxFileSizeMb.IsNull(),
+		"max_rollover_files": !req.Config.MaxRolloverFiles.IsNull(),
+	} {
+		if isSet {
+			utils.AddAttributeError(ctx, path.Root(attrName), "Attribute not applicable", fmt.Sprintf("%s can be set only when destination is FILE", attrName))
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package serverAudit
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	if testCtx.IsAzureTest {
+		return
+	}
+
+	newResource := func(queueDelay int, onFailure string, enabled bool) string {
+		return fmt.Sprintf(`
+resource "mssql_server_audit" "test" {
+	name = "test_server_audit"
+	destination = "APPLICATION_LOG"
+	queue_delay = %d
+	on_failure = %q
+	enabled = %t
+}
+`, queueDelay, onFailure, enabled)
+	}
+
+	var auditId string
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource(1000, "CONTINUE", true),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						var (
+							auditType string
+							enabled   bool
+						)
+
+						err := conn.QueryRow("SELECT [audit_id], [type], [is_state_enabled] FROM sys.server_audits WHERE [name]='test_server_audit'").
+							Scan(&auditId, &auditType, &enabled)
+
+						testCtx.Assert.Equal("AL", auditType, "type")
+						testCtx.Assert.True(enabled, "enabled")
+
+						return err
+					}),
+					resource.TestCheckResourceAttrPtr("mssql_server_audit.test", "id", &auditId),
+				),
+			},
+			{
+				Config: newResource(2000, "FAIL_OPERATION", false),
+				Check: testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+					var (
+						queueDelay int
+						onFailure  string
+						enabled    bool
+					)
+
+					err := conn.QueryRow("SELECT [queue_delay], [on_failure_desc], [is_state_enabled] FROM sys.server_audits WHERE [name]='test_server_audit'").
+						Scan(&queueDelay, &onFailure, &enabled)
+
+					testCtx.Assert.Equal(2000, queueDelay, "queue_delay")
+					testCtx.Assert.Equal("FAIL_OPERATION", onFailure, "on_failure")
+					testCtx.Assert.False(enabled, "enabled")
+
+					return err
+				}),
+			},
+			{
+				PreConfig: func() {
+					testCtx.ExecMasterDB("ALTER SERVER AUDIT [test_server_audit] WITH (STATE = ON)")
+				},
+				Config:             newResource(2000, "FAIL_OPERATION", false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: newResource(2000, "FAIL_OPERATION", false),
+				Check: testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+					var enabled bool
+					err := conn.QueryRow("SELECT [is_state_enabled] FROM sys.server_audits WHERE [name]='test_server_audit'").Scan(&enabled)
+					testCtx.Assert.False(enabled, "enabled")
+					return err
+				}),
+			},
+			{
+				ResourceName:      "mssql_server_audit.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
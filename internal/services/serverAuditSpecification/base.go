@@ -0,0 +1,81 @@
+package serverAuditSpecification
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"strings"
+)
+
+var attrDescriptions = map[string]string{
+	"id":            "Server audit specification ID. Can be retrieved using `SELECT server_specification_id FROM sys.server_audit_specifications WHERE [name]='<specification_name>'`.",
+	"name":          "Name of the server audit specification. Cannot be longer than 128 chars.",
+	"audit_id":      "ID of `mssql_server_audit` collecting the events.",
+	"action_groups": "Set of server-level audit action groups, e.g. `FAILED_LOGIN_GROUP`.",
+	"enabled":       "When `false`, the specification is created in disabled state and no events are collected. Defaults to `true`.",
+}
+
+type resourceData struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	AuditId      types.String `tfsdk:"audit_id"`
+	ActionGroups []string     `tfsdk:"action_groups"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+}
+
+func (d resourceData) toSettings(ctx context.Context) sql.ServerAuditSpecificationSettings {
+	auditId, err := strconv.Atoi(d.AuditId.ValueString())
+	utils.AddError(ctx, fmt.Sprintf("Failed to convert audit ID '%s'", d.AuditId.ValueString()), err)
+
+	return sql.ServerAuditSpecificationSettings{
+		Name:         d.Name.ValueString(),
+		AuditId:      sql.ServerAuditId(auditId),
+		ActionGroups: d.ActionGroups,
+		Enabled:      d.Enabled.ValueBool() || !common.IsAttrSet(d.Enabled),
+	}
+}
+
+func (d resourceData) withSettings(settings sql.ServerAuditSpecificationSettings) resourceData {
+	d.Name = types.StringValue(settings.Name)
+	d.AuditId = types.StringValue(fmt.Sprint(settings.AuditId))
+	d.Enabled = types.BoolValue(settings.Enabled)
+
+	if !equalIgnoreCase(d.ActionGroups, settings.ActionGroups) {
+		d.ActionGroups = settings.ActionGroups
+	}
+
+	return d
+}
+
+func (d resourceData) getId(ctx context.Context) sql.ServerAuditSpecificationId {
+	if !common.IsAttrSet(d.Id) {
+		return 0
+	}
+
+	id, err := strconv.Atoi(d.Id.ValueString())
+	utils.AddError(ctx, fmt.Sprintf("Failed to convert resource ID '%s'", d.Id.ValueString()), err)
+	return sql.ServerAuditSpecificationId(id)
+}
+
+func equalIgnoreCase(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	values := map[string]bool{}
+	for _, v := range a {
+		values[strings.ToUpper(v)] = true
+	}
+
+	for _, v := range b {
+		if !values[strings.ToUpper(v)] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,111 @@
+package serverAuditSpecification
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/planModifiers"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "server_audit_specification"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages server audit specification, selecting server-level action groups collected by `mssql_server_audit`.\n\n" +
+		"-> **Note** Azure SQL Database does not support server audit specifications."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.AuditNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"audit_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["audit_id"],
+			Required:            true,
+		},
+		"action_groups": schema.SetAttribute{
+			MarkdownDescription: attrDescriptions["action_groups"],
+			ElementType:         types.StringType,
+			Required:            true,
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: attrDescriptions["enabled"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				planModifiers.BoolDefault(true),
+			},
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var (
+		settings sql.ServerAuditSpecificationSettings
+		spec     sql.ServerAuditSpecification
+	)
+
+	req.
+		Then(func() { settings = req.Plan.toSettings(ctx) }).
+		Then(func() { spec = sql.CreateServerAuditSpecification(ctx, req.Conn, settings) }).
+		Then(func() {
+			resp.State = req.Plan.withSettings(spec.GetSettings(ctx))
+			resp.State.Id = types.StringValue(fmt.Sprint(spec.GetId(ctx)))
+		})
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		spec   sql.ServerAuditSpecification
+		exists bool
+	)
+
+	req.
+		Then(func() { spec = sql.GetServerAuditSpecification(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { exists = spec.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withSettings(spec.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var (
+		settings sql.ServerAuditSpecificationSettings
+		spec     sql.ServerAuditSpecification
+	)
+
+	req.
+		Then(func() { settings = req.Plan.toSettings(ctx) }).
+		Then(func() { spec = sql.GetServerAuditSpecification(ctx, req.Conn, req.Plan.getId(ctx)) }).
+		Then(func() { spec.UpdateSettings(ctx, settings) }).
+		Then(func() { resp.State = req.Plan.withSettings(spec.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var spec sql.ServerAuditSpecification
+
+	req.
+		Then(func() { spec = sql.GetServerAuditSpecification(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { spec.Drop(ctx) })
+}
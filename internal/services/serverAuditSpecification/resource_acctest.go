@@ -0,0 +1,114 @@
+package serverAuditSpecification
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"sort"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	if testCtx.IsAzureTest {
+		return
+	}
+
+	newResource := func(groups string, enabled bool) string {
+		return fmt.Sprintf(`
+resource "mssql_server_audit" "test" {
+	name = "test_server_audit_spec_audit"
+	destination = "APPLICATION_LOG"
+}
+
+resource "mssql_server_audit_specification" "test" {
+	name = "test_server_audit_spec"
+	audit_id = mssql_server_audit.test.id
+	action_groups = %s
+	enabled = %t
+}
+`, groups, enabled)
+	}
+
+	getGroups := func(conn *sql.DB) ([]string, error) {
+		var groups []string
+
+		rows, err := conn.Query(`
+SELECT d.[audit_action_name]
+FROM sys.server_audit_specification_details d
+INNER JOIN sys.server_audit_specifications s ON s.[server_specification_id] = d.[server_specification_id]
+WHERE s.[name]='test_server_audit_spec'`)
+		if err != nil {
+			return groups, err
+		}
+
+		for rows.Next() {
+			var group string
+			if err := rows.Scan(&group); err != nil {
+				return groups, err
+			}
+			groups = append(groups, group)
+		}
+
+		sort.Strings(groups)
+		return groups, rows.Err()
+	}
+
+	var specId string
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource(`["FAILED_LOGIN_GROUP"]`, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						var enabled bool
+						err := conn.QueryRow("SELECT [server_specification_id], [is_state_enabled] FROM sys.server_audit_specifications WHERE [name]='test_server_audit_spec'").
+							Scan(&specId, &enabled)
+
+						testCtx.Assert.True(enabled, "enabled")
+
+						return err
+					}),
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						groups, err := getGroups(conn)
+						testCtx.Assert.Equal([]string{"FAILED_LOGIN_GROUP"}, groups)
+						return err
+					}),
+					resource.TestCheckResourceAttrPtr("mssql_server_audit_specification.test", "id", &specId),
+				),
+			},
+			{
+				Config: newResource(`["LOGOUT_GROUP", "SUCCESSFUL_LOGIN_GROUP"]`, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						var enabled bool
+						err := conn.QueryRow("SELECT [is_state_enabled] FROM sys.server_audit_specifications WHERE [name]='test_server_audit_spec'").Scan(&enabled)
+						testCtx.Assert.False(enabled, "enabled")
+						return err
+					}),
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						groups, err := getGroups(conn)
+						testCtx.Assert.Equal([]string{"LOGOUT_GROUP", "SUCCESSFUL_LOGIN_GROUP"}, groups)
+						return err
+					}),
+				),
+			},
+			{
+				PreConfig: func() {
+					testCtx.ExecMasterDB("ALTER SERVER AUDIT SPECIFICATION [test_server_audit_spec] WITH (STATE = ON)")
+				},
+				Config:             newResource(`["LOGOUT_GROUP", "SUCCESSFUL_LOGIN_GROUP"]`, false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: newResource(`["LOGOUT_GROUP", "SUCCESSFUL_LOGIN_GROUP"]`, false),
+			},
+			{
+				ResourceName:      "mssql_server_audit_specification.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package serverCertificate
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+)
+
+var attrDescriptions = map[string]string{
+	"id":                    "Certificate ID. Can be retrieved using `SELECT certificate_id FROM master.sys.certificates WHERE [name]='<certificate_name>'`.",
+	"name":                  "Name of the certificate. Cannot be longer than 128 chars.",
+	"subject":               "Subject of the certificate. Required when generating the certificate, conflicts with `file_path`.",
+	"expiry_date":           "Expiry date of the generated certificate in `YYYY-MM-DD` format. Defaults to one year after creation.",
+	"file_path":             "Path, on the SQL Server host, of the DER-encoded file to load the certificate from. Conflicts with `subject`.",
+	"private_key_file_path": "Path, on the SQL Server host, of the file to load the private key from. Can be used only together with `file_path`.",
+	"private_key_password": "Password used to decrypt the private key loaded from `private_key_file_path`.\n\n" +
+		"~> **Note** The password will be stored in the raw state as plain-text. [Read more about sensitive data in state](https://www.terraform.io/language/state/sensitive-data).",
+	"thumbprint": "SHA-1 hash of the certificate, e.g. `0x8E3A1C...`.",
+}
+
+type resourceData struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Subject            types.String `tfsdk:"subject"`
+	ExpiryDate         types.String `tfsdk:"expiry_date"`
+	FilePath           types.String `tfsdk:"file_path"`
+	PrivateKeyFilePath types.String `tfsdk:"private_key_file_path"`
+	PrivateKeyPassword types.String `tfsdk:"private_key_password"`
+	Thumbprint         types.String `tfsdk:"thumbprint"`
+}
+
+func (d resourceData) toSettings() sql.CertificateSettings {
+	return sql.CertificateSettings{
+		Name:                         d.Name.ValueString(),
+		Subject:                      d.Subject.ValueString(),
+		ExpiryDate:                   d.ExpiryDate.ValueString(),
+		FilePath:                     d.FilePath.ValueString(),
+		PrivateKeyFilePath:           d.PrivateKeyFilePath.ValueString(),
+		PrivateKeyDecryptionPassword: d.PrivateKeyPassword.ValueString(),
+	}
+}
+
+func (d resourceData) withSettings(settings sql.CertificateSettings) resourceData {
+	d.Name = types.StringValue(settings.Name)
+	d.Subject = types.StringValue(settings.Subject)
+	d.ExpiryDate = types.StringValue(settings.ExpiryDate)
+	d.Thumbprint = types.StringValue(settings.Thumbprint)
+	return d
+}
+
+func (d resourceData) getId(ctx context.Context) sql.CertificateId {
+	if !common.IsAttrSet(d.Id) {
+		return 0
+	}
+
+	id, err := strconv.Atoi(d.Id.ValueString())
+	utils.AddError(ctx, fmt.Sprintf("Failed to convert resource ID '%s'", d.Id.ValueString()), err)
+	return sql.CertificateId(id)
+}
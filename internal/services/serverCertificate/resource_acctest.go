@@ -0,0 +1,68 @@
+package serverCertificate
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	if testCtx.IsAzureTest {
+		return
+	}
+
+	testCtx.ExecMasterDB("IF NOT EXISTS (SELECT 1 FROM sys.symmetric_keys WHERE [name]='##MS_DatabaseMasterKey##') CREATE MASTER KEY ENCRYPTION BY PASSWORD='Str0ngMasterKeyPa$$w0rd'")
+
+	newResource := func(name string, subject string) string {
+		return fmt.Sprintf(`
+resource "mssql_server_certificate" "test" {
+	name = %q
+	subject = %q
+	expiry_date = "2099-12-31"
+}
+`, name, subject)
+	}
+
+	var certId, thumbprint string
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("test_server_cert", "Test certificate"),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						var subject string
+						err := conn.QueryRow("SELECT [certificate_id], [subject], CONVERT(VARCHAR(MAX), [thumbprint], 1) FROM sys.certificates WHERE [name]='test_server_cert'").
+							Scan(&certId, &subject, &thumbprint)
+
+						testCtx.Assert.Equal("Test certificate", subject, "subject")
+
+						return err
+					}),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPtr("mssql_server_certificate.test", "id", &certId),
+						resource.TestCheckResourceAttrPtr("mssql_server_certificate.test", "thumbprint", &thumbprint),
+						resource.TestCheckResourceAttr("mssql_server_certificate.test", "expiry_date", "2099-12-31"),
+					),
+				),
+			},
+			{
+				Config: newResource("test_server_cert", "Changed subject"),
+				Check: testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+					var id string
+					err := conn.QueryRow("SELECT [certificate_id] FROM sys.certificates WHERE [name]='test_server_cert'").Scan(&id)
+
+					testCtx.Assert.NotEqual(certId, id, "certificate should be recreated")
+
+					return err
+				}),
+			},
+			{
+				ResourceName:      "mssql_server_certificate.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
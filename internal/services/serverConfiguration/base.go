@@ -0,0 +1,58 @@
+package serverConfiguration
+
+import (
+	"context"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"strings"
+)
+
+const idSeparator = ","
+
+var attrDescriptions = map[string]string{
+	"id":            "Comma-separated, sorted list of managed option names.",
+	"options":       "Map of `sp_configure` option names to their configured values, e.g. `max server memory (MB)`. Option names are case-insensitive.",
+	"values_in_use": "Map of option names to values currently in effect, as reported by `value_in_use` column of `sys.configurations`. Differs from `options` when the change requires server restart.",
+}
+
+type resourceData struct {
+	Id          types.String     `tfsdk:"id"`
+	Options     map[string]int64 `tfsdk:"options"`
+	ValuesInUse types.Map        `tfsdk:"values_in_use"`
+}
+
+func (d resourceData) getOptionNames() []string {
+	var names []string
+
+	if d.Options == nil {
+		names = strings.Split(d.Id.ValueString(), idSeparator)
+	}
+
+	for name := range d.Options {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+func (d resourceData) withOptions(ctx context.Context, options map[string]sql.ServerConfigurationOption) resourceData {
+	values := map[string]int64{}
+	inUse := map[string]int64{}
+
+	for name, opt := range options {
+		values[name] = opt.Value
+		inUse[name] = opt.ValueInUse
+	}
+
+	var diags diag.Diagnostics
+	d.Options = values
+	d.ValuesInUse, diags = types.MapValueFrom(ctx, types.Int64Type, inUse)
+	utils.AppendDiagnostics(ctx, diags...)
+	d.Id = types.StringValue(strings.Join(d.getOptionNames(), idSeparator))
+
+	return d
+}
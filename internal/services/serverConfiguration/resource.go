@@ -0,0 +1,177 @@
+package serverConfiguration
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"reflect"
+	"strings"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "server_configuration"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages server configuration options, set using `sp_configure` and applied with `RECONFIGURE`. " +
+		"Advanced options are changed without the need to enable `show advanced options` beforehand.\n\n" +
+		"-> **Note** Destroying the resource or removing option from `options` does not revert the option to its previous value.\n\n" +
+		"-> **Note** Changes of options which are not dynamic take effect only after server restart. In such case, a warning is reported and `values_in_use` keeps the old values."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				idPlanModifier{},
+			},
+		},
+		"options": schema.MapAttribute{
+			MarkdownDescription: attrDescriptions["options"],
+			ElementType:         types.Int64Type,
+			Required:            true,
+		},
+		"values_in_use": schema.MapAttribute{
+			MarkdownDescription: attrDescriptions["values_in_use"],
+			ElementType:         types.Int64Type,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Map{
+				valuesInUsePlanModifier{},
+			},
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	req.Then(func() { resp.State = r.apply(ctx, req.Conn, req.Plan) })
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var options map[string]sql.ServerConfigurationOption
+
+	req.
+		Then(func() { options = sql.GetServerConfigurationOptions(ctx, req.Conn, req.State.getOptionNames()) }).
+		Then(func() { resp.SetState(req.State.withOptions(ctx, options)) })
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	req.Then(func() { resp.State = r.apply(ctx, req.Conn, req.Plan) })
+}
+
+func (r *res) Delete(context.Context, resource.DeleteRequest[resourceData], *resource.DeleteResponse[resourceData]) {
+	// Options are left with their current values, as there is no reliable way to tell what the defaults are.
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	seen := map[string]string{}
+
+	for name := range req.Config.Options {
+		if other, ok := seen[strings.ToLower(name)]; ok {
+			utils.AddAttributeError(ctx, path.Root("options"), "Duplicated option", fmt.Sprintf("Options '%s' and '%s' refer to the same setting", other, name))
+		}
+		seen[strings.ToLower(name)] = name
+	}
+}
+
+func (r *res) apply(ctx context.Context, conn sql.Connection, plan resourceData) resourceData {
+	var options map[string]sql.ServerConfigurationOption
+
+	utils.StopOnError(ctx).
+		Then(func() { sql.SetServerConfigurationOptions(ctx, conn, plan.Options) }).
+		Then(func() { options = sql.GetServerConfigurationOptions(ctx, conn, plan.getOptionNames()) }).
+		Then(func() {
+			for _, name := range plan.getOptionNames() {
+				if opt := options[name]; opt.RestartRequired() {
+					utils.AddWarning(ctx, "Server restart required",
+						fmt.Sprintf("Option '%s' has been set to %d, but value %d remains in use until the server is restarted.", name, opt.Value, opt.ValueInUse))
+				}
+			}
+
+			plan = plan.withOptions(ctx, options)
+		})
+
+	return plan
+}
+
+// getPlannedOptions returns options from plan and state, or false when the resource is created, destroyed or the options are unknown.
+func getPlannedOptions(ctx context.Context, plan tfsdk.Plan, state tfsdk.State, diags *diag.Diagnostics) (map[string]int64, map[string]int64, bool) {
+	if plan.Raw.IsNull() || state.Raw.IsNull() {
+		return nil, nil, false
+	}
+
+	var planOptions, stateOptions types.Map
+	diags.Append(plan.GetAttribute(ctx, path.Root("options"), &planOptions)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("options"), &stateOptions)...)
+	if diags.HasError() || planOptions.IsUnknown() || planOptions.IsNull() || stateOptions.IsNull() {
+		return nil, nil, false
+	}
+
+	planValues, stateValues := map[string]int64{}, map[string]int64{}
+	diags.Append(planOptions.ElementsAs(ctx, &planValues, false)...)
+	diags.Append(stateOptions.ElementsAs(ctx, &stateValues, false)...)
+
+	return planValues, stateValues, !diags.HasError()
+}
+
+// idPlanModifier keeps id from the state, when the set of managed options does not change.
+type idPlanModifier struct{}
+
+func (m idPlanModifier) Description(context.Context) string {
+	return "Uses id from the state when option names are not changed"
+}
+
+func (m idPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m idPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	planOptions, stateOptions, ok := getPlannedOptions(ctx, req.Plan, req.State, &resp.Diagnostics)
+	if !ok || len(planOptions) != len(stateOptions) {
+		return
+	}
+
+	for name := range planOptions {
+		if _, ok := stateOptions[name]; !ok {
+			return
+		}
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+// valuesInUsePlanModifier keeps values_in_use from the state, when options do not change.
+type valuesInUsePlanModifier struct{}
+
+func (m valuesInUsePlanModifier) Description(context.Context) string {
+	return "Uses values in use from the state when options are not changed"
+}
+
+func (m valuesInUsePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m valuesInUsePlanModifier) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	if !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	planOptions, stateOptions, ok := getPlannedOptions(ctx, req.Plan, req.State, &resp.Diagnostics)
+	if !ok || !reflect.DeepEqual(planOptions, stateOptions) {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
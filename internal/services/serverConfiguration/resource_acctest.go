@@ -0,0 +1,78 @@
+package serverConfiguration
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	if testCtx.IsAzureTest {
+		return
+	}
+
+	newResource := func(costThreshold int, containedAuth int) string {
+		return fmt.Sprintf(`
+resource "mssql_server_configuration" "test" {
+	options = {
+		"cost threshold for parallelism"    = %d
+		"contained database authentication" = %d
+	}
+}
+`, costThreshold, containedAuth)
+	}
+
+	fetchOption := func(conn *sql.DB, name string) (int, int, error) {
+		var value, valueInUse int
+		err := conn.QueryRow("SELECT CONVERT(INT, [value]), CONVERT(INT, [value_in_use]) FROM sys.configurations WHERE [name]=@p1", name).Scan(&value, &valueInUse)
+		return value, valueInUse, err
+	}
+
+	checkOption := func(name string, expected int) resource.TestCheckFunc {
+		return testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+			value, valueInUse, err := fetchOption(conn, name)
+
+			testCtx.Assert.Equal(expected, value, name)
+			testCtx.Assert.Equal(expected, valueInUse, "%s in use", name)
+
+			return err
+		})
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource(40, 1),
+				Check: resource.ComposeTestCheckFunc(
+					checkOption("cost threshold for parallelism", 40),
+					checkOption("contained database authentication", 1),
+					checkOption("show advanced options", 0),
+					resource.TestCheckResourceAttr("mssql_server_configuration.test", "id", "contained database authentication,cost threshold for parallelism"),
+					resource.TestCheckResourceAttr("mssql_server_configuration.test", "values_in_use.cost threshold for parallelism", "40"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testCtx.ExecMasterDB("EXEC sp_configure 'contained database authentication', 0; RECONFIGURE")
+				},
+				Config:             newResource(40, 1),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: newResource(5, 0),
+				Check: resource.ComposeTestCheckFunc(
+					checkOption("cost threshold for parallelism", 5),
+					checkOption("contained database authentication", 0),
+				),
+			},
+			{
+				ResourceName:      "mssql_server_configuration.test",
+				ImportState:       true,
+				ImportStateId:     "contained database authentication,cost threshold for parallelism",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,119 @@
+package signature
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"strings"
+)
+
+var attrDescriptions = map[string]string{
+	"id":                "`<database_id>/<object_id>/<thumbprint>`. Thumbprint of the signing certificate or key is in hex format, e.g. `0x8E3A1C...`.",
+	"object_name":       "Schema-qualified name of the signed module, e.g. `dbo.my_procedure`. Altering the module removes the signature, which will be re-added on next apply.",
+	"certificate_id":    "ID of `mssql_certificate` used to sign the module. Conflicts with `asymmetric_key_id`. The certificate must be stored in the same database as the module.",
+	"asymmetric_key_id": "ID of `mssql_asymmetric_key` used to sign the module. Conflicts with `certificate_id`. The key must be stored in the same database as the module.",
+	"password": "Password of the certificate or asymmetric key private key. Required when the private key is not protected by the database master key.\n\n" +
+		"~> **Note** The password will be stored in the raw state as plain-text. [Read more about sensitive data in state](https://www.terraform.io/language/state/sensitive-data).",
+	"counter_signature": "When `true`, a counter signature is added, which does not change the execution context of the module. Defaults to `false`.",
+	"thumbprint":        "SHA-1 hash of the signing certificate or key.",
+}
+
+type resourceData struct {
+	Id               types.String `tfsdk:"id"`
+	ObjectName       types.String `tfsdk:"object_name"`
+	CertificateId    types.String `tfsdk:"certificate_id"`
+	AsymmetricKeyId  types.String `tfsdk:"asymmetric_key_id"`
+	Password         types.String `tfsdk:"password"`
+	CounterSignature types.Bool   `tfsdk:"counter_signature"`
+	Thumbprint       types.String `tfsdk:"thumbprint"`
+}
+
+type signatureId struct {
+	DbId       sql.DatabaseId
+	ObjectId   int
+	Thumbprint string
+}
+
+func (id signatureId) String() string {
+	return fmt.Sprintf("%d/%d/%s", id.DbId, id.ObjectId, id.Thumbprint)
+}
+
+func parseSignatureId(ctx context.Context, s string) signatureId {
+	var id signatureId
+
+	segments := strings.Split(s, "/")
+	if len(segments) != 3 {
+		utils.AddError(ctx, fmt.Sprintf("Failed to parse signature ID %q", s), fmt.Errorf("expected format <database_id>/<object_id>/<thumbprint>"))
+		return id
+	}
+
+	dbId, err := strconv.Atoi(segments[0])
+	if err != nil {
+		utils.AddError(ctx, fmt.Sprintf("Failed to parse signature ID %q", s), err)
+		return id
+	}
+
+	objectId, err := strconv.Atoi(segments[1])
+	if err != nil {
+		utils.AddError(ctx, fmt.Sprintf("Failed to parse signature ID %q", s), err)
+		return id
+	}
+
+	return signatureId{DbId: sql.DatabaseId(dbId), ObjectId: objectId, Thumbprint: segments[2]}
+}
+
+func (d resourceData) getSignerDbId(ctx context.Context) sql.DatabaseId {
+	if common.IsAttrSet(d.CertificateId) {
+		return common.ParseDbObjectId[sql.CertificateId](ctx, d.CertificateId.ValueString()).DbId
+	}
+
+	return common.ParseDbObjectId[sql.AsymmetricKeyId](ctx, d.AsymmetricKeyId.ValueString()).DbId
+}
+
+func (d resourceData) toSettings(ctx context.Context) sql.SignatureSettings {
+	settings := sql.SignatureSettings{
+		ObjectName:         d.ObjectName.ValueString(),
+		Password:           d.Password.ValueString(),
+		IsCounterSignature: d.CounterSignature.ValueBool(),
+	}
+
+	if common.IsAttrSet(d.CertificateId) {
+		settings.CertificateId = common.ParseDbObjectId[sql.CertificateId](ctx, d.CertificateId.ValueString()).ObjectId
+	}
+
+	if common.IsAttrSet(d.AsymmetricKeyId) {
+		settings.AsymmetricKeyId = common.ParseDbObjectId[sql.AsymmetricKeyId](ctx, d.AsymmetricKeyId.ValueString()).ObjectId
+	}
+
+	return settings
+}
+
+func (d resourceData) withSettings(ctx context.Context, sig sql.Signature, settings sql.SignatureSettings) resourceData {
+	dbId := sig.GetDb(ctx).GetId(ctx)
+
+	d.Id = types.StringValue(signatureId{DbId: dbId, ObjectId: sig.GetObjectId(ctx), Thumbprint: sig.GetThumbprint(ctx)}.String())
+	d.Thumbprint = types.StringValue(sig.GetThumbprint(ctx))
+	d.CounterSignature = types.BoolValue(settings.IsCounterSignature)
+
+	if d.ObjectName.IsNull() {
+		d.ObjectName = types.StringValue(settings.ObjectName)
+	}
+
+	if settings.CertificateId != 0 {
+		d.CertificateId = types.StringValue(common.DbObjectId[sql.CertificateId]{DbId: dbId, ObjectId: settings.CertificateId}.String())
+	} else {
+		d.CertificateId = types.StringNull()
+	}
+
+	if settings.AsymmetricKeyId != 0 {
+		d.AsymmetricKeyId = types.StringValue(common.DbObjectId[sql.AsymmetricKeyId]{DbId: dbId, ObjectId: settings.AsymmetricKeyId}.String())
+	} else {
+		d.AsymmetricKeyId = types.StringNull()
+	}
+
+	return d
+}
@@ -0,0 +1,133 @@
+package signature
+
+import (
+	"context"
+	"errors"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "signature"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages signature of a module (e.g. stored procedure or function), added with certificate or asymmetric key. " +
+		"Signed modules can be used to grant permissions through certificate-mapped users and logins."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"object_name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["object_name"],
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"certificate_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["certificate_id"],
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"asymmetric_key_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["asymmetric_key_id"],
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"password": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["password"],
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"counter_signature": schema.BoolAttribute{
+			MarkdownDescription: attrDescriptions["counter_signature"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+		"thumbprint": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["thumbprint"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var (
+		db  sql.Database
+		sig sql.Signature
+	)
+
+	req.
+		Then(func() { db = sql.GetDatabase(ctx, req.Conn, req.Plan.getSignerDbId(ctx)) }).
+		Then(func() { sig = sql.AddSignature(ctx, db, req.Plan.toSettings(ctx)) }).
+		Then(func() { resp.State = req.Plan.withSettings(ctx, sig, sig.GetSettings(ctx)) })
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		sig    sql.Signature
+		exists bool
+	)
+
+	req.
+		Then(func() { sig = getSignature(ctx, req.Conn, req.State) }).
+		Then(func() { exists = sig.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withSettings(ctx, sig, sig.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(_ context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	// Only password can change without replacement and it is used only when adding the signature.
+	resp.State = req.Plan
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var sig sql.Signature
+
+	req.
+		Then(func() { sig = getSignature(ctx, req.Conn, req.State) }).
+		Then(func() { sig.Drop(ctx) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	if req.Config.CertificateId.IsNull() == req.Config.AsymmetricKeyId.IsNull() {
+		utils.AddError(ctx, "Exactly one of certificate_id or asymmetric_key_id must be provided", errors.New("module must be signed either with certificate or asymmetric key"))
+	}
+}
+
+func getSignature(ctx context.Context, conn sql.Connection, data resourceData) sql.Signature {
+	var sig sql.Signature
+
+	id := parseSignatureId(ctx, data.Id.ValueString())
+	utils.StopOnError(ctx).Then(func() {
+		sig = sql.GetSignature(ctx, sql.GetDatabase(ctx, conn, id.DbId), id.ObjectId, id.Thumbprint)
+	})
+
+	return sig
+}
@@ -0,0 +1,80 @@
+package signature
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	testCtx.ExecDefaultDB("CREATE OR ALTER PROCEDURE [dbo].[test_signed_proc] AS SELECT 1")
+
+	newResource := func(counterSignature bool) string {
+		return fmt.Sprintf(`
+resource "mssql_certificate" "test" {
+	database_id = %[1]d
+	name = "test_signing_cert"
+	subject = "Module signing"
+	encryption_password = "Str0ngCertPa$$w0rd"
+}
+
+resource "mssql_signature" "test" {
+	object_name = "dbo.test_signed_proc"
+	certificate_id = mssql_certificate.test.id
+	password = "Str0ngCertPa$$w0rd"
+	counter_signature = %[2]t
+}
+`, testCtx.DefaultDBId, counterSignature)
+	}
+
+	fetchCryptType := func(conn *sql.DB) (string, error) {
+		var cryptType string
+		err := conn.QueryRow("SELECT [crypt_type] FROM sys.crypt_properties WHERE [major_id]=OBJECT_ID('dbo.test_signed_proc')").Scan(&cryptType)
+		return cryptType, err
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource(false),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+						cryptType, err := fetchCryptType(conn)
+
+						testCtx.Assert.Equal("SPVC", cryptType, "crypt type")
+
+						return err
+					}),
+					resource.TestCheckResourceAttrPair("mssql_signature.test", "thumbprint", "mssql_certificate.test", "thumbprint"),
+				),
+			},
+			{
+				Config: newResource(true),
+				Check: testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+					cryptType, err := fetchCryptType(conn)
+
+					testCtx.Assert.Equal("CPVC", cryptType, "crypt type")
+
+					return err
+				}),
+			},
+			{
+				PreConfig: func() {
+					testCtx.ExecDefaultDB("ALTER PROCEDURE [dbo].[test_signed_proc] AS SELECT 2")
+				},
+				Config: newResource(true),
+				Check: testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+					_, err := fetchCryptType(conn)
+					return err
+				}),
+			},
+			{
+				ResourceName:            "mssql_signature.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
@@ -16,6 +16,8 @@ var attrDescriptions = map[string]string{
 	"check_password_expiration": "When `true`, password expiration policy is enforced for this login.",
 	"check_password_policy":     "When `true`, the Windows password policies of the computer on which SQL Server is running are enforced on this login.",
 	"principal_id":              "ID used to reference SQL Login in other resources, e.g. `server_role`. Can be retrieved from `sys.sql_logins`.",
+	"certificate_id":            "ID of `mssql_certificate` stored in `master` database, the login is mapped to. Conflicts with `password` and `asymmetric_key_id`.",
+	"asymmetric_key_id":         "ID of `mssql_asymmetric_key` stored in `master` database, the login is mapped to. Conflicts with `password` and `certificate_id`.",
 }
 
 type dataSourceData struct {
@@ -2,10 +2,13 @@ package sqlLogin
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
 	common2 "github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -26,6 +29,8 @@ type resourceData struct {
 	CheckPasswordExpiration types.Bool   `tfsdk:"check_password_expiration"`
 	CheckPasswordPolicy     types.Bool   `tfsdk:"check_password_policy"`
 	PrincipalId             types.String `tfsdk:"principal_id"`
+	CertificateId           types.String `tfsdk:"certificate_id"`
+	AsymmetricKeyId         types.String `tfsdk:"asymmetric_key_id"`
 }
 
 func (d resourceData) toSettings(ctx context.Context) sql.SqlLoginSettings {
@@ -39,7 +44,7 @@ func (d resourceData) toSettings(ctx context.Context) sql.SqlLoginSettings {
 		}
 	}
 
-	return sql.SqlLoginSettings{
+	settings := sql.SqlLoginSettings{
 		Name:                    d.Name.ValueString(),
 		Password:                d.Password.ValueString(),
 		MustChangePassword:      d.MustChangePassword.ValueBool(),
@@ -48,12 +53,40 @@ func (d resourceData) toSettings(ctx context.Context) sql.SqlLoginSettings {
 		CheckPasswordExpiration: d.CheckPasswordExpiration.ValueBool(),
 		CheckPasswordPolicy:     d.CheckPasswordPolicy.ValueBool() || d.CheckPasswordPolicy.IsNull() || d.CheckPasswordPolicy.IsUnknown(),
 	}
+
+	if common2.IsAttrSet(d.CertificateId) {
+		id := common2.ParseDbObjectId[sql.CertificateId](ctx, d.CertificateId.ValueString())
+		validateMasterDbId(ctx, path.Root("certificate_id"), id.DbId)
+		settings.CertificateId = id.ObjectId
+	}
+
+	if common2.IsAttrSet(d.AsymmetricKeyId) {
+		id := common2.ParseDbObjectId[sql.AsymmetricKeyId](ctx, d.AsymmetricKeyId.ValueString())
+		validateMasterDbId(ctx, path.Root("asymmetric_key_id"), id.DbId)
+		settings.AsymmetricKeyId = id.ObjectId
+	}
+
+	return settings
+}
+
+func validateMasterDbId(ctx context.Context, attrPath path.Path, dbId sql.DatabaseId) {
+	if dbId != masterDbId {
+		utils.AddAttributeError(ctx, attrPath, "Invalid login key", "Logins can be mapped only to certificates and asymmetric keys stored in `master` database")
+	}
 }
 
 func (d resourceData) withSettings(settings sql.SqlLoginSettings, isAzure bool) resourceData {
 	d.Name = types.StringValue(settings.Name)
 	d.PrincipalId = types.StringValue(fmt.Sprint(settings.PrincipalId))
 
+	if settings.CertificateId != 0 {
+		d.CertificateId = types.StringValue(common2.DbObjectId[sql.CertificateId]{DbId: masterDbId, ObjectId: settings.CertificateId}.String())
+	}
+
+	if settings.AsymmetricKeyId != 0 {
+		d.AsymmetricKeyId = types.StringValue(common2.DbObjectId[sql.AsymmetricKeyId]{DbId: masterDbId, ObjectId: settings.AsymmetricKeyId}.String())
+	}
+
 	if isAzure {
 		return d
 	}
@@ -81,6 +114,10 @@ func (d resourceData) withSettings(settings sql.SqlLoginSettings, isAzure bool)
 	return d
 }
 
+const masterDbId sql.DatabaseId = 1
+
+const mappedLoginNote = " Password options, `default_database_id` and `default_language` cannot be set for mapped logins."
+
 type res struct{}
 
 func (r *res) GetName() string {
@@ -105,11 +142,26 @@ func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource
 		},
 		"password": schema.StringAttribute{
 			MarkdownDescription: "Password for the login. Must follow strong password policies defined for SQL server. " +
-				"Passwords are case-sensitive, length must be 8-128 chars, can include all characters except `'` or `name`.\n\n" +
+				"Passwords are case-sensitive, length must be 8-128 chars, can include all characters except `'` or `name`. " +
+				"Conflicts with `certificate_id` and `asymmetric_key_id`.\n\n" +
 				"~> **Note** Password will be stored in the raw state as plain-text. [Read more about sensitive data in state](https://www.terraform.io/language/state/sensitive-data).",
-			Required:  true,
+			Optional:  true,
 			Sensitive: true,
 		},
+		"certificate_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["certificate_id"] + mappedLoginNote,
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"asymmetric_key_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["asymmetric_key_id"] + mappedLoginNote,
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
 		"must_change_password": schema.BoolAttribute{
 			MarkdownDescription: attrDescriptions["must_change_password"] + " Defaults to `false`. \n\n" +
 				"-> **Note** After password is changed, this flag is being reset to `false`, which will show as changes in Terraform plan. " +
@@ -183,3 +235,37 @@ func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceDat
 		Then(func() { login = sql.GetSqlLogin(ctx, req.Conn, sql.LoginId(req.State.Id.ValueString())) }).
 		Then(func() { login.Drop(ctx) })
 }
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	sources := 0
+	for _, attr := range []types.String{req.Config.Password, req.Config.CertificateId, req.Config.AsymmetricKeyId} {
+		if !attr.IsNull() {
+			sources++
+		}
+	}
+
+	if sources != 1 {
+		utils.AddError(ctx, "Exactly one of password, certificate_id or asymmetric_key_id must be provided", errors.New("login must be either SQL login or mapped to certificate or asymmetric key"))
+	}
+
+	if req.Config.CertificateId.IsNull() && req.Config.AsymmetricKeyId.IsNull() {
+		return
+	}
+
+	passwordOnlyAttrs := []struct {
+		name  string
+		value attr.Value
+	}{
+		{"must_change_password", req.Config.MustChangePassword},
+		{"check_password_expiration", req.Config.CheckPasswordExpiration},
+		{"check_password_policy", req.Config.CheckPasswordPolicy},
+		{"default_database_id", req.Config.DefaultDatabaseId},
+		{"default_language", req.Config.DefaultLanguage},
+	}
+
+	for _, a := range passwordOnlyAttrs {
+		if !a.value.IsNull() {
+			utils.AddAttributeError(ctx, path.Root(a.name), "Attribute not supported by mapped login", fmt.Sprintf("%s can be set only for logins using password, not mapped to certificate or asymmetric key", a.name))
+		}
+	}
+}
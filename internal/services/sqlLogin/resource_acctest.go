@@ -7,6 +7,7 @@ import (
 	sql2 "github.com/PGSSoft/terraform-provider-mssql/internal/sql"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
 )
 
 func testResource(testCtx *acctest.TestContext) {
@@ -213,4 +214,53 @@ resource "mssql_sql_login" %[1]q {
 			},
 		},
 	})
+	if testCtx.IsAzureTest {
+		return
+	}
+
+	testCtx.ExecMasterDB("IF NOT EXISTS (SELECT 1 FROM sys.symmetric_keys WHERE [name]='##MS_DatabaseMasterKey##') CREATE MASTER KEY ENCRYPTION BY PASSWORD='Str0ngMasterKeyPa$$w0rd'")
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "mssql_certificate" "test" {
+	name = "sqllogin_test_cert"
+	subject = "Certificate login"
+}
+
+resource "mssql_sql_login" "cert_login" {
+	name = "test_cert_login"
+	certificate_id = mssql_certificate.test.id
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("mssql_sql_login.cert_login", "certificate_id", "mssql_certificate.test", "id"),
+					testCtx.SqlCheckMaster(func(db *sql.DB) error {
+						var loginType string
+						err := db.QueryRow("SELECT [type] FROM sys.server_principals WHERE [name]='test_cert_login'").Scan(&loginType)
+
+						testCtx.Assert.Equal("C", loginType, "login type")
+
+						return err
+					}),
+				),
+			},
+			{
+				Config: `
+resource "mssql_certificate" "test" {
+	name = "sqllogin_test_cert"
+	subject = "Certificate login"
+}
+
+resource "mssql_sql_login" "cert_login" {
+	name = "test_cert_login"
+	certificate_id = mssql_certificate.test.id
+	must_change_password = true
+}
+`,
+				ExpectError: regexp.MustCompile("not supported by mapped login"),
+			},
+		},
+	})
 }
@@ -1,46 +1,74 @@
 package sqlUser
 
 import (
+	"context"
 	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var attrDescriptions = map[string]string{
-	"id":       "`<database_id>/<user_id>`. User ID can be retrieved using `SELECT DATABASE_PRINCIPAL_ID('<user_name>')`.",
-	"name":     "User name. Cannot be longer than 128 chars.",
-	"login_id": "SID of SQL login. Can be retrieved using `mssql_sql_login` or `SELECT SUSER_SID('<login_name>')`.",
+	"id":                "`<database_id>/<user_id>`. User ID can be retrieved using `SELECT DATABASE_PRINCIPAL_ID('<user_name>')`.",
+	"name":              "User name. Cannot be longer than 128 chars.",
+	"login_id":          "SID of SQL login. Can be retrieved using `mssql_sql_login` or `SELECT SUSER_SID('<login_name>')`.",
+	"certificate_id":    "ID of `mssql_certificate` the user is mapped to. The certificate must be stored in the same database as the user.",
+	"asymmetric_key_id": "ID of `mssql_asymmetric_key` the user is mapped to. The key must be stored in the same database as the user.",
 }
 
 type resourceData struct {
-	Id         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	DatabaseId types.String `tfsdk:"database_id"`
-	LoginId    types.String `tfsdk:"login_id"`
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	DatabaseId      types.String `tfsdk:"database_id"`
+	LoginId         types.String `tfsdk:"login_id"`
+	CertificateId   types.String `tfsdk:"certificate_id"`
+	AsymmetricKeyId types.String `tfsdk:"asymmetric_key_id"`
 }
 
-func (d resourceData) toSettings() sql.UserSettings {
-	return sql.UserSettings{
-		Name:    d.Name.ValueString(),
-		LoginId: sql.LoginId(d.LoginId.ValueString()),
-		Type:    sql.USER_TYPE_SQL,
+func (d resourceData) toSettings(ctx context.Context) sql.UserSettings {
+	settings := sql.UserSettings{
+		Name: d.Name.ValueString(),
+		Type: sql.USER_TYPE_SQL,
 	}
+
+	switch {
+	case common.IsAttrSet(d.CertificateId):
+		settings.Type = sql.USER_TYPE_CERTIFICATE
+		settings.CertificateId = common.ParseDbObjectId[sql.CertificateId](ctx, d.CertificateId.ValueString()).ObjectId
+	case common.IsAttrSet(d.AsymmetricKeyId):
+		settings.Type = sql.USER_TYPE_ASYMMETRIC_KEY
+		settings.AsymmetricKeyId = common.ParseDbObjectId[sql.AsymmetricKeyId](ctx, d.AsymmetricKeyId.ValueString()).ObjectId
+	default:
+		settings.LoginId = sql.LoginId(d.LoginId.ValueString())
+	}
+
+	return settings
 }
 
 func (d resourceData) withSettings(settings sql.UserSettings) resourceData {
-	return resourceData{
-		Id:         d.Id,
-		DatabaseId: d.DatabaseId,
-		Name:       types.StringValue(settings.Name),
-		LoginId:    types.StringValue(fmt.Sprint(settings.LoginId)),
+	res := resourceData{
+		Id:              d.Id,
+		DatabaseId:      d.DatabaseId,
+		Name:            types.StringValue(settings.Name),
+		LoginId:         types.StringNull(),
+		CertificateId:   types.StringNull(),
+		AsymmetricKeyId: types.StringNull(),
 	}
+
+	switch settings.Type {
+	case sql.USER_TYPE_CERTIFICATE:
+		res.CertificateId = types.StringValue(fmt.Sprintf("%s/%d", d.DatabaseId.ValueString(), settings.CertificateId))
+	case sql.USER_TYPE_ASYMMETRIC_KEY:
+		res.AsymmetricKeyId = types.StringValue(fmt.Sprintf("%s/%d", d.DatabaseId.ValueString(), settings.AsymmetricKeyId))
+	default:
+		res.LoginId = types.StringValue(fmt.Sprint(settings.LoginId))
+	}
+
+	return res
 }
 
 func (d resourceData) withIds(dbId sql.DatabaseId, userId sql.UserId) resourceData {
-	return resourceData{
-		Id:         types.StringValue(fmt.Sprintf("%v/%v", dbId, userId)),
-		DatabaseId: types.StringValue(fmt.Sprint(dbId)),
-		Name:       d.Name,
-		LoginId:    d.LoginId,
-	}
+	d.Id = types.StringValue(fmt.Sprintf("%v/%v", dbId, userId))
+	d.DatabaseId = types.StringValue(fmt.Sprint(dbId))
+	return d
 }
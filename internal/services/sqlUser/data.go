@@ -35,6 +35,14 @@ func (d *dataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp
 			MarkdownDescription: attrDescriptions["login_id"],
 			Computed:            true,
 		},
+		"certificate_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["certificate_id"],
+			Computed:            true,
+		},
+		"asymmetric_key_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["asymmetric_key_id"],
+			Computed:            true,
+		},
 	}
 }
 
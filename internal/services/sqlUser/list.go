@@ -55,6 +55,14 @@ func (l *listDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 						MarkdownDescription: attrDescriptions["login_id"],
 						Computed:            true,
 					},
+					"certificate_id": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["certificate_id"],
+						Computed:            true,
+					},
+					"asymmetric_key_id": schema.StringAttribute{
+						MarkdownDescription: attrDescriptions["asymmetric_key_id"],
+						Computed:            true,
+					},
 				},
 			},
 		},
@@ -2,6 +2,7 @@ package sqlUser
 
 import (
 	"context"
+	"errors"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
 	common2 "github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type res struct{}
@@ -22,7 +24,7 @@ func (r *res) GetName() string {
 }
 
 func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema.MarkdownDescription = "Manages database-level user, based on SQL login."
+	resp.Schema.MarkdownDescription = "Manages database-level user, based on SQL login, certificate or asymmetric key."
 	resp.Schema.Attributes = map[string]schema.Attribute{
 		"id": schema.StringAttribute{
 			MarkdownDescription: attrDescriptions["id"],
@@ -45,8 +47,22 @@ func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource
 			},
 		},
 		"login_id": schema.StringAttribute{
-			MarkdownDescription: attrDescriptions["login_id"],
-			Required:            true,
+			MarkdownDescription: attrDescriptions["login_id"] + " Conflicts with `certificate_id` and `asymmetric_key_id`.",
+			Optional:            true,
+		},
+		"certificate_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["certificate_id"] + " Conflicts with `login_id` and `asymmetric_key_id`.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"asymmetric_key_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["asymmetric_key_id"] + " Conflicts with `login_id` and `certificate_id`.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
 		},
 	}
 }
@@ -59,7 +75,7 @@ func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceDat
 
 	req.
 		Then(func() { db = common2.GetResourceDb(ctx, req.Conn, req.Plan.DatabaseId.ValueString()) }).
-		Then(func() { user = sql.CreateUser(ctx, db, req.Plan.toSettings()) }).
+		Then(func() { user = sql.CreateUser(ctx, db, req.Plan.toSettings(ctx)) }).
 		Then(func() { resp.State = req.Plan.withIds(db.GetId(ctx), user.GetId(ctx)) })
 }
 
@@ -79,8 +95,10 @@ func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceDat
 
 	req.
 		Then(func() { user = getUser(ctx, req.Conn, req.Plan) }).
-		Then(func() { user.UpdateSettings(ctx, req.Plan.toSettings()) }).
-		Then(func() { resp.State = req.Plan.withSettings(user.GetSettings(ctx)) })
+		Then(func() { user.UpdateSettings(ctx, req.Plan.toSettings(ctx)) }).
+		Then(func() {
+			resp.State = req.Plan.withIds(user.GetDatabaseId(ctx), user.GetId(ctx)).withSettings(user.GetSettings(ctx))
+		})
 }
 
 func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], resp *resource.DeleteResponse[resourceData]) {
@@ -91,6 +109,19 @@ func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceDat
 		Then(func() { user.Drop(ctx) })
 }
 
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	sources := 0
+	for _, attr := range []types.String{req.Config.LoginId, req.Config.CertificateId, req.Config.AsymmetricKeyId} {
+		if !attr.IsNull() {
+			sources++
+		}
+	}
+
+	if sources != 1 {
+		utils.AddError(ctx, "Exactly one of login_id, certificate_id or asymmetric_key_id must be provided", errors.New("user must be mapped either to login, certificate or asymmetric key"))
+	}
+}
+
 func getUser(ctx context.Context, conn sql.Connection, data resourceData) sql.User {
 	idSegments := strings.Split(data.Id.ValueString(), "/")
 	id, err := strconv.Atoi(idSegments[1])
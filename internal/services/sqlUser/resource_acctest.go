@@ -113,4 +113,36 @@ DROP LOGIN [renamed_login];
 			},
 		},
 	})
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "mssql_certificate" "test" {
+	database_id = %[1]d
+	name = "sqluser_test_cert"
+	subject = "Certificate user"
+	encryption_password = "Str0ngCertPa$$w0rd"
+}
+
+resource "mssql_sql_user" "cert_user" {
+	name = "test_cert_user"
+	database_id = %[1]d
+	certificate_id = mssql_certificate.test.id
+}
+`, testCtx.DefaultDBId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("mssql_sql_user.cert_user", "certificate_id", "mssql_certificate.test", "id"),
+					resource.TestCheckNoResourceAttr("mssql_sql_user.cert_user", "login_id"),
+					testCtx.SqlCheckDefaultDB(func(db *sql.DB) error {
+						var userType string
+						err := db.QueryRow("SELECT [type] FROM sys.database_principals WHERE [name]='test_cert_user'").Scan(&userType)
+
+						testCtx.Assert.Equal("C", userType, "user type")
+
+						return err
+					}),
+				),
+			},
+		},
+	})
 }
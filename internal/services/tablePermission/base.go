@@ -0,0 +1,76 @@
+package tablePermission
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"strings"
+)
+
+var attrDescriptions = map[string]string{
+	"id":           "`<database_id>/<table_id>/<column_id>/<principal_id>/<permission>`. Column ID is `0` when the permission is granted on the whole table.",
+	"principal_id": "`<database_id>/<principal_id>`. Can be retrieved using `mssql_database_role`, `mssql_sql_user`, `mssql_azuread_user` or `mssql_azuread_service_principal`. The table is looked up in the same database.",
+	"table_name":   "Schema-qualified name of the table, e.g. `dbo.Customers`.",
+	"column_name":  "Name of the column the permission is granted on. When not set, the permission is granted on the whole table.",
+	"permission": "Name of object-level SQL permission, e.g. `SELECT` or `UNMASK`. For full list of supported permissions, see [docs](https://learn.microsoft.com/en-us/sql/t-sql/statements/grant-object-permissions-transact-sql#remarks).\n\n" +
+		"-> **Note** Granting `UNMASK` on a table or column requires SQL Server 2022 or Azure SQL.",
+	"with_grant_option": "When set to `true`, `principal_id` will be allowed to grant the `permission` to other principals.",
+}
+
+type resourceData struct {
+	Id              types.String `tfsdk:"id"`
+	PrincipalId     types.String `tfsdk:"principal_id"`
+	TableName       types.String `tfsdk:"table_name"`
+	ColumnName      types.String `tfsdk:"column_name"`
+	Permission      types.String `tfsdk:"permission"`
+	WithGrantOption types.Bool   `tfsdk:"with_grant_option"`
+}
+
+type permissionId struct {
+	DbId        sql.DatabaseId
+	TableId     sql.TableId
+	ColumnId    sql.ColumnId
+	PrincipalId sql.GenericDatabasePrincipalId
+	Permission  string
+}
+
+func (id permissionId) String() string {
+	return fmt.Sprintf("%d/%d/%d/%d/%s", id.DbId, id.TableId, id.ColumnId, id.PrincipalId, id.Permission)
+}
+
+func (id permissionId) getPrincipalId() common.DbObjectId[sql.GenericDatabasePrincipalId] {
+	return common.DbObjectId[sql.GenericDatabasePrincipalId]{DbId: id.DbId, ObjectId: id.PrincipalId}
+}
+
+func parsePermissionId(ctx context.Context, s string) permissionId {
+	const errorSummary = "Failed to parse table permission ID"
+	var id permissionId
+
+	segments := strings.Split(s, "/")
+	if len(segments) != 5 {
+		utils.AddError(ctx, fmt.Sprintf("%s %q", errorSummary, s), fmt.Errorf("expected format <database_id>/<table_id>/<column_id>/<principal_id>/<permission>"))
+		return id
+	}
+
+	var numbers [4]int
+	for i := range numbers {
+		num, err := strconv.Atoi(segments[i])
+		if err != nil {
+			utils.AddError(ctx, fmt.Sprintf("%s %q", errorSummary, s), err)
+			return id
+		}
+		numbers[i] = num
+	}
+
+	return permissionId{
+		DbId:        sql.DatabaseId(numbers[0]),
+		TableId:     sql.TableId(numbers[1]),
+		ColumnId:    sql.ColumnId(numbers[2]),
+		PrincipalId: sql.GenericDatabasePrincipalId(numbers[3]),
+		Permission:  segments[4],
+	}
+}
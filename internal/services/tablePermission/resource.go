@@ -0,0 +1,185 @@
+package tablePermission
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+var _ resource.ResourceWithValidation[resourceData] = res{}
+
+type res struct{}
+
+func (r res) GetName() string {
+	return "table_permission"
+}
+
+func (r res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Grants table-level or column-level permission."
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"principal_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["principal_id"],
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"table_name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["table_name"],
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"column_name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["column_name"],
+			Optional:            true,
+			Validators:          validators.ColumnNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"permission": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["permission"],
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"with_grant_option": schema.BoolAttribute{
+			MarkdownDescription: attrDescriptions["with_grant_option"] + " Defaults to `false`.",
+			Optional:            true,
+			Computed:            true,
+		},
+	}
+}
+
+func (r res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	id := parsePermissionId(ctx, req.State.Id.ValueString())
+	var (
+		table       sql.Table
+		permissions sql.TablePermissions
+	)
+
+	req.
+		Then(func() { table = sql.GetTable(ctx, sql.GetDatabase(ctx, req.Conn, id.DbId), id.TableId) }).
+		Then(func() { permissions = table.GetPermissions(ctx, id.ColumnId, id.PrincipalId) }).
+		Then(func() {
+			perm, ok := permissions[id.Permission]
+			if !ok {
+				return
+			}
+
+			state := req.State
+			state.PrincipalId = types.StringValue(id.getPrincipalId().String())
+			state.Permission = types.StringValue(id.Permission)
+			state.WithGrantOption = types.BoolValue(perm.WithGrantOption)
+
+			if state.TableName.IsNull() {
+				state.TableName = types.StringValue(table.GetName(ctx))
+			}
+
+			if state.ColumnName.IsNull() && id.ColumnId != sql.WholeTable {
+				state.ColumnName = types.StringValue(table.GetColumnName(ctx, id.ColumnId))
+			}
+
+			resp.SetState(state)
+		})
+}
+
+func (r res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	principalId := common.ParseDbObjectId[sql.GenericDatabasePrincipalId](ctx, req.Plan.PrincipalId.ValueString())
+
+	var (
+		table    sql.Table
+		columnId = sql.WholeTable
+	)
+
+	req.
+		Then(func() {
+			table = sql.GetTableByName(ctx, sql.GetDatabase(ctx, req.Conn, principalId.DbId), req.Plan.TableName.ValueString())
+		}).
+		Then(func() {
+			if common.IsAttrSet(req.Plan.ColumnName) {
+				columnId = table.GetColumnId(ctx, req.Plan.ColumnName.ValueString())
+			}
+		}).
+		Then(func() {
+			perm := sql.TablePermission{
+				Name:            req.Plan.Permission.ValueString(),
+				WithGrantOption: req.Plan.WithGrantOption.ValueBool(),
+			}
+
+			table.GrantPermission(ctx, columnId, principalId.ObjectId, perm)
+		}).
+		Then(func() {
+			id := permissionId{
+				DbId:        principalId.DbId,
+				TableId:     table.GetId(ctx),
+				ColumnId:    columnId,
+				PrincipalId: principalId.ObjectId,
+				Permission:  req.Plan.Permission.ValueString(),
+			}
+
+			resp.State = req.Plan
+			resp.State.Id = types.StringValue(id.String())
+			resp.State.WithGrantOption = types.BoolValue(req.Plan.WithGrantOption.ValueBool())
+		})
+}
+
+func (r res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	id := parsePermissionId(ctx, req.Plan.Id.ValueString())
+	var table sql.Table
+
+	req.
+		Then(func() { table = sql.GetTable(ctx, sql.GetDatabase(ctx, req.Conn, id.DbId), id.TableId) }).
+		Then(func() {
+			perm := sql.TablePermission{
+				Name:            id.Permission,
+				WithGrantOption: req.Plan.WithGrantOption.ValueBool(),
+			}
+
+			table.UpdatePermission(ctx, id.ColumnId, id.PrincipalId, perm)
+		}).
+		Then(func() {
+			resp.State = req.Plan
+			resp.State.WithGrantOption = types.BoolValue(req.Plan.WithGrantOption.ValueBool())
+		})
+}
+
+func (r res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	id := parsePermissionId(ctx, req.State.Id.ValueString())
+	var table sql.Table
+
+	req.
+		Then(func() { table = sql.GetTable(ctx, sql.GetDatabase(ctx, req.Conn, id.DbId), id.TableId) }).
+		Then(func() { table.RevokePermission(ctx, id.ColumnId, id.PrincipalId, id.Permission) })
+}
+
+func (r res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	if !common.IsAttrSet(req.Config.TableName) {
+		return
+	}
+
+	name := req.Config.TableName.ValueString()
+	if parts := strings.SplitN(name, ".", 2); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		utils.AddAttributeError(ctx, path.Root("table_name"), "Invalid table name", fmt.Sprintf("Name %q must be schema-qualified, e.g. `dbo.%s`", name, name))
+	}
+}
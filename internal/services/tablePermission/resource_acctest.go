@@ -0,0 +1,88 @@
+package tablePermission
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	testCtx.ExecDefaultDB("CREATE TABLE [dbo].[test_table_permission] ([id] INT, [email] NVARCHAR(100) MASKED WITH (FUNCTION = 'email()'))")
+	defer testCtx.ExecDefaultDB("DROP TABLE [dbo].[test_table_permission]")
+
+	testCtx.ExecDefaultDB("CREATE ROLE [test_table_permission]")
+	defer testCtx.ExecDefaultDB("DROP ROLE [test_table_permission]")
+
+	var tableId, roleId int
+	err := testCtx.GetDefaultDBConnection().
+		QueryRow("SELECT OBJECT_ID('dbo.test_table_permission'), DATABASE_PRINCIPAL_ID('test_table_permission')").
+		Scan(&tableId, &roleId)
+	testCtx.Require.NoError(err, "Fetching IDs")
+
+	newResource := func(resName string, permission string, columnName string, withGrantOption bool) string {
+		additionalAttrs := ""
+
+		if columnName != "" {
+			additionalAttrs += fmt.Sprintf("column_name = %q\n", columnName)
+		}
+
+		if withGrantOption {
+			additionalAttrs += "with_grant_option = true"
+		}
+
+		return fmt.Sprintf(`
+resource "mssql_table_permission" %[1]q {
+	principal_id = %[2]q
+	table_name = "dbo.test_table_permission"
+	permission = %[3]q
+	%[4]s
+}
+`, resName, testCtx.DefaultDbId(roleId), permission, additionalAttrs)
+	}
+
+	checkPermissionState := func(permission string, columnId int, expectedState string) resource.TestCheckFunc {
+		return testCtx.SqlCheckDefaultDB(func(conn *sql.DB) error {
+			var state string
+			err := conn.
+				QueryRow("SELECT [state] FROM sys.database_permissions WHERE [class]=1 AND [major_id]=@p1 AND [minor_id]=@p2 AND [grantee_principal_id]=@p3 AND [permission_name]=@p4", tableId, columnId, roleId, permission).
+				Scan(&state)
+
+			testCtx.Assert.Equal(expectedState, state, "permission state")
+
+			return err
+		})
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("test", "SELECT", "", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkPermissionState("SELECT", 0, "G"),
+					resource.TestCheckResourceAttr("mssql_table_permission.test", "id", fmt.Sprintf("%d/%d/0/%d/SELECT", testCtx.DefaultDBId, tableId, roleId)),
+					resource.TestCheckResourceAttr("mssql_table_permission.test", "with_grant_option", "false"),
+				),
+			},
+			{
+				Config: newResource("test", "SELECT", "", true),
+				Check:  checkPermissionState("SELECT", 0, "W"),
+			},
+			{
+				Config: newResource("unmask", "UNMASK", "email", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkPermissionState("UNMASK", 2, "G"),
+					resource.TestCheckResourceAttr("mssql_table_permission.unmask", "id", fmt.Sprintf("%d/%d/2/%d/UNMASK", testCtx.DefaultDBId, tableId, roleId)),
+				),
+			},
+			{
+				ResourceName:      "mssql_table_permission.unmask",
+				Config:            newResource("unmask", "UNMASK", "email", false),
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%d/%d/2/%d/UNMASK", testCtx.DefaultDBId, tableId, roleId),
+				ImportStateVerify: true,
+				PlanOnly:          true,
+			},
+		},
+	})
+}
@@ -0,0 +1,99 @@
+package workloadGroup
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"strings"
+)
+
+// defaultPoolId is the ID of built-in `default` resource pool.
+const defaultPoolId sql.ResourcePoolId = 2
+
+var importanceLevels = []string{"LOW", "MEDIUM", "HIGH"}
+
+var attrDescriptions = map[string]string{
+	"id":                               "Workload group ID. Can be retrieved using `SELECT group_id FROM sys.resource_governor_workload_groups WHERE [name]='<group_name>'`.",
+	"name":                             "Name of the workload group. Cannot be longer than 128 chars.",
+	"resource_pool_id":                 "ID of the resource pool used by the group. Defaults to built-in `default` pool.",
+	"importance":                       "Relative importance of requests in the group. One of `LOW`, `MEDIUM`, `HIGH`. Defaults to `MEDIUM`.",
+	"request_max_memory_grant_percent": "Maximum amount of memory a single request can take from the pool, in percent. Defaults to `25`.",
+	"request_max_cpu_time_sec":         "Maximum CPU time a request can use, in seconds. `0` means unlimited. Defaults to `0`.",
+	"request_memory_grant_timeout_sec": "Maximum time a query can wait for a memory grant, in seconds. `0` means timeout calculated from query cost. Defaults to `0`.",
+	"max_dop":                          "Maximum degree of parallelism for parallel requests. `0` means global setting is used. Defaults to `0`.",
+	"group_max_requests":               "Maximum number of simultaneous requests executing in the group. `0` means unlimited. Defaults to `0`.",
+}
+
+type resourceData struct {
+	Id                           types.String `tfsdk:"id"`
+	Name                         types.String `tfsdk:"name"`
+	ResourcePoolId               types.String `tfsdk:"resource_pool_id"`
+	Importance                   types.String `tfsdk:"importance"`
+	RequestMaxMemoryGrantPercent types.Int64  `tfsdk:"request_max_memory_grant_percent"`
+	RequestMaxCpuTimeSec         types.Int64  `tfsdk:"request_max_cpu_time_sec"`
+	RequestMemoryGrantTimeoutSec types.Int64  `tfsdk:"request_memory_grant_timeout_sec"`
+	MaxDop                       types.Int64  `tfsdk:"max_dop"`
+	GroupMaxRequests             types.Int64  `tfsdk:"group_max_requests"`
+}
+
+func (d resourceData) toSettings(ctx context.Context) sql.WorkloadGroupSettings {
+	intOrDefault := func(value types.Int64, def int) int {
+		if common.IsAttrSet(value) {
+			return int(value.ValueInt64())
+		}
+
+		return def
+	}
+
+	settings := sql.WorkloadGroupSettings{
+		Name:                         d.Name.ValueString(),
+		ResourcePoolId:               defaultPoolId,
+		Importance:                   "MEDIUM",
+		RequestMaxMemoryGrantPercent: intOrDefault(d.RequestMaxMemoryGrantPercent, 25),
+		RequestMaxCpuTimeSec:         intOrDefault(d.RequestMaxCpuTimeSec, 0),
+		RequestMemoryGrantTimeoutSec: intOrDefault(d.RequestMemoryGrantTimeoutSec, 0),
+		MaxDop:                       intOrDefault(d.MaxDop, 0),
+		GroupMaxRequests:             intOrDefault(d.GroupMaxRequests, 0),
+	}
+
+	if common.IsAttrSet(d.ResourcePoolId) {
+		id, err := strconv.Atoi(d.ResourcePoolId.ValueString())
+		utils.AddError(ctx, fmt.Sprintf("Failed to convert resource pool ID '%s'", d.ResourcePoolId.ValueString()), err)
+		settings.ResourcePoolId = sql.ResourcePoolId(id)
+	}
+
+	if common.IsAttrSet(d.Importance) {
+		settings.Importance = strings.ToUpper(d.Importance.ValueString())
+	}
+
+	return settings
+}
+
+func (d resourceData) withSettings(settings sql.WorkloadGroupSettings) resourceData {
+	d.Name = types.StringValue(settings.Name)
+	d.ResourcePoolId = types.StringValue(fmt.Sprint(settings.ResourcePoolId))
+	// Keep casing used in the config, as long as the value is the same
+	if !common.IsAttrSet(d.Importance) || !strings.EqualFold(d.Importance.ValueString(), settings.Importance) {
+		d.Importance = types.StringValue(settings.Importance)
+	}
+	d.RequestMaxMemoryGrantPercent = types.Int64Value(int64(settings.RequestMaxMemoryGrantPercent))
+	d.RequestMaxCpuTimeSec = types.Int64Value(int64(settings.RequestMaxCpuTimeSec))
+	d.RequestMemoryGrantTimeoutSec = types.Int64Value(int64(settings.RequestMemoryGrantTimeoutSec))
+	d.MaxDop = types.Int64Value(int64(settings.MaxDop))
+	d.GroupMaxRequests = types.Int64Value(int64(settings.GroupMaxRequests))
+	return d
+}
+
+func (d resourceData) getId(ctx context.Context) sql.WorkloadGroupId {
+	if !common.IsAttrSet(d.Id) {
+		return 0
+	}
+
+	id, err := strconv.Atoi(d.Id.ValueString())
+	utils.AddError(ctx, fmt.Sprintf("Failed to convert resource ID '%s'", d.Id.ValueString()), err)
+	return sql.WorkloadGroupId(id)
+}
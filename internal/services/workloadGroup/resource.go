@@ -0,0 +1,166 @@
+package workloadGroup
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/core/resource"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/planModifiers"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/services/common"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+type res struct{}
+
+func (r *res) GetName() string {
+	return "workload_group"
+}
+
+func (r *res) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = "Manages Resource Governor workload group. Sessions are routed to workload groups by classifier function, see `mssql_resource_governor_classifier`. " +
+		"Every change is followed by `ALTER RESOURCE GOVERNOR RECONFIGURE`.\n\n" +
+		"-> **Note** Resource Governor is not available in Azure SQL Database."
+
+	int64Attr := func(name string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: attrDescriptions[name],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["id"],
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["name"],
+			Required:            true,
+			Validators:          validators.ResourceGovernorNameValidators,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"resource_pool_id": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["resource_pool_id"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"importance": schema.StringAttribute{
+			MarkdownDescription: attrDescriptions["importance"],
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				planModifiers.IgnoreCase(),
+			},
+		},
+		"request_max_memory_grant_percent": int64Attr("request_max_memory_grant_percent"),
+		"request_max_cpu_time_sec":         int64Attr("request_max_cpu_time_sec"),
+		"request_memory_grant_timeout_sec": int64Attr("request_memory_grant_timeout_sec"),
+		"max_dop":                          int64Attr("max_dop"),
+		"group_max_requests":               int64Attr("group_max_requests"),
+	}
+}
+
+func (r *res) Create(ctx context.Context, req resource.CreateRequest[resourceData], resp *resource.CreateResponse[resourceData]) {
+	var (
+		settings sql.WorkloadGroupSettings
+		group    sql.WorkloadGroup
+	)
+
+	req.
+		Then(func() { settings = req.Plan.toSettings(ctx) }).
+		Then(func() { group = sql.CreateWorkloadGroup(ctx, req.Conn, settings) }).
+		Then(func() {
+			resp.State = req.Plan.withSettings(group.GetSettings(ctx))
+			resp.State.Id = types.StringValue(fmt.Sprint(group.GetId(ctx)))
+		})
+}
+
+func (r *res) Read(ctx context.Context, req resource.ReadRequest[resourceData], resp *resource.ReadResponse[resourceData]) {
+	var (
+		group  sql.WorkloadGroup
+		exists bool
+	)
+
+	req.
+		Then(func() { group = sql.GetWorkloadGroup(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { exists = group.Exists(ctx) }).
+		Then(func() {
+			if exists {
+				resp.SetState(req.State.withSettings(group.GetSettings(ctx)))
+			}
+		})
+}
+
+func (r *res) Update(ctx context.Context, req resource.UpdateRequest[resourceData], resp *resource.UpdateResponse[resourceData]) {
+	var (
+		settings sql.WorkloadGroupSettings
+		group    sql.WorkloadGroup
+	)
+
+	req.
+		Then(func() { settings = req.Plan.toSettings(ctx) }).
+		Then(func() { group = sql.GetWorkloadGroup(ctx, req.Conn, req.Plan.getId(ctx)) }).
+		Then(func() { group.UpdateSettings(ctx, settings) }).
+		Then(func() { resp.State = req.Plan.withSettings(group.GetSettings(ctx)) })
+}
+
+func (r *res) Delete(ctx context.Context, req resource.DeleteRequest[resourceData], _ *resource.DeleteResponse[resourceData]) {
+	var group sql.WorkloadGroup
+
+	req.
+		Then(func() { group = sql.GetWorkloadGroup(ctx, req.Conn, req.State.getId(ctx)) }).
+		Then(func() { group.Drop(ctx) })
+}
+
+func (r *res) Validate(ctx context.Context, req resource.ValidateRequest[resourceData], _ *resource.ValidateResponse[resourceData]) {
+	isOneOf := func(value string, allowed []string) bool {
+		for _, a := range allowed {
+			if strings.EqualFold(value, a) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if common.IsAttrSet(req.Config.Importance) && !isOneOf(req.Config.Importance.ValueString(), importanceLevels) {
+		utils.AddAttributeError(ctx, path.Root("importance"), "Invalid importance", fmt.Sprintf("Importance %q is not supported", req.Config.Importance.ValueString()))
+	}
+
+	if v := req.Config.RequestMaxMemoryGrantPercent; common.IsAttrSet(v) && (v.ValueInt64() < 1 || v.ValueInt64() > 100) {
+		utils.AddAttributeError(ctx, path.Root("request_max_memory_grant_percent"), "Invalid percentage", "request_max_memory_grant_percent must be between 1 and 100")
+	}
+
+	nonNegative := map[string]types.Int64{
+		"request_max_cpu_time_sec":         req.Config.RequestMaxCpuTimeSec,
+		"request_memory_grant_timeout_sec": req.Config.RequestMemoryGrantTimeoutSec,
+		"max_dop":                          req.Config.MaxDop,
+		"group_max_requests":               req.Config.GroupMaxRequests,
+	}
+
+	for attrName, value := range nonNegative {
+		if common.IsAttrSet(value) && value.ValueInt64() < 0 {
+			utils.AddAttributeError(ctx, path.Root(attrName), "Invalid value", fmt.Sprintf("%s cannot be negative", attrName))
+		}
+	}
+}
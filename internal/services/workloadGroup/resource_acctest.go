@@ -0,0 +1,85 @@
+package workloadGroup
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testResource(testCtx *acctest.TestContext) {
+	if testCtx.IsAzureTest {
+		return
+	}
+
+	newResource := func(importance string, maxDop int) string {
+		return fmt.Sprintf(`
+resource "mssql_resource_pool" "test" {
+	name = "test_group_pool"
+}
+
+resource "mssql_workload_group" "test" {
+	name             = "test_group"
+	resource_pool_id = mssql_resource_pool.test.id
+	importance       = %q
+	max_dop          = %d
+}
+`, importance, maxDop)
+	}
+
+	var groupId string
+
+	fetchGroup := func(conn *sql.DB) (string, string, string, int, error) {
+		var (
+			id, poolName, importance string
+			maxDop                   int
+		)
+		err := conn.QueryRow(`SELECT g.[group_id], p.[name], g.[importance], g.[max_dop] FROM sys.resource_governor_workload_groups g
+			INNER JOIN sys.resource_governor_resource_pools p ON p.[pool_id] = g.[pool_id] WHERE g.[name]='test_group'`).
+			Scan(&id, &poolName, &importance, &maxDop)
+		return id, poolName, importance, maxDop, err
+	}
+
+	testCtx.Test(resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: newResource("LOW", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+						id, poolName, importance, maxDop, err := fetchGroup(conn)
+						groupId = id
+
+						testCtx.Assert.Equal("test_group_pool", poolName, "pool")
+						testCtx.Assert.Equal("Low", importance, "importance")
+						testCtx.Assert.Equal(2, maxDop, "max_dop")
+
+						return err
+					}),
+					resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPtr("mssql_workload_group.test", "id", &groupId),
+						resource.TestCheckResourceAttr("mssql_workload_group.test", "request_max_memory_grant_percent", "25"),
+						resource.TestCheckResourceAttr("mssql_workload_group.test", "group_max_requests", "0"),
+					),
+				),
+			},
+			{
+				Config: newResource("high", 4),
+				Check: testCtx.SqlCheckMaster(func(conn *sql.DB) error {
+					id, _, importance, maxDop, err := fetchGroup(conn)
+
+					testCtx.Assert.Equal(groupId, id, "group should not be recreated")
+					testCtx.Assert.Equal("High", importance, "importance")
+					testCtx.Assert.Equal(4, maxDop, "max_dop")
+
+					return err
+				}),
+			},
+			{
+				ResourceName:            "mssql_workload_group.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"importance"},
+			},
+		},
+	})
+}
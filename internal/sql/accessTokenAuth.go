@@ -0,0 +1,48 @@
+package sql
+
+import (
+	"context"
+	"database/sql/driver"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	mssql "github.com/microsoft/go-mssqldb"
+	"github.com/microsoft/go-mssqldb/msdsn"
+	"net/url"
+)
+
+// AccessTokenProvider is called every time new physical connection is established and must return valid Azure AD access token.
+type AccessTokenProvider func(ctx context.Context) (string, error)
+
+// StaticAccessToken returns provider always returning given, pre-acquired token.
+func StaticAccessToken(token string) AccessTokenProvider {
+	return func(context.Context) (string, error) {
+		return token, nil
+	}
+}
+
+// ConnectionAuthAccessToken authenticates using tokens supplied by the caller.
+type ConnectionAuthAccessToken struct {
+	TokenProvider AccessTokenProvider
+}
+
+func (auth ConnectionAuthAccessToken) configure(context.Context, *url.URL) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	if auth.TokenProvider == nil {
+		diags.AddError("Invalid access token auth config", "Access token provider must be set")
+	}
+
+	return diags
+}
+
+func (ConnectionAuthAccessToken) getDriverName() string {
+	return "sqlserver"
+}
+
+func (auth ConnectionAuthAccessToken) getConnector(_ context.Context, connStr string) (driver.Connector, error) {
+	config, err := msdsn.Parse(connStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return mssql.NewSecurityTokenConnector(config, auth.TokenProvider)
+}
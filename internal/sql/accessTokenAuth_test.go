@@ -0,0 +1,26 @@
+package sql
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/url"
+	"testing"
+)
+
+func TestAccessTokenAuthConfigure(t *testing.T) {
+	diags := ConnectionAuthAccessToken{}.configure(context.Background(), &url.URL{})
+
+	assert.True(t, diags.HasError(), "missing token provider")
+}
+
+func TestAccessTokenAuthGetConnector(t *testing.T) {
+	auth := ConnectionAuthAccessToken{TokenProvider: StaticAccessToken("test_token")}
+
+	connector, err := auth.getConnector(context.Background(), "sqlserver://test_host")
+
+	require.NoError(t, err)
+	assert.NotNil(t, connector)
+	token, _ := auth.TokenProvider(context.Background())
+	assert.Equal(t, "test_token", token)
+}
@@ -0,0 +1,246 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"sort"
+)
+
+const (
+	AgentNotificationEmail = "EMAIL"
+	AgentNotificationPager = "PAGER"
+)
+
+var agentNotificationMethods = map[string]int{
+	AgentNotificationEmail: 1,
+	AgentNotificationPager: 2,
+}
+
+// emptyAgentJobId is stored by SQL Server Agent in alerts not executing any job.
+const emptyAgentJobId AgentJobId = "00000000-0000-0000-0000-000000000000"
+
+type AgentAlertNotification struct {
+	OperatorId AgentOperatorId
+	Method     string
+}
+
+type AgentAlertSettings struct {
+	Name    string
+	Enabled bool
+	// Only one of MessageId and Severity can be set
+	MessageId    int
+	Severity     int
+	DatabaseName string
+	JobId        AgentJobId
+	// DelayBetweenResponses is expressed in seconds
+	DelayBetweenResponses int
+	Notifications         []AgentAlertNotification
+}
+
+func (s AgentAlertSettings) getNotificationMethods() map[AgentOperatorId]int {
+	methods := map[AgentOperatorId]int{}
+
+	for _, n := range s.Notifications {
+		methods[n.OperatorId] |= agentNotificationMethods[n.Method]
+	}
+
+	return methods
+}
+
+type AgentAlert interface {
+	GetId(context.Context) AgentAlertId
+	Exists(context.Context) bool
+	GetSettings(context.Context) AgentAlertSettings
+	UpdateSettings(context.Context, AgentAlertSettings)
+	Drop(context.Context)
+}
+
+func GetAgentAlert(_ context.Context, conn Connection, id AgentAlertId) AgentAlert {
+	return agentAlert{conn: conn, id: id}
+}
+
+func CreateAgentAlert(ctx context.Context, conn Connection, settings AgentAlertSettings) AgentAlert {
+	var alert agentAlert
+
+	utils.StopOnError(ctx).
+		Then(func() {
+			conn.exec(ctx, `EXEC msdb.dbo.sp_add_alert @name=@p1, @enabled=@p2, @message_id=@p3, @severity=@p4, @database_name=@p5, @job_id=@p6,
+    @delay_between_responses=@p7`,
+				settings.Name, settings.Enabled, settings.MessageId, settings.Severity, nullIfEmpty(settings.DatabaseName), nullIfEmpty(string(settings.JobId)),
+				settings.DelayBetweenResponses)
+		}).
+		Then(func() {
+			alert.conn = conn
+			err := conn.getSqlConnection(ctx).QueryRowContext(ctx, "SELECT [id] FROM msdb.dbo.sysalerts WHERE [name]=@p1", settings.Name).Scan(&alert.id)
+			utils.AddError(ctx, "Failed to retrieve agent alert ID", err)
+		}).
+		Then(func() {
+			alert.updateNotifications(ctx, settings.Name, map[AgentOperatorId]int{}, settings.getNotificationMethods())
+		})
+
+	if utils.HasError(ctx) {
+		return nil
+	}
+
+	return alert
+}
+
+var _ AgentAlert = agentAlert{}
+
+type agentAlert struct {
+	conn Connection
+	id   AgentAlertId
+}
+
+func (a agentAlert) GetId(context.Context) AgentAlertId {
+	return a.id
+}
+
+func (a agentAlert) Exists(ctx context.Context) bool {
+	switch _, err := a.getSettingsRaw(ctx); err {
+	case sql.ErrNoRows:
+		return false
+	case nil:
+		return true
+	default:
+		utils.AddError(ctx, "Failed to check if agent alert exists", err)
+		return false
+	}
+}
+
+func (a agentAlert) GetSettings(ctx context.Context) AgentAlertSettings {
+	settings, err := a.getSettingsRaw(ctx)
+	if err != nil {
+		utils.AddError(ctx, "Failed to retrieve agent alert settings", err)
+		return settings
+	}
+
+	settings.Notifications = a.getNotifications(ctx)
+	return settings
+}
+
+func (a agentAlert) UpdateSettings(ctx context.Context, settings AgentAlertSettings) {
+	var current AgentAlertSettings
+
+	utils.StopOnError(ctx).
+		Then(func() { current = a.GetSettings(ctx) }).
+		Then(func() {
+			jobId := settings.JobId
+			if jobId == "" {
+				jobId = emptyAgentJobId
+			}
+
+			a.conn.exec(ctx, `EXEC msdb.dbo.sp_update_alert @name=@p1, @new_name=@p2, @enabled=@p3, @message_id=@p4, @severity=@p5, @database_name=@p6,
+    @job_id=@p7, @delay_between_responses=@p8`,
+				current.Name, settings.Name, settings.Enabled, settings.MessageId, settings.Severity, settings.DatabaseName, jobId, settings.DelayBetweenResponses)
+		}).
+		Then(func() {
+			a.updateNotifications(ctx, settings.Name, current.getNotificationMethods(), settings.getNotificationMethods())
+		})
+}
+
+func (a agentAlert) Drop(ctx context.Context) {
+	var settings AgentAlertSettings
+
+	utils.StopOnError(ctx).
+		Then(func() {
+			var err error
+			settings, err = a.getSettingsRaw(ctx)
+			utils.AddError(ctx, "Failed to retrieve agent alert settings", err)
+		}).
+		Then(func() { a.conn.exec(ctx, "EXEC msdb.dbo.sp_delete_alert @name=@p1", settings.Name) })
+}
+
+func (a agentAlert) updateNotifications(ctx context.Context, alertName string, current map[AgentOperatorId]int, desired map[AgentOperatorId]int) {
+	getOperatorName := func(id AgentOperatorId) string {
+		return GetAgentOperator(ctx, a.conn, id).GetSettings(ctx).Name
+	}
+
+	for _, id := range sortedOperatorIds(current) {
+		if _, ok := desired[id]; !ok && !utils.HasError(ctx) {
+			a.conn.exec(ctx, "EXEC msdb.dbo.sp_delete_notification @alert_name=@p1, @operator_name=@p2", alertName, getOperatorName(id))
+		}
+	}
+
+	for _, id := range sortedOperatorIds(desired) {
+		if utils.HasError(ctx) {
+			return
+		}
+
+		method := desired[id]
+
+		switch currentMethod, ok := current[id]; {
+		case !ok:
+			a.conn.exec(ctx, "EXEC msdb.dbo.sp_add_notification @alert_name=@p1, @operator_name=@p2, @notification_method=@p3", alertName, getOperatorName(id), method)
+		case currentMethod != method:
+			a.conn.exec(ctx, "EXEC msdb.dbo.sp_update_notification @alert_name=@p1, @operator_name=@p2, @notification_method=@p3", alertName, getOperatorName(id), method)
+		}
+	}
+}
+
+func (a agentAlert) getSettingsRaw(ctx context.Context) (AgentAlertSettings, error) {
+	var settings AgentAlertSettings
+	err := a.conn.getSqlConnection(ctx).
+		QueryRowContext(ctx, `
+SELECT
+    [name],
+    [enabled],
+    [message_id],
+    [severity],
+    ISNULL([database_name], ''),
+    CONVERT(NVARCHAR(36), [job_id]),
+    [delay_between_responses]
+FROM msdb.dbo.sysalerts
+WHERE [id]=@p1`, a.id).
+		Scan(&settings.Name, &settings.Enabled, &settings.MessageId, &settings.Severity, &settings.DatabaseName, &settings.JobId, &settings.DelayBetweenResponses)
+
+	if settings.JobId == emptyAgentJobId {
+		settings.JobId = ""
+	}
+
+	return settings, err
+}
+
+func (a agentAlert) getNotifications(ctx context.Context) []AgentAlertNotification {
+	const errorSummary = "Failed to retrieve agent alert notifications"
+	var notifications []AgentAlertNotification
+
+	rows, err := a.conn.getSqlConnection(ctx).
+		QueryContext(ctx, "SELECT [operator_id], [notification_method] FROM msdb.dbo.sysnotifications WHERE [alert_id]=@p1", a.id)
+	if err != nil {
+		utils.AddError(ctx, errorSummary, err)
+		return notifications
+	}
+
+	for rows.Next() {
+		var (
+			operatorId AgentOperatorId
+			methods    int
+		)
+
+		if err := rows.Scan(&operatorId, &methods); err != nil {
+			utils.AddError(ctx, errorSummary, err)
+			return notifications
+		}
+
+		for _, method := range []string{AgentNotificationEmail, AgentNotificationPager} {
+			if methods&agentNotificationMethods[method] != 0 {
+				notifications = append(notifications, AgentAlertNotification{OperatorId: operatorId, Method: method})
+			}
+		}
+	}
+
+	utils.AddError(ctx, errorSummary, rows.Err())
+	return notifications
+}
+
+func sortedOperatorIds(methods map[AgentOperatorId]int) []AgentOperatorId {
+	var ids []AgentOperatorId
+	for id := range methods {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
@@ -0,0 +1,140 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestAgentAlertTestSuite(t *testing.T) {
+	s := &AgentAlertTestSuite{}
+	suite.Run(t, s)
+}
+
+type AgentAlertTestSuite struct {
+	SqlTestSuite
+	alert agentAlert
+}
+
+func (s *AgentAlertTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.alert = agentAlert{conn: s.connMock, id: AgentAlertId(rand.Int())}
+}
+
+func (s *AgentAlertTestSuite) TestCreate() {
+	expectExactExec(s.mock, `EXEC msdb.dbo.sp_add_alert @name=@p1, @enabled=@p2, @message_id=@p3, @severity=@p4, @database_name=@p5, @job_id=@p6,
+    @delay_between_responses=@p7`).
+		WithArgs("severity_17", true, 0, 17, nil, nil, 60).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactQuery(s.mock, "SELECT [id] FROM msdb.dbo.sysalerts WHERE [name]=@p1").WithArgs("severity_17").WillReturnRows(newRows("id").AddRow(5))
+	s.expectOperatorNameQuery(3, "dba")
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_add_notification @alert_name=@p1, @operator_name=@p2, @notification_method=@p3").
+		WithArgs("severity_17", "dba", 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	alert := CreateAgentAlert(s.ctx, s.connMock, AgentAlertSettings{
+		Name:                  "severity_17",
+		Enabled:               true,
+		Severity:              17,
+		DelayBetweenResponses: 60,
+		Notifications: []AgentAlertNotification{
+			{OperatorId: 3, Method: AgentNotificationEmail},
+			{OperatorId: 3, Method: AgentNotificationPager},
+		},
+	})
+
+	s.Equal(AgentAlertId(5), alert.GetId(s.ctx))
+}
+
+func (s *AgentAlertTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	s.True(s.alert.Exists(s.ctx))
+}
+
+func (s *AgentAlertTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.alert.Exists(s.ctx))
+}
+
+func (s *AgentAlertTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+	s.expectNotificationsQuery()
+
+	s.Equal(AgentAlertSettings{
+		Name:                  "severity_17",
+		Enabled:               true,
+		Severity:              17,
+		DelayBetweenResponses: 60,
+		Notifications: []AgentAlertNotification{
+			{OperatorId: 3, Method: AgentNotificationEmail},
+			{OperatorId: 3, Method: AgentNotificationPager},
+			{OperatorId: 4, Method: AgentNotificationEmail},
+		},
+	}, s.alert.GetSettings(s.ctx))
+}
+
+func (s *AgentAlertTestSuite) TestUpdateSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+	s.expectNotificationsQuery()
+	expectExactExec(s.mock, `EXEC msdb.dbo.sp_update_alert @name=@p1, @new_name=@p2, @enabled=@p3, @message_id=@p4, @severity=@p5, @database_name=@p6,
+    @job_id=@p7, @delay_between_responses=@p8`).
+		WithArgs("severity_17", "error_50001", true, 50001, 0, "test", "3F2504E0-4F89-11D3-9A0C-0305E82C3301", 0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectOperatorNameQuery(4, "team")
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_delete_notification @alert_name=@p1, @operator_name=@p2").WithArgs("error_50001", "team").WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectOperatorNameQuery(3, "dba")
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_update_notification @alert_name=@p1, @operator_name=@p2, @notification_method=@p3").
+		WithArgs("error_50001", "dba", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.alert.UpdateSettings(s.ctx, AgentAlertSettings{
+		Name:          "error_50001",
+		Enabled:       true,
+		MessageId:     50001,
+		DatabaseName:  "test",
+		JobId:         "3F2504E0-4F89-11D3-9A0C-0305E82C3301",
+		Notifications: []AgentAlertNotification{{OperatorId: 3, Method: AgentNotificationEmail}},
+	})
+}
+
+func (s *AgentAlertTestSuite) TestDrop() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_delete_alert @name=@p1").WithArgs("severity_17").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.alert.Drop(s.ctx)
+}
+
+func (s *AgentAlertTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, `
+SELECT
+    [name],
+    [enabled],
+    [message_id],
+    [severity],
+    ISNULL([database_name], ''),
+    CONVERT(NVARCHAR(36), [job_id]),
+    [delay_between_responses]
+FROM msdb.dbo.sysalerts
+WHERE [id]=@p1`).WithArgs(s.alert.id)
+}
+
+func (s *AgentAlertTestSuite) newSettingsRows() *sqlmock.Rows {
+	return newRows("name", "enabled", "message_id", "severity", "database_name", "job_id", "delay_between_responses").
+		AddRow("severity_17", true, 0, 17, "", "00000000-0000-0000-0000-000000000000", 60)
+}
+
+func (s *AgentAlertTestSuite) expectNotificationsQuery() {
+	expectExactQuery(s.mock, "SELECT [operator_id], [notification_method] FROM msdb.dbo.sysnotifications WHERE [alert_id]=@p1").
+		WithArgs(s.alert.id).
+		WillReturnRows(newRows("operator_id", "notification_method").AddRow(3, 3).AddRow(4, 1))
+}
+
+func (s *AgentAlertTestSuite) expectOperatorNameQuery(id int, name string) {
+	expectExactQuery(s.mock, "SELECT [name], [enabled], ISNULL([email_address], ''), ISNULL([pager_address], '') FROM msdb.dbo.sysoperators WHERE [id]=@p1").
+		WithArgs(id).
+		WillReturnRows(newRows("name", "enabled", "email_address", "pager_address").AddRow(name, true, "", ""))
+}
@@ -0,0 +1,309 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+)
+
+const (
+	AgentJobStepQuitWithSuccess = "QUIT_WITH_SUCCESS"
+	AgentJobStepQuitWithFailure = "QUIT_WITH_FAILURE"
+	AgentJobStepGoToNextStep    = "GO_TO_NEXT_STEP"
+	AgentJobStepGoToStep        = "GO_TO_STEP"
+)
+
+var agentJobStepActions = map[string]int{
+	AgentJobStepQuitWithSuccess: 1,
+	AgentJobStepQuitWithFailure: 2,
+	AgentJobStepGoToNextStep:    3,
+	AgentJobStepGoToStep:        4,
+}
+
+type AgentJobStep struct {
+	Name         string
+	Command      string
+	DatabaseName string
+
+	OnSuccessAction string
+	// OnSuccessStepId is used only with GO_TO_STEP action
+	OnSuccessStepId int
+	OnFailureAction string
+	// OnFailureStepId is used only with GO_TO_STEP action
+	OnFailureStepId int
+
+	RetryAttempts int
+	// RetryInterval is expressed in minutes
+	RetryInterval int
+}
+
+func (s AgentJobStep) toSqlArgs(jobId AgentJobId, stepId int) []any {
+	return []any{
+		jobId,
+		stepId,
+		s.Name,
+		s.Command,
+		s.DatabaseName,
+		agentJobStepActions[s.OnSuccessAction],
+		s.OnSuccessStepId,
+		agentJobStepActions[s.OnFailureAction],
+		s.OnFailureStepId,
+		s.RetryAttempts,
+		s.RetryInterval,
+	}
+}
+
+const agentJobStepSqlParams = `@job_id=@p1, @step_id=@p2, @step_name=@p3, @subsystem=N'TSQL', @command=@p4, @database_name=@p5,
+    @on_success_action=@p6, @on_success_step_id=@p7, @on_fail_action=@p8, @on_fail_step_id=@p9, @retry_attempts=@p10, @retry_interval=@p11`
+
+type AgentJobSettings struct {
+	Name        string
+	Description string
+	Enabled     bool
+	// OwnerLoginName defaults to the login used by the provider, when empty
+	OwnerLoginName string
+	Steps          []AgentJobStep
+	ScheduleIds    []AgentScheduleId
+}
+
+type AgentJob interface {
+	GetId(context.Context) AgentJobId
+	Exists(context.Context) bool
+	GetSettings(context.Context) AgentJobSettings
+	UpdateSettings(context.Context, AgentJobSettings)
+	Drop(context.Context)
+}
+
+func GetAgentJob(_ context.Context, conn Connection, id AgentJobId) AgentJob {
+	return agentJob{conn: conn, id: id}
+}
+
+// CreateAgentJob creates the job targeting local server, so it can be executed by the SQL Server Agent.
+func CreateAgentJob(ctx context.Context, conn Connection, settings AgentJobSettings) AgentJob {
+	var job agentJob
+
+	utils.StopOnError(ctx).
+		Then(func() {
+			err := conn.getSqlConnection(ctx).
+				QueryRowContext(ctx, `
+SET XACT_ABORT ON;
+DECLARE @job_id UNIQUEIDENTIFIER;
+DECLARE @owner NVARCHAR(128) = ISNULL(NULLIF(@p4, ''), SUSER_SNAME());
+BEGIN TRANSACTION;
+EXEC msdb.dbo.sp_add_job @job_name=@p1, @description=@p2, @enabled=@p3, @owner_login_name=@owner, @job_id=@job_id OUTPUT;
+EXEC msdb.dbo.sp_add_jobserver @job_id=@job_id;
+COMMIT;
+SELECT CONVERT(NVARCHAR(36), @job_id)`, settings.Name, settings.Description, settings.Enabled, settings.OwnerLoginName).
+				Scan(&job.id)
+			utils.AddError(ctx, "Failed to create agent job", err)
+			job.conn = conn
+		}).
+		Then(func() {
+			for i, step := range settings.Steps {
+				if utils.HasError(ctx) {
+					return
+				}
+
+				job.conn.exec(ctx, "EXEC msdb.dbo.sp_add_jobstep "+agentJobStepSqlParams, step.toSqlArgs(job.id, i+1)...)
+			}
+		}).
+		Then(func() { job.updateSchedules(ctx, nil, settings.ScheduleIds) })
+
+	if utils.HasError(ctx) {
+		// Partially created job would not be recorded in the state, and would cause the next attempt to fail
+		if job.id != "" {
+			job.Drop(ctx)
+		}
+		return nil
+	}
+
+	return job
+}
+
+var _ AgentJob = agentJob{}
+
+type agentJob struct {
+	conn Connection
+	id   AgentJobId
+}
+
+func (j agentJob) GetId(context.Context) AgentJobId {
+	return j.id
+}
+
+func (j agentJob) Exists(ctx context.Context) bool {
+	switch _, err := j.getSettingsRaw(ctx); err {
+	case sql.ErrNoRows:
+		return false
+	case nil:
+		return true
+	default:
+		utils.AddError(ctx, "Failed to check if agent job exists", err)
+		return false
+	}
+}
+
+func (j agentJob) GetSettings(ctx context.Context) AgentJobSettings {
+	settings, err := j.getSettingsRaw(ctx)
+	if err != nil {
+		utils.AddError(ctx, "Failed to retrieve agent job settings", err)
+		return settings
+	}
+
+	utils.StopOnError(ctx).
+		Then(func() { settings.Steps = j.getSteps(ctx) }).
+		Then(func() { settings.ScheduleIds = j.getScheduleIds(ctx) })
+
+	return settings
+}
+
+// UpdateSettings updates steps in place, as steps are identified by their position. Excessive steps are removed starting from the last one.
+func (j agentJob) UpdateSettings(ctx context.Context, settings AgentJobSettings) {
+	var current AgentJobSettings
+
+	utils.StopOnError(ctx).
+		Then(func() { current = j.GetSettings(ctx) }).
+		Then(func() {
+			if current.Name != settings.Name || current.Description != settings.Description || current.Enabled != settings.Enabled {
+				j.conn.exec(ctx, "EXEC msdb.dbo.sp_update_job @job_id=@p1, @new_name=@p2, @description=@p3, @enabled=@p4",
+					j.id, settings.Name, settings.Description, settings.Enabled)
+			}
+		}).
+		Then(func() {
+			if settings.OwnerLoginName != "" && current.OwnerLoginName != settings.OwnerLoginName {
+				j.conn.exec(ctx, "EXEC msdb.dbo.sp_update_job @job_id=@p1, @owner_login_name=@p2", j.id, settings.OwnerLoginName)
+			}
+		}).
+		Then(func() {
+			for i, step := range settings.Steps {
+				if utils.HasError(ctx) {
+					return
+				}
+
+				switch {
+				case i >= len(current.Steps):
+					j.conn.exec(ctx, "EXEC msdb.dbo.sp_add_jobstep "+agentJobStepSqlParams, step.toSqlArgs(j.id, i+1)...)
+				case current.Steps[i] != step:
+					j.conn.exec(ctx, "EXEC msdb.dbo.sp_update_jobstep "+agentJobStepSqlParams, step.toSqlArgs(j.id, i+1)...)
+				}
+			}
+
+			for stepId := len(current.Steps); stepId > len(settings.Steps) && !utils.HasError(ctx); stepId-- {
+				j.conn.exec(ctx, "EXEC msdb.dbo.sp_delete_jobstep @job_id=@p1, @step_id=@p2", j.id, stepId)
+			}
+		}).
+		Then(func() { j.updateSchedules(ctx, current.ScheduleIds, settings.ScheduleIds) })
+}
+
+func (j agentJob) Drop(ctx context.Context) {
+	j.conn.exec(ctx, "EXEC msdb.dbo.sp_delete_job @job_id=@p1, @delete_unused_schedule=0", j.id)
+}
+
+func (j agentJob) updateSchedules(ctx context.Context, current []AgentScheduleId, desired []AgentScheduleId) {
+	contains := func(ids []AgentScheduleId, id AgentScheduleId) bool {
+		for _, i := range ids {
+			if i == id {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, id := range current {
+		if !contains(desired, id) && !utils.HasError(ctx) {
+			j.conn.exec(ctx, "EXEC msdb.dbo.sp_detach_schedule @job_id=@p1, @schedule_id=@p2, @delete_unused_schedule=0", j.id, id)
+		}
+	}
+
+	for _, id := range desired {
+		if !contains(current, id) && !utils.HasError(ctx) {
+			j.conn.exec(ctx, "EXEC msdb.dbo.sp_attach_schedule @job_id=@p1, @schedule_id=@p2", j.id, id)
+		}
+	}
+}
+
+func (j agentJob) getSettingsRaw(ctx context.Context) (AgentJobSettings, error) {
+	var settings AgentJobSettings
+	err := j.conn.getSqlConnection(ctx).
+		QueryRowContext(ctx, "SELECT [name], ISNULL([description], ''), [enabled], ISNULL(SUSER_SNAME([owner_sid]), '') FROM msdb.dbo.sysjobs WHERE [job_id]=@p1", j.id).
+		Scan(&settings.Name, &settings.Description, &settings.Enabled, &settings.OwnerLoginName)
+	return settings, err
+}
+
+func (j agentJob) getSteps(ctx context.Context) []AgentJobStep {
+	const errorSummary = "Failed to retrieve agent job steps"
+	var steps []AgentJobStep
+
+	rows, err := j.conn.getSqlConnection(ctx).
+		QueryContext(ctx, `
+SELECT
+    [step_name],
+    [command],
+    ISNULL([database_name], ''),
+    [on_success_action],
+    [on_success_step_id],
+    [on_fail_action],
+    [on_fail_step_id],
+    [retry_attempts],
+    [retry_interval]
+FROM msdb.dbo.sysjobsteps
+WHERE [job_id]=@p1
+ORDER BY [step_id]`, j.id)
+	if err != nil {
+		utils.AddError(ctx, errorSummary, err)
+		return steps
+	}
+
+	for rows.Next() {
+		var (
+			step                             AgentJobStep
+			onSuccessAction, onFailureAction int
+		)
+
+		err := rows.Scan(&step.Name, &step.Command, &step.DatabaseName, &onSuccessAction, &step.OnSuccessStepId, &onFailureAction,
+			&step.OnFailureStepId, &step.RetryAttempts, &step.RetryInterval)
+		if err != nil {
+			utils.AddError(ctx, errorSummary, err)
+			return steps
+		}
+
+		step.OnSuccessAction = findMapKey(agentJobStepActions, onSuccessAction)
+		step.OnFailureAction = findMapKey(agentJobStepActions, onFailureAction)
+
+		// step IDs are kept by SQL Server even when action does not use them
+		if step.OnSuccessAction != AgentJobStepGoToStep {
+			step.OnSuccessStepId = 0
+		}
+		if step.OnFailureAction != AgentJobStepGoToStep {
+			step.OnFailureStepId = 0
+		}
+
+		steps = append(steps, step)
+	}
+
+	utils.AddError(ctx, errorSummary, rows.Err())
+	return steps
+}
+
+func (j agentJob) getScheduleIds(ctx context.Context) []AgentScheduleId {
+	const errorSummary = "Failed to retrieve agent job schedules"
+	var ids []AgentScheduleId
+
+	rows, err := j.conn.getSqlConnection(ctx).QueryContext(ctx, "SELECT [schedule_id] FROM msdb.dbo.sysjobschedules WHERE [job_id]=@p1", j.id)
+	if err != nil {
+		utils.AddError(ctx, errorSummary, err)
+		return ids
+	}
+
+	for rows.Next() {
+		var id AgentScheduleId
+		if err := rows.Scan(&id); err != nil {
+			utils.AddError(ctx, errorSummary, err)
+			return ids
+		}
+		ids = append(ids, id)
+	}
+
+	utils.AddError(ctx, errorSummary, rows.Err())
+	return ids
+}
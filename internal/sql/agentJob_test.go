@@ -0,0 +1,196 @@
+package sql
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestAgentJobTestSuite(t *testing.T) {
+	s := &AgentJobTestSuite{}
+	suite.Run(t, s)
+}
+
+type AgentJobTestSuite struct {
+	SqlTestSuite
+	job agentJob
+}
+
+func (s *AgentJobTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.job = agentJob{conn: s.connMock, id: "3F2504E0-4F89-11D3-9A0C-0305E82C3301"}
+}
+
+var testAgentJobSteps = []AgentJobStep{
+	{Name: "backup", Command: "BACKUP DATABASE [test] TO DISK = 'test.bak'", DatabaseName: "master", OnSuccessAction: AgentJobStepGoToNextStep, OnFailureAction: AgentJobStepGoToStep, OnFailureStepId: 3},
+	{Name: "cleanup", Command: "EXEC dbo.cleanup", DatabaseName: "test", OnSuccessAction: AgentJobStepQuitWithSuccess, OnFailureAction: AgentJobStepQuitWithFailure, RetryAttempts: 2, RetryInterval: 5},
+	{Name: "notify", Command: "EXEC dbo.notify", DatabaseName: "test", OnSuccessAction: AgentJobStepQuitWithFailure, OnFailureAction: AgentJobStepQuitWithFailure},
+}
+
+func (s *AgentJobTestSuite) TestCreate() {
+	expectExactQuery(s.mock, `
+SET XACT_ABORT ON;
+DECLARE @job_id UNIQUEIDENTIFIER;
+DECLARE @owner NVARCHAR(128) = ISNULL(NULLIF(@p4, ''), SUSER_SNAME());
+BEGIN TRANSACTION;
+EXEC msdb.dbo.sp_add_job @job_name=@p1, @description=@p2, @enabled=@p3, @owner_login_name=@owner, @job_id=@job_id OUTPUT;
+EXEC msdb.dbo.sp_add_jobserver @job_id=@job_id;
+COMMIT;
+SELECT CONVERT(NVARCHAR(36), @job_id)`).
+		WithArgs("maintenance", "Nightly maintenance", true, "").
+		WillReturnRows(newRows("job_id").AddRow(s.job.id))
+	s.expectAddStep(0, "sp_add_jobstep")
+	s.expectAddStep(1, "sp_add_jobstep")
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_attach_schedule @job_id=@p1, @schedule_id=@p2").WithArgs(s.job.id, 12).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	job := CreateAgentJob(s.ctx, s.connMock, AgentJobSettings{
+		Name:        "maintenance",
+		Description: "Nightly maintenance",
+		Enabled:     true,
+		Steps:       testAgentJobSteps[:2],
+		ScheduleIds: []AgentScheduleId{12},
+	})
+
+	s.Equal(s.job.id, job.GetId(s.ctx))
+}
+
+func (s *AgentJobTestSuite) TestCreateFailedStep() {
+	expectExactQuery(s.mock, `
+SET XACT_ABORT ON;
+DECLARE @job_id UNIQUEIDENTIFIER;
+DECLARE @owner NVARCHAR(128) = ISNULL(NULLIF(@p4, ''), SUSER_SNAME());
+BEGIN TRANSACTION;
+EXEC msdb.dbo.sp_add_job @job_name=@p1, @description=@p2, @enabled=@p3, @owner_login_name=@owner, @job_id=@job_id OUTPUT;
+EXEC msdb.dbo.sp_add_jobserver @job_id=@job_id;
+COMMIT;
+SELECT CONVERT(NVARCHAR(36), @job_id)`).
+		WithArgs("maintenance", "", false, "").
+		WillReturnRows(newRows("job_id").AddRow(s.job.id))
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_add_jobstep "+agentJobStepSqlParams).WillReturnError(errors.New("test_error"))
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_delete_job @job_id=@p1, @delete_unused_schedule=0").WithArgs(s.job.id).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	job := CreateAgentJob(s.ctx, s.connMock, AgentJobSettings{Name: "maintenance", Steps: testAgentJobSteps[:1]})
+
+	s.Nil(job)
+	s.verifyError(errors.New("test_error"))
+}
+
+func (s *AgentJobTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	s.True(s.job.Exists(s.ctx))
+}
+
+func (s *AgentJobTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.job.Exists(s.ctx))
+}
+
+func (s *AgentJobTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+	s.expectStepsQuery()
+	s.expectSchedulesQuery()
+
+	s.Equal(AgentJobSettings{
+		Name:           "maintenance",
+		Description:    "Nightly maintenance",
+		Enabled:        true,
+		OwnerLoginName: "sa",
+		Steps:          testAgentJobSteps[:2],
+		ScheduleIds:    []AgentScheduleId{12},
+	}, s.job.GetSettings(s.ctx))
+}
+
+func (s *AgentJobTestSuite) TestUpdateSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+	s.expectStepsQuery()
+	s.expectSchedulesQuery()
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_update_job @job_id=@p1, @new_name=@p2, @description=@p3, @enabled=@p4").
+		WithArgs(s.job.id, "maintenance", "Nightly maintenance", false).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_update_job @job_id=@p1, @owner_login_name=@p2").WithArgs(s.job.id, "agent_owner").WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectAddStep(2, "sp_add_jobstep")
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_detach_schedule @job_id=@p1, @schedule_id=@p2, @delete_unused_schedule=0").WithArgs(s.job.id, 12).WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_attach_schedule @job_id=@p1, @schedule_id=@p2").WithArgs(s.job.id, 13).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.job.UpdateSettings(s.ctx, AgentJobSettings{
+		Name:           "maintenance",
+		Description:    "Nightly maintenance",
+		OwnerLoginName: "agent_owner",
+		Steps:          testAgentJobSteps,
+		ScheduleIds:    []AgentScheduleId{13},
+	})
+}
+
+func (s *AgentJobTestSuite) TestUpdateStepsInPlace() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+	s.expectStepsQuery()
+	s.expectSchedulesQuery()
+
+	changed := testAgentJobSteps[0]
+	changed.Command = "SELECT 1"
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_update_jobstep "+agentJobStepSqlParams).
+		WithArgs(toDriverValues(changed.toSqlArgs(s.job.id, 1))...).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_delete_jobstep @job_id=@p1, @step_id=@p2").WithArgs(s.job.id, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.job.UpdateSettings(s.ctx, AgentJobSettings{
+		Name:           "maintenance",
+		Description:    "Nightly maintenance",
+		Enabled:        true,
+		OwnerLoginName: "sa",
+		Steps:          []AgentJobStep{changed},
+		ScheduleIds:    []AgentScheduleId{12},
+	})
+}
+
+func (s *AgentJobTestSuite) TestDrop() {
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_delete_job @job_id=@p1, @delete_unused_schedule=0").WithArgs(s.job.id).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.job.Drop(s.ctx)
+}
+
+func (s *AgentJobTestSuite) expectAddStep(idx int, proc string) {
+	expectExactExec(s.mock, "EXEC msdb.dbo."+proc+" "+agentJobStepSqlParams).
+		WithArgs(toDriverValues(testAgentJobSteps[idx].toSqlArgs(s.job.id, idx+1))...).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func (s *AgentJobTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, "SELECT [name], ISNULL([description], ''), [enabled], ISNULL(SUSER_SNAME([owner_sid]), '') FROM msdb.dbo.sysjobs WHERE [job_id]=@p1").
+		WithArgs(s.job.id)
+}
+
+func (s *AgentJobTestSuite) newSettingsRows() *sqlmock.Rows {
+	return newRows("name", "description", "enabled", "owner").AddRow("maintenance", "Nightly maintenance", true, "sa")
+}
+
+func (s *AgentJobTestSuite) expectStepsQuery() {
+	expectExactQuery(s.mock, `
+SELECT
+    [step_name],
+    [command],
+    ISNULL([database_name], ''),
+    [on_success_action],
+    [on_success_step_id],
+    [on_fail_action],
+    [on_fail_step_id],
+    [retry_attempts],
+    [retry_interval]
+FROM msdb.dbo.sysjobsteps
+WHERE [job_id]=@p1
+ORDER BY [step_id]`).
+		WithArgs(s.job.id).
+		WillReturnRows(newRows("step_name", "command", "database_name", "on_success_action", "on_success_step_id", "on_fail_action", "on_fail_step_id", "retry_attempts", "retry_interval").
+			AddRow("backup", "BACKUP DATABASE [test] TO DISK = 'test.bak'", "master", 3, 0, 4, 3, 0, 0).
+			AddRow("cleanup", "EXEC dbo.cleanup", "test", 1, 2, 2, 0, 2, 5))
+}
+
+func (s *AgentJobTestSuite) expectSchedulesQuery() {
+	expectExactQuery(s.mock, "SELECT [schedule_id] FROM msdb.dbo.sysjobschedules WHERE [job_id]=@p1").
+		WithArgs(s.job.id).
+		WillReturnRows(newRows("schedule_id").AddRow(12))
+}
@@ -0,0 +1,107 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+)
+
+type AgentOperatorSettings struct {
+	Name         string
+	Enabled      bool
+	EmailAddress string
+	PagerAddress string
+}
+
+type AgentOperator interface {
+	GetId(context.Context) AgentOperatorId
+	Exists(context.Context) bool
+	GetSettings(context.Context) AgentOperatorSettings
+	UpdateSettings(context.Context, AgentOperatorSettings)
+	Drop(context.Context)
+}
+
+func GetAgentOperator(_ context.Context, conn Connection, id AgentOperatorId) AgentOperator {
+	return agentOperator{conn: conn, id: id}
+}
+
+func GetAgentOperatorByName(ctx context.Context, conn Connection, name string) AgentOperator {
+	var id AgentOperatorId
+
+	err := conn.getSqlConnection(ctx).QueryRowContext(ctx, "SELECT [id] FROM msdb.dbo.sysoperators WHERE [name]=@p1", name).Scan(&id)
+	if err != nil {
+		utils.AddError(ctx, "Failed to retrieve agent operator ID", err)
+		return nil
+	}
+
+	return GetAgentOperator(ctx, conn, id)
+}
+
+func CreateAgentOperator(ctx context.Context, conn Connection, settings AgentOperatorSettings) AgentOperator {
+	var operator AgentOperator
+
+	utils.StopOnError(ctx).
+		Then(func() {
+			conn.exec(ctx, "EXEC msdb.dbo.sp_add_operator @name=@p1, @enabled=@p2, @email_address=@p3, @pager_address=@p4",
+				settings.Name, settings.Enabled, settings.EmailAddress, settings.PagerAddress)
+		}).
+		Then(func() { operator = GetAgentOperatorByName(ctx, conn, settings.Name) })
+
+	return operator
+}
+
+var _ AgentOperator = agentOperator{}
+
+type agentOperator struct {
+	conn Connection
+	id   AgentOperatorId
+}
+
+func (o agentOperator) GetId(context.Context) AgentOperatorId {
+	return o.id
+}
+
+func (o agentOperator) Exists(ctx context.Context) bool {
+	switch _, err := o.getSettingsRaw(ctx); err {
+	case sql.ErrNoRows:
+		return false
+	case nil:
+		return true
+	default:
+		utils.AddError(ctx, "Failed to check if agent operator exists", err)
+		return false
+	}
+}
+
+func (o agentOperator) GetSettings(ctx context.Context) AgentOperatorSettings {
+	settings, err := o.getSettingsRaw(ctx)
+	utils.AddError(ctx, "Failed to retrieve agent operator settings", err)
+	return settings
+}
+
+func (o agentOperator) UpdateSettings(ctx context.Context, settings AgentOperatorSettings) {
+	var current AgentOperatorSettings
+
+	utils.StopOnError(ctx).
+		Then(func() { current = o.GetSettings(ctx) }).
+		Then(func() {
+			o.conn.exec(ctx, "EXEC msdb.dbo.sp_update_operator @name=@p1, @new_name=@p2, @enabled=@p3, @email_address=@p4, @pager_address=@p5",
+				current.Name, settings.Name, settings.Enabled, settings.EmailAddress, settings.PagerAddress)
+		})
+}
+
+func (o agentOperator) Drop(ctx context.Context) {
+	var settings AgentOperatorSettings
+
+	utils.StopOnError(ctx).
+		Then(func() { settings = o.GetSettings(ctx) }).
+		Then(func() { o.conn.exec(ctx, "EXEC msdb.dbo.sp_delete_operator @name=@p1", settings.Name) })
+}
+
+func (o agentOperator) getSettingsRaw(ctx context.Context) (AgentOperatorSettings, error) {
+	var settings AgentOperatorSettings
+	err := o.conn.getSqlConnection(ctx).
+		QueryRowContext(ctx, "SELECT [name], [enabled], ISNULL([email_address], ''), ISNULL([pager_address], '') FROM msdb.dbo.sysoperators WHERE [id]=@p1", o.id).
+		Scan(&settings.Name, &settings.Enabled, &settings.EmailAddress, &settings.PagerAddress)
+	return settings, err
+}
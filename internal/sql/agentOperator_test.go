@@ -0,0 +1,80 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestAgentOperatorTestSuite(t *testing.T) {
+	s := &AgentOperatorTestSuite{}
+	suite.Run(t, s)
+}
+
+type AgentOperatorTestSuite struct {
+	SqlTestSuite
+	operator agentOperator
+}
+
+func (s *AgentOperatorTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.operator = agentOperator{conn: s.connMock, id: AgentOperatorId(rand.Int())}
+}
+
+func (s *AgentOperatorTestSuite) TestCreate() {
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_add_operator @name=@p1, @enabled=@p2, @email_address=@p3, @pager_address=@p4").
+		WithArgs("dba", true, "dba@example.com", "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactQuery(s.mock, "SELECT [id] FROM msdb.dbo.sysoperators WHERE [name]=@p1").
+		WithArgs("dba").
+		WillReturnRows(newRows("id").AddRow(3))
+
+	operator := CreateAgentOperator(s.ctx, s.connMock, AgentOperatorSettings{Name: "dba", Enabled: true, EmailAddress: "dba@example.com"})
+
+	s.Equal(AgentOperatorId(3), operator.GetId(s.ctx))
+}
+
+func (s *AgentOperatorTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	s.True(s.operator.Exists(s.ctx))
+}
+
+func (s *AgentOperatorTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.operator.Exists(s.ctx))
+}
+
+func (s *AgentOperatorTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	s.Equal(AgentOperatorSettings{Name: "dba", Enabled: true, EmailAddress: "dba@example.com"}, s.operator.GetSettings(s.ctx))
+}
+
+func (s *AgentOperatorTestSuite) TestUpdateSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_update_operator @name=@p1, @new_name=@p2, @enabled=@p3, @email_address=@p4, @pager_address=@p5").
+		WithArgs("dba", "dba_team", false, "team@example.com", "pager@example.com").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.operator.UpdateSettings(s.ctx, AgentOperatorSettings{Name: "dba_team", EmailAddress: "team@example.com", PagerAddress: "pager@example.com"})
+}
+
+func (s *AgentOperatorTestSuite) TestDrop() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_delete_operator @name=@p1").WithArgs("dba").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.operator.Drop(s.ctx)
+}
+
+func (s *AgentOperatorTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, "SELECT [name], [enabled], ISNULL([email_address], ''), ISNULL([pager_address], '') FROM msdb.dbo.sysoperators WHERE [id]=@p1").
+		WithArgs(s.operator.id)
+}
+
+func (s *AgentOperatorTestSuite) newSettingsRows() *sqlmock.Rows {
+	return newRows("name", "enabled", "email_address", "pager_address").AddRow("dba", true, "dba@example.com", "")
+}
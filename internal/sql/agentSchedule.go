@@ -0,0 +1,163 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+)
+
+var agentScheduleFrequencyTypes = map[string]int{
+	"ONCE":             1,
+	"DAILY":            4,
+	"WEEKLY":           8,
+	"MONTHLY":          16,
+	"MONTHLY_RELATIVE": 32,
+	"AGENT_START":      64,
+	"IDLE":             128,
+}
+
+var agentScheduleSubdayTypes = map[string]int{
+	"ONCE":    1,
+	"SECONDS": 2,
+	"MINUTES": 4,
+	"HOURS":   8,
+}
+
+type AgentScheduleSettings struct {
+	Name                      string
+	Enabled                   bool
+	FrequencyType             string
+	FrequencyInterval         int
+	FrequencySubdayType       string
+	FrequencySubdayInterval   int
+	FrequencyRelativeInterval int
+	FrequencyRecurrenceFactor int
+
+	// ActiveStartDate and ActiveEndDate are formatted as YYYYMMDD, ActiveStartTime and ActiveEndTime as HHMMSS.
+	ActiveStartDate int
+	ActiveEndDate   int
+	ActiveStartTime int
+	ActiveEndTime   int
+}
+
+func (s AgentScheduleSettings) toSqlArgs() []any {
+	return []any{
+		s.Name,
+		s.Enabled,
+		agentScheduleFrequencyTypes[s.FrequencyType],
+		s.FrequencyInterval,
+		agentScheduleSubdayTypes[s.FrequencySubdayType],
+		s.FrequencySubdayInterval,
+		s.FrequencyRelativeInterval,
+		s.FrequencyRecurrenceFactor,
+		nullIfZero(s.ActiveStartDate),
+		s.ActiveEndDate,
+		s.ActiveStartTime,
+		s.ActiveEndTime,
+	}
+}
+
+const agentScheduleSqlParams = `@enabled=@p2, @freq_type=@p3, @freq_interval=@p4, @freq_subday_type=@p5, @freq_subday_interval=@p6,
+    @freq_relative_interval=@p7, @freq_recurrence_factor=@p8, @active_start_date=@p9, @active_end_date=@p10,
+    @active_start_time=@p11, @active_end_time=@p12`
+
+type AgentSchedule interface {
+	GetId(context.Context) AgentScheduleId
+	Exists(context.Context) bool
+	GetSettings(context.Context) AgentScheduleSettings
+	UpdateSettings(context.Context, AgentScheduleSettings)
+	Drop(context.Context)
+}
+
+func GetAgentSchedule(_ context.Context, conn Connection, id AgentScheduleId) AgentSchedule {
+	return agentSchedule{conn: conn, id: id}
+}
+
+func CreateAgentSchedule(ctx context.Context, conn Connection, settings AgentScheduleSettings) AgentSchedule {
+	var id AgentScheduleId
+
+	err := conn.getSqlConnection(ctx).
+		QueryRowContext(ctx, `
+DECLARE @schedule_id INT;
+EXEC msdb.dbo.sp_add_schedule @schedule_name=@p1, `+agentScheduleSqlParams+`, @schedule_id=@schedule_id OUTPUT;
+SELECT @schedule_id`, settings.toSqlArgs()...).
+		Scan(&id)
+
+	if err != nil {
+		utils.AddError(ctx, "Failed to create agent schedule", err)
+		return nil
+	}
+
+	return GetAgentSchedule(ctx, conn, id)
+}
+
+var _ AgentSchedule = agentSchedule{}
+
+type agentSchedule struct {
+	conn Connection
+	id   AgentScheduleId
+}
+
+func (s agentSchedule) GetId(context.Context) AgentScheduleId {
+	return s.id
+}
+
+func (s agentSchedule) Exists(ctx context.Context) bool {
+	switch _, err := s.getSettingsRaw(ctx); err {
+	case sql.ErrNoRows:
+		return false
+	case nil:
+		return true
+	default:
+		utils.AddError(ctx, "Failed to check if agent schedule exists", err)
+		return false
+	}
+}
+
+func (s agentSchedule) GetSettings(ctx context.Context) AgentScheduleSettings {
+	settings, err := s.getSettingsRaw(ctx)
+	utils.AddError(ctx, "Failed to retrieve agent schedule settings", err)
+	return settings
+}
+
+func (s agentSchedule) UpdateSettings(ctx context.Context, settings AgentScheduleSettings) {
+	args := append(settings.toSqlArgs(), s.id)
+	s.conn.exec(ctx, "EXEC msdb.dbo.sp_update_schedule @schedule_id=@p13, @new_name=@p1, "+agentScheduleSqlParams, args...)
+}
+
+func (s agentSchedule) Drop(ctx context.Context) {
+	s.conn.exec(ctx, "EXEC msdb.dbo.sp_delete_schedule @schedule_id=@p1, @force_delete=1", s.id)
+}
+
+func (s agentSchedule) getSettingsRaw(ctx context.Context) (AgentScheduleSettings, error) {
+	var (
+		settings             AgentScheduleSettings
+		freqType, subdayType int
+	)
+
+	err := s.conn.getSqlConnection(ctx).
+		QueryRowContext(ctx, `
+SELECT
+    [name],
+    [enabled],
+    [freq_type],
+    [freq_interval],
+    [freq_subday_type],
+    [freq_subday_interval],
+    [freq_relative_interval],
+    [freq_recurrence_factor],
+    [active_start_date],
+    [active_end_date],
+    [active_start_time],
+    [active_end_time]
+FROM msdb.dbo.sysschedules
+WHERE [schedule_id]=@p1`, s.id).
+		Scan(&settings.Name, &settings.Enabled, &freqType, &settings.FrequencyInterval, &subdayType, &settings.FrequencySubdayInterval,
+			&settings.FrequencyRelativeInterval, &settings.FrequencyRecurrenceFactor, &settings.ActiveStartDate, &settings.ActiveEndDate,
+			&settings.ActiveStartTime, &settings.ActiveEndTime)
+
+	settings.FrequencyType = findMapKey(agentScheduleFrequencyTypes, freqType)
+	settings.FrequencySubdayType = findMapKey(agentScheduleSubdayTypes, subdayType)
+
+	return settings, err
+}
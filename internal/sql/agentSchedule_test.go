@@ -0,0 +1,120 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestAgentScheduleTestSuite(t *testing.T) {
+	s := &AgentScheduleTestSuite{}
+	suite.Run(t, s)
+}
+
+type AgentScheduleTestSuite struct {
+	SqlTestSuite
+	schedule agentSchedule
+}
+
+func (s *AgentScheduleTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.schedule = agentSchedule{conn: s.connMock, id: AgentScheduleId(rand.Int())}
+}
+
+var testAgentScheduleSettings = AgentScheduleSettings{
+	Name:                    "nightly",
+	Enabled:                 true,
+	FrequencyType:           "DAILY",
+	FrequencyInterval:       1,
+	FrequencySubdayType:     "ONCE",
+	ActiveEndDate:           99991231,
+	ActiveStartTime:         20000,
+	ActiveEndTime:           235959,
+	FrequencySubdayInterval: 0,
+}
+
+func (s *AgentScheduleTestSuite) TestCreate() {
+	expectExactQuery(s.mock, `
+DECLARE @schedule_id INT;
+EXEC msdb.dbo.sp_add_schedule @schedule_name=@p1, `+agentScheduleSqlParams+`, @schedule_id=@schedule_id OUTPUT;
+SELECT @schedule_id`).
+		WithArgs("nightly", true, 4, 1, 1, 0, 0, 0, nil, 99991231, 20000, 235959).
+		WillReturnRows(newRows("schedule_id").AddRow(12))
+
+	schedule := CreateAgentSchedule(s.ctx, s.connMock, testAgentScheduleSettings)
+
+	s.Equal(AgentScheduleId(12), schedule.GetId(s.ctx))
+}
+
+func (s *AgentScheduleTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	s.True(s.schedule.Exists(s.ctx))
+}
+
+func (s *AgentScheduleTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.schedule.Exists(s.ctx))
+}
+
+func (s *AgentScheduleTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	expected := testAgentScheduleSettings
+	expected.ActiveStartDate = 20240101
+	s.Equal(expected, s.schedule.GetSettings(s.ctx))
+}
+
+func (s *AgentScheduleTestSuite) TestUpdateSettings() {
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_update_schedule @schedule_id=@p13, @new_name=@p1, "+agentScheduleSqlParams).
+		WithArgs("hourly", true, 4, 1, 8, 1, 0, 0, 20240101, 99991231, 0, 235959, s.schedule.id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.schedule.UpdateSettings(s.ctx, AgentScheduleSettings{
+		Name:                    "hourly",
+		Enabled:                 true,
+		FrequencyType:           "DAILY",
+		FrequencyInterval:       1,
+		FrequencySubdayType:     "HOURS",
+		FrequencySubdayInterval: 1,
+		ActiveStartDate:         20240101,
+		ActiveEndDate:           99991231,
+		ActiveEndTime:           235959,
+	})
+}
+
+func (s *AgentScheduleTestSuite) TestDrop() {
+	expectExactExec(s.mock, "EXEC msdb.dbo.sp_delete_schedule @schedule_id=@p1, @force_delete=1").
+		WithArgs(s.schedule.id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.schedule.Drop(s.ctx)
+}
+
+func (s *AgentScheduleTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, `
+SELECT
+    [name],
+    [enabled],
+    [freq_type],
+    [freq_interval],
+    [freq_subday_type],
+    [freq_subday_interval],
+    [freq_relative_interval],
+    [freq_recurrence_factor],
+    [active_start_date],
+    [active_end_date],
+    [active_start_time],
+    [active_end_time]
+FROM msdb.dbo.sysschedules
+WHERE [schedule_id]=@p1`).WithArgs(s.schedule.id)
+}
+
+func (s *AgentScheduleTestSuite) newSettingsRows() *sqlmock.Rows {
+	return newRows("name", "enabled", "freq_type", "freq_interval", "freq_subday_type", "freq_subday_interval", "freq_relative_interval",
+		"freq_recurrence_factor", "active_start_date", "active_end_date", "active_start_time", "active_end_time").
+		AddRow("nightly", true, 4, 1, 1, 0, 0, 0, 20240101, 99991231, 20000, 235959)
+}
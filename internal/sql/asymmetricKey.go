@@ -0,0 +1,127 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+)
+
+type AsymmetricKeySettings struct {
+	Name               string
+	Algorithm          string
+	Thumbprint         string
+	FilePath           string
+	EncryptionPassword string
+}
+
+func (s AsymmetricKeySettings) toSqlDefinition() string {
+	if s.FilePath != "" {
+		return fmt.Sprintf("FROM FILE = %s", quoteString(s.FilePath))
+	}
+
+	def := fmt.Sprintf("WITH ALGORITHM = %s", s.Algorithm)
+
+	if s.EncryptionPassword != "" {
+		def += fmt.Sprintf(" ENCRYPTION BY PASSWORD = %s", quoteString(s.EncryptionPassword))
+	}
+
+	return def
+}
+
+type AsymmetricKey interface {
+	GetId(context.Context) AsymmetricKeyId
+	GetDb(context.Context) Database
+	Exists(context.Context) bool
+	GetSettings(context.Context) AsymmetricKeySettings
+	Drop(context.Context)
+}
+
+func GetAsymmetricKey(_ context.Context, db Database, id AsymmetricKeyId) AsymmetricKey {
+	return asymmetricKey{db: db, id: id}
+}
+
+func GetAsymmetricKeyByName(ctx context.Context, db Database, name string) AsymmetricKey {
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) AsymmetricKey {
+		var id AsymmetricKeyId
+
+		if err := conn.QueryRowContext(ctx, "SELECT [asymmetric_key_id] FROM sys.asymmetric_keys WHERE [name]=@p1", name).Scan(&id); err != nil {
+			utils.AddError(ctx, fmt.Sprintf("Failed to retrieve asymmetric key ID for name '%s'", name), err)
+			return nil
+		}
+
+		return GetAsymmetricKey(ctx, db, id)
+	})
+}
+
+func CreateAsymmetricKey(ctx context.Context, db Database, settings AsymmetricKeySettings) AsymmetricKey {
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) AsymmetricKey {
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("CREATE ASYMMETRIC KEY [%s] %s", settings.Name, settings.toSqlDefinition())); err != nil {
+			utils.AddError(ctx, "Failed to create asymmetric key", err)
+			return nil
+		}
+
+		return GetAsymmetricKeyByName(ctx, db, settings.Name)
+	})
+}
+
+var _ AsymmetricKey = asymmetricKey{}
+
+type asymmetricKey struct {
+	db Database
+	id AsymmetricKeyId
+}
+
+func (k asymmetricKey) GetId(context.Context) AsymmetricKeyId {
+	return k.id
+}
+
+func (k asymmetricKey) GetDb(context.Context) Database {
+	return k.db
+}
+
+func (k asymmetricKey) Exists(ctx context.Context) bool {
+	return WithConnection(ctx, k.db.connect, func(conn *sql.DB) bool {
+		switch _, err := k.getSettingsRaw(ctx, conn); err {
+		case sql.ErrNoRows:
+			return false
+		case nil:
+			return true
+		default:
+			utils.AddError(ctx, "Failed to check if asymmetric key exists", err)
+			return false
+		}
+	})
+}
+
+func (k asymmetricKey) GetSettings(ctx context.Context) AsymmetricKeySettings {
+	return WithConnection(ctx, k.db.connect, func(conn *sql.DB) AsymmetricKeySettings {
+		settings, err := k.getSettingsRaw(ctx, conn)
+		utils.AddError(ctx, "Failed to retrieve asymmetric key settings", err)
+		return settings
+	})
+}
+
+func (k asymmetricKey) Drop(ctx context.Context) {
+	WithConnection(ctx, k.db.connect, func(conn *sql.DB) any {
+		settings, err := k.getSettingsRaw(ctx, conn)
+		if err != nil {
+			utils.AddError(ctx, "Failed to retrieve asymmetric key settings", err)
+			return nil
+		}
+
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("DROP ASYMMETRIC KEY [%s]", settings.Name)); err != nil {
+			utils.AddError(ctx, "Failed to drop asymmetric key", err)
+		}
+
+		return nil
+	})
+}
+
+func (k asymmetricKey) getSettingsRaw(ctx context.Context, conn *sql.DB) (AsymmetricKeySettings, error) {
+	var settings AsymmetricKeySettings
+	err := conn.
+		QueryRowContext(ctx, "SELECT [name], [algorithm_desc], CONVERT(VARCHAR(MAX), [thumbprint], 1) FROM sys.asymmetric_keys WHERE [asymmetric_key_id]=@p1", k.id).
+		Scan(&settings.Name, &settings.Algorithm, &settings.Thumbprint)
+	return settings, err
+}
@@ -0,0 +1,94 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestAsymmetricKeyTestSuite(t *testing.T) {
+	s := &AsymmetricKeyTestSuite{}
+	suite.Run(t, s)
+}
+
+type AsymmetricKeyTestSuite struct {
+	SqlTestSuite
+	key asymmetricKey
+}
+
+func (s *AsymmetricKeyTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.key = asymmetricKey{db: &s.dbMock, id: AsymmetricKeyId(rand.Int())}
+}
+
+func (s *AsymmetricKeyTestSuite) TestCreateAsymmetricKey() {
+	cases := map[string]struct {
+		settings AsymmetricKeySettings
+		sql      string
+	}{
+		"generated": {
+			settings: AsymmetricKeySettings{Name: "test_key", Algorithm: "RSA_2048"},
+			sql:      "CREATE ASYMMETRIC KEY [test_key] WITH ALGORITHM = RSA_2048",
+		},
+		"password protected": {
+			settings: AsymmetricKeySettings{Name: "test_key", Algorithm: "RSA_4096", EncryptionPassword: "Str0ngPa$$word"},
+			sql:      "CREATE ASYMMETRIC KEY [test_key] WITH ALGORITHM = RSA_4096 ENCRYPTION BY PASSWORD = 'Str0ngPa$$word'",
+		},
+		"from file": {
+			settings: AsymmetricKeySettings{Name: "test_key", FilePath: "/var/opt/mssql/key.snk"},
+			sql:      "CREATE ASYMMETRIC KEY [test_key] FROM FILE = '/var/opt/mssql/key.snk'",
+		},
+	}
+
+	for name, t := range cases {
+		tc := t
+		s.Run(name, func() {
+			expectExactExec(s.mock, tc.sql).WillReturnResult(sqlmock.NewResult(0, 1))
+			expectExactQuery(s.mock, "SELECT [asymmetric_key_id] FROM sys.asymmetric_keys WHERE [name]=@p1").
+				WithArgs("test_key").
+				WillReturnRows(newRows("asymmetric_key_id").AddRow(256))
+
+			key := CreateAsymmetricKey(s.ctx, &s.dbMock, tc.settings)
+
+			s.Equal(AsymmetricKeyId(256), key.GetId(s.ctx))
+		})
+	}
+}
+
+func (s *AsymmetricKeyTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	s.True(s.key.Exists(s.ctx))
+}
+
+func (s *AsymmetricKeyTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.key.Exists(s.ctx))
+}
+
+func (s *AsymmetricKeyTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	settings := s.key.GetSettings(s.ctx)
+
+	s.Equal(AsymmetricKeySettings{Name: "test_key", Algorithm: "RSA_2048", Thumbprint: "0x0102"}, settings)
+}
+
+func (s *AsymmetricKeyTestSuite) TestDrop() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+	expectExactExec(s.mock, "DROP ASYMMETRIC KEY [test_key]").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.key.Drop(s.ctx)
+}
+
+func (s *AsymmetricKeyTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, "SELECT [name], [algorithm_desc], CONVERT(VARCHAR(MAX), [thumbprint], 1) FROM sys.asymmetric_keys WHERE [asymmetric_key_id]=@p1").
+		WithArgs(s.key.id)
+}
+
+func (s *AsymmetricKeyTestSuite) newSettingsRows() *sqlmock.Rows {
+	return newRows("name", "algorithm_desc", "thumbprint").AddRow("test_key", "RSA_2048", "0x0102")
+}
@@ -2,40 +2,108 @@ package sql
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
-	"github.com/microsoft/go-mssqldb/azuread"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	mssql "github.com/microsoft/go-mssqldb"
+	"github.com/microsoft/go-mssqldb/msdsn"
 	"net/url"
 )
 
+const (
+	AzureAuthMethodDefault           = "default"
+	AzureAuthMethodServicePrincipal  = "service_principal"
+	AzureAuthMethodClientCertificate = "client_certificate"
+	AzureAuthMethodManagedIdentity   = "managed_identity"
+	AzureAuthMethodWorkloadIdentity  = "workload_identity"
+	AzureAuthMethodAzureCli          = "azure_cli"
+	AzureAuthMethodAccessToken       = "access_token"
+)
+
+var AzureAuthMethods = []string{
+	AzureAuthMethodDefault,
+	AzureAuthMethodServicePrincipal,
+	AzureAuthMethodClientCertificate,
+	AzureAuthMethodManagedIdentity,
+	AzureAuthMethodWorkloadIdentity,
+	AzureAuthMethodAzureCli,
+	AzureAuthMethodAccessToken,
+}
+
 type ConnectionAuthAzure struct {
-	ClientId     string
-	ClientSecret string
-	TenantId     string
+	// Method is one of AzureAuthMethods. When empty, service principal is used if both ClientId and ClientSecret are set,
+	// otherwise default chained credentials.
+	Method                    string
+	ClientId                  string
+	ClientSecret              string
+	TenantId                  string
+	ClientCertificatePath     string
+	ClientCertificatePassword string
+	OidcTokenFilePath         string
+	AccessToken               string
 }
 
-func (auth ConnectionAuthAzure) configure(_ context.Context, u *url.URL) diag.Diagnostics {
-	q := u.Query()
+func (auth ConnectionAuthAzure) getMethod() string {
+	if auth.Method != "" {
+		return auth.Method
+	}
 
 	if auth.ClientId == "" || auth.ClientSecret == "" {
-		q.Set("fedauth", "ActiveDirectoryDefault")
-		u.RawQuery = q.Encode()
-		return nil
+		return AzureAuthMethodDefault
 	}
 
-	q.Set("fedauth", "ActiveDirectoryServicePrincipal")
-	q.Set("password", auth.ClientSecret)
+	return AzureAuthMethodServicePrincipal
+}
+
+// configure only validates the settings, as tokens are supplied to the driver by the connector.
+func (auth ConnectionAuthAzure) configure(context.Context, *url.URL) diag.Diagnostics {
+	diags := diag.Diagnostics{}
 
-	if auth.TenantId != "" {
-		q.Set("user id", fmt.Sprintf("%s@%s", auth.ClientId, auth.TenantId))
-	} else {
-		q.Set("user id", auth.ClientId)
+	requireAttr := func(value string, name string) {
+		if value == "" {
+			diags.AddError("Invalid Azure AD auth config", fmt.Sprintf("%s is required for %s authentication method", name, auth.getMethod()))
+		}
 	}
 
-	u.RawQuery = q.Encode()
-	return nil
+	switch auth.getMethod() {
+	case AzureAuthMethodDefault, AzureAuthMethodManagedIdentity, AzureAuthMethodAzureCli:
+	case AzureAuthMethodServicePrincipal:
+		requireAttr(auth.ClientId, "client_id")
+		requireAttr(auth.ClientSecret, "client_secret")
+	case AzureAuthMethodClientCertificate:
+		requireAttr(auth.ClientId, "client_id")
+		requireAttr(auth.ClientCertificatePath, "client_certificate_path")
+	case AzureAuthMethodWorkloadIdentity:
+		requireAttr(auth.ClientId, "client_id")
+		requireAttr(auth.TenantId, "tenant_id")
+		requireAttr(auth.OidcTokenFilePath, "oidc_token_file_path")
+	case AzureAuthMethodAccessToken:
+		requireAttr(auth.AccessToken, "access_token")
+	default:
+		diags.AddError("Invalid Azure AD auth config", fmt.Sprintf("Authentication method %q is not supported", auth.Method))
+	}
+
+	return diags
 }
 
 func (ConnectionAuthAzure) getDriverName() string {
-	return azuread.DriverName
+	return "sqlserver"
+}
+
+// getConnector returns connector using token provider shared by all connections with the same auth settings,
+// so tokens are acquired once and reused until they expire, instead of being acquired by every new connection.
+func (auth ConnectionAuthAzure) getConnector(_ context.Context, connStr string) (driver.Connector, error) {
+	config, err := msdsn.Parse(connStr)
+	if err != nil {
+		return nil, err
+	}
+
+	switch auth.getMethod() {
+	case AzureAuthMethodAccessToken:
+		return mssql.NewSecurityTokenConnector(config, StaticAccessToken(auth.AccessToken))
+	case AzureAuthMethodManagedIdentity:
+		return mssql.NewActiveDirectoryTokenConnector(config, mssql.FedAuthADALWorkflowMSI, getSharedAzureTokenProvider(auth).getToken)
+	default:
+		return mssql.NewActiveDirectoryTokenConnector(config, mssql.FedAuthADALWorkflowPassword, getSharedAzureTokenProvider(auth).getToken)
+	}
 }
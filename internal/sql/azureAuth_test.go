@@ -2,44 +2,128 @@ package sql
 
 import (
 	"context"
-	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"net/url"
 	"testing"
 )
 
-func TestConfigureDefault(t *testing.T) {
-	u := url.URL{}
-	auth := ConnectionAuthAzure{}
+func TestAzureAuthMethod(t *testing.T) {
+	cases := map[string]struct {
+		auth   ConnectionAuthAzure
+		method string
+	}{
+		"default": {
+			auth:   ConnectionAuthAzure{},
+			method: AzureAuthMethodDefault,
+		},
+		"client_id_only": {
+			auth:   ConnectionAuthAzure{ClientId: "test_client_id"},
+			method: AzureAuthMethodDefault,
+		},
+		"service_principal": {
+			auth:   ConnectionAuthAzure{ClientId: "test_client_id", ClientSecret: "test_client_secret"},
+			method: AzureAuthMethodServicePrincipal,
+		},
+		"explicit": {
+			auth:   ConnectionAuthAzure{Method: AzureAuthMethodAzureCli, ClientId: "test_client_id", ClientSecret: "test_client_secret"},
+			method: AzureAuthMethodAzureCli,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.method, tc.auth.getMethod())
+		})
+	}
+}
 
-	auth.configure(context.Background(), &u)
+func TestConfigureDoesNotModifyConnectionString(t *testing.T) {
+	u := url.URL{RawQuery: "database=test_db"}
+	auth := ConnectionAuthAzure{ClientId: "test_client_id", ClientSecret: "test_client_secret"}
 
-	assert.Equal(t, "ActiveDirectoryDefault", u.Query().Get("fedauth"))
+	diags := auth.configure(context.Background(), &u)
+
+	assert.False(t, diags.HasError(), "diagnostics")
+	assert.Equal(t, "database=test_db", u.RawQuery)
+	assert.Equal(t, "sqlserver", auth.getDriverName(), "driver name")
 }
 
-func TestConfigureServicePrincipal(t *testing.T) {
-	u := url.URL{}
-	auth := ConnectionAuthAzure{
-		ClientId:     "test_client_id",
-		ClientSecret: "test_client_secret",
+func TestConfigureMissingRequiredAttributes(t *testing.T) {
+	cases := map[string]ConnectionAuthAzure{
+		"service_principal":  {Method: AzureAuthMethodServicePrincipal, ClientId: "test_client_id"},
+		"client_certificate": {Method: AzureAuthMethodClientCertificate, ClientId: "test_client_id"},
+		"workload_identity":  {Method: AzureAuthMethodWorkloadIdentity, ClientId: "test_client_id", TenantId: "test_tenant_id"},
+		"access_token":       {Method: AzureAuthMethodAccessToken},
+		"unsupported":        {Method: "test_method"},
 	}
 
-	auth.configure(context.Background(), &u)
+	for name, auth := range cases {
+		auth := auth
+		t.Run(name, func(t *testing.T) {
+			diags := auth.configure(context.Background(), &url.URL{})
 
-	assert.Equal(t, "ActiveDirectoryServicePrincipal", u.Query().Get("fedauth"))
-	assert.Equal(t, auth.ClientId, u.Query().Get("user id"), "user id")
-	assert.Equal(t, auth.ClientSecret, u.Query().Get("password"), "password")
+			assert.True(t, diags.HasError(), "diagnostics")
+		})
+	}
 }
 
-func TestConfigureServicePrincipalWithTenant(t *testing.T) {
-	u := url.URL{}
-	auth := ConnectionAuthAzure{
-		ClientId:     "test_client_id",
-		ClientSecret: "test_client_secret",
-		TenantId:     "test_tenant_id",
+func TestAzureAuthNewCredential(t *testing.T) {
+	cases := map[string]struct {
+		auth     ConnectionAuthAzure
+		credType any
+	}{
+		"default": {
+			auth:     ConnectionAuthAzure{},
+			credType: &azidentity.DefaultAzureCredential{},
+		},
+		"service_principal": {
+			auth:     ConnectionAuthAzure{ClientId: "00000000-0000-0000-0000-000000000001", ClientSecret: "test_client_secret"},
+			credType: &azidentity.ClientSecretCredential{},
+		},
+		"managed_identity": {
+			auth:     ConnectionAuthAzure{Method: AzureAuthMethodManagedIdentity, ClientId: "test_client_id"},
+			credType: &azidentity.ManagedIdentityCredential{},
+		},
+		"azure_cli": {
+			auth:     ConnectionAuthAzure{Method: AzureAuthMethodAzureCli},
+			credType: &azidentity.AzureCLICredential{},
+		},
+		"workload_identity": {
+			auth:     ConnectionAuthAzure{Method: AzureAuthMethodWorkloadIdentity, ClientId: "test_client_id", TenantId: "test_tenant_id", OidcTokenFilePath: "/test/token"},
+			credType: workloadIdentityCredential{},
+		},
 	}
 
-	auth.configure(context.Background(), &u)
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			cred, err := tc.auth.newCredential("00000000-0000-0000-0000-000000000002")
+
+			require.NoError(t, err)
+			assert.IsType(t, tc.credType, cred)
+		})
+	}
+}
+
+func TestAzureAuthGetConnector(t *testing.T) {
+	for _, method := range AzureAuthMethods {
+		t.Run(method, func(t *testing.T) {
+			auth := ConnectionAuthAzure{Method: method, AccessToken: "test_token"}
+
+			connector, err := auth.getConnector(context.Background(), "sqlserver://test_host?database=test_db")
+
+			require.NoError(t, err)
+			assert.NotNil(t, connector)
+		})
+	}
+}
+
+func TestSharedAzureTokenProvider(t *testing.T) {
+	auth := ConnectionAuthAzure{Method: AzureAuthMethodAzureCli, TenantId: "test_shared_provider"}
 
-	assert.Equal(t, fmt.Sprintf("%s@%s", auth.ClientId, auth.TenantId), u.Query().Get("user id"))
+	assert.Same(t, getSharedAzureTokenProvider(auth), getSharedAzureTokenProvider(auth), "same settings")
+	assert.NotSame(t, getSharedAzureTokenProvider(auth), getSharedAzureTokenProvider(ConnectionAuthAzure{Method: AzureAuthMethodAzureCli}), "different settings")
 }
@@ -0,0 +1,158 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/AzureAD/microsoft-authentication-library-for-go/apps/confidential"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	azureAuthorityHostUrl = "https://login.microsoftonline.com/"
+	// Tokens are refreshed a bit before they expire, so they do not expire while the connection is being established
+	azureTokenRefreshMargin = 5 * time.Minute
+)
+
+var (
+	azureTokenProvidersMutex sync.Mutex
+	azureTokenProviders      = map[ConnectionAuthAzure]*azureTokenProvider{}
+)
+
+// getSharedAzureTokenProvider returns token provider shared by all connections using the same auth settings.
+func getSharedAzureTokenProvider(auth ConnectionAuthAzure) *azureTokenProvider {
+	azureTokenProvidersMutex.Lock()
+	defer azureTokenProvidersMutex.Unlock()
+
+	if p, ok := azureTokenProviders[auth]; ok {
+		return p
+	}
+
+	p := newAzureTokenProvider(auth.newCredential)
+	azureTokenProviders[auth] = p
+	return p
+}
+
+type azureTokenProvider struct {
+	newCredential func(tenantId string) (azcore.TokenCredential, error)
+
+	mutex       sync.Mutex
+	credentials map[string]azcore.TokenCredential
+	tokens      map[string]azcore.AccessToken
+}
+
+func newAzureTokenProvider(newCredential func(tenantId string) (azcore.TokenCredential, error)) *azureTokenProvider {
+	return &azureTokenProvider{
+		newCredential: newCredential,
+		credentials:   map[string]azcore.TokenCredential{},
+		tokens:        map[string]azcore.AccessToken{},
+	}
+}
+
+// getToken returns cached token for the server, acquiring new one when it is missing or about to expire.
+// Server SPN and STS URL are provided by the server during login.
+func (p *azureTokenProvider) getToken(ctx context.Context, serverSPN string, stsURL string) (string, error) {
+	tenantId := stsURL[strings.LastIndex(stsURL, "/")+1:]
+	scope := strings.TrimRight(serverSPN, "/") + "/.default"
+	cacheKey := fmt.Sprintf("%s|%s", tenantId, scope)
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if token, ok := p.tokens[cacheKey]; ok && time.Now().Add(azureTokenRefreshMargin).Before(token.ExpiresOn) {
+		return token.Token, nil
+	}
+
+	cred, ok := p.credentials[tenantId]
+	if !ok {
+		var err error
+		if cred, err = p.newCredential(tenantId); err != nil {
+			return "", err
+		}
+		p.credentials[tenantId] = cred
+	}
+
+	token, err := cred.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{scope}})
+	if err != nil {
+		return "", err
+	}
+
+	p.tokens[cacheKey] = token
+	return token.Token, nil
+}
+
+// newCredential creates credential for the auth method. serverTenantId, provided by the server, is used
+// by service principal methods when tenant is not set explicitly.
+func (auth ConnectionAuthAzure) newCredential(serverTenantId string) (azcore.TokenCredential, error) {
+	tenantId := auth.TenantId
+	if tenantId == "" {
+		tenantId = serverTenantId
+	}
+
+	switch auth.getMethod() {
+	case AzureAuthMethodServicePrincipal:
+		return azidentity.NewClientSecretCredential(tenantId, auth.ClientId, auth.ClientSecret, nil)
+
+	case AzureAuthMethodClientCertificate:
+		certData, err := os.ReadFile(auth.ClientCertificatePath)
+		if err != nil {
+			return nil, err
+		}
+
+		certs, key, err := azidentity.ParseCertificates(certData, []byte(auth.ClientCertificatePassword))
+		if err != nil {
+			return nil, err
+		}
+
+		return azidentity.NewClientCertificateCredential(tenantId, auth.ClientId, certs, key, nil)
+
+	case AzureAuthMethodManagedIdentity:
+		opts := &azidentity.ManagedIdentityCredentialOptions{}
+		if auth.ClientId != "" {
+			opts.ID = azidentity.ClientID(auth.ClientId)
+		}
+
+		return azidentity.NewManagedIdentityCredential(opts)
+
+	case AzureAuthMethodWorkloadIdentity:
+		return workloadIdentityCredential{clientId: auth.ClientId, tenantId: auth.TenantId, tokenFilePath: auth.OidcTokenFilePath}, nil
+
+	case AzureAuthMethodAzureCli:
+		return azidentity.NewAzureCLICredential(&azidentity.AzureCLICredentialOptions{TenantID: auth.TenantId})
+
+	default:
+		return azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{TenantID: auth.TenantId})
+	}
+}
+
+// workloadIdentityCredential exchanges federated OIDC token for Azure AD access token.
+type workloadIdentityCredential struct {
+	clientId      string
+	tenantId      string
+	tokenFilePath string
+}
+
+func (c workloadIdentityCredential) GetToken(ctx context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	// Federated token files are rotated, so the assertion must be read on each token request
+	assertion, err := os.ReadFile(c.tokenFilePath)
+	if err != nil {
+		return azcore.AccessToken{}, err
+	}
+
+	cred := confidential.NewCredFromAssertionCallback(func(context.Context, confidential.AssertionRequestOptions) (string, error) {
+		return strings.TrimSpace(string(assertion)), nil
+	})
+
+	client, err := confidential.New(azureAuthorityHostUrl+c.tenantId, c.clientId, cred)
+	if err != nil {
+		return azcore.AccessToken{}, err
+	}
+
+	res, err := client.AcquireTokenByCredential(ctx, opts.Scopes)
+	return azcore.AccessToken{Token: res.AccessToken, ExpiresOn: res.ExpiresOn}, err
+}
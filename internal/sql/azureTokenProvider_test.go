@@ -0,0 +1,78 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type fakeTokenCredential struct {
+	tenantId  string
+	expiresIn time.Duration
+	calls     *int
+	scopes    *[]string
+}
+
+func (c fakeTokenCredential) GetToken(_ context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	*c.calls++
+	*c.scopes = append(*c.scopes, opts.Scopes...)
+	return azcore.AccessToken{Token: fmt.Sprintf("%s_token_%d", c.tenantId, *c.calls), ExpiresOn: time.Now().Add(c.expiresIn)}, nil
+}
+
+func TestAzureTokenProvider(t *testing.T) {
+	const (
+		serverSPN = "https://database.windows.net/"
+		stsURL    = "https://login.microsoftonline.com/test_tenant"
+	)
+
+	newProvider := func(expiresIn time.Duration) (*azureTokenProvider, *int, *[]string, *[]string) {
+		calls, scopes, tenants := 0, []string{}, []string{}
+		return newAzureTokenProvider(func(tenantId string) (azcore.TokenCredential, error) {
+			tenants = append(tenants, tenantId)
+			return fakeTokenCredential{tenantId: tenantId, expiresIn: expiresIn, calls: &calls, scopes: &scopes}, nil
+		}), &calls, &scopes, &tenants
+	}
+
+	t.Run("reuses valid token", func(t *testing.T) {
+		p, calls, scopes, tenants := newProvider(time.Hour)
+
+		token1, err1 := p.getToken(context.Background(), serverSPN, stsURL)
+		token2, err2 := p.getToken(context.Background(), serverSPN, stsURL)
+
+		require.NoError(t, err1)
+		require.NoError(t, err2)
+		assert.Equal(t, "test_tenant_token_1", token1)
+		assert.Equal(t, token1, token2, "cached token")
+		assert.Equal(t, 1, *calls, "token requests")
+		assert.Equal(t, []string{"https://database.windows.net/.default"}, *scopes, "scopes")
+		assert.Equal(t, []string{"test_tenant"}, *tenants, "credential tenants")
+	})
+
+	t.Run("refreshes expiring token", func(t *testing.T) {
+		p, calls, _, tenants := newProvider(time.Minute)
+
+		p.getToken(context.Background(), serverSPN, stsURL)
+		token, err := p.getToken(context.Background(), serverSPN, stsURL)
+
+		require.NoError(t, err)
+		assert.Equal(t, "test_tenant_token_2", token)
+		assert.Equal(t, 2, *calls, "token requests")
+		assert.Len(t, *tenants, 1, "credential should be reused")
+	})
+
+	t.Run("credential error", func(t *testing.T) {
+		p := newAzureTokenProvider(func(string) (azcore.TokenCredential, error) {
+			return nil, errors.New("test_error")
+		})
+
+		_, err := p.getToken(context.Background(), serverSPN, stsURL)
+
+		assert.EqualError(t, err, "test_error")
+	})
+}
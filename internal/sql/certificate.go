@@ -0,0 +1,153 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"strings"
+)
+
+type CertificateSettings struct {
+	Name                         string
+	Subject                      string
+	ExpiryDate                   string
+	Thumbprint                   string
+	FilePath                     string
+	PrivateKeyFilePath           string
+	PrivateKeyDecryptionPassword string
+	EncryptionPassword           string
+}
+
+func (s CertificateSettings) toSqlDefinition() string {
+	var def strings.Builder
+
+	if s.FilePath != "" {
+		def.WriteString(fmt.Sprintf("FROM FILE = %s", quoteString(s.FilePath)))
+
+		if s.PrivateKeyFilePath != "" {
+			def.WriteString(fmt.Sprintf(" WITH PRIVATE KEY (FILE = %s", quoteString(s.PrivateKeyFilePath)))
+
+			if s.PrivateKeyDecryptionPassword != "" {
+				def.WriteString(fmt.Sprintf(", DECRYPTION BY PASSWORD = %s", quoteString(s.PrivateKeyDecryptionPassword)))
+			}
+
+			if s.EncryptionPassword != "" {
+				def.WriteString(fmt.Sprintf(", ENCRYPTION BY PASSWORD = %s", quoteString(s.EncryptionPassword)))
+			}
+
+			def.WriteString(")")
+		}
+
+		return def.String()
+	}
+
+	if s.EncryptionPassword != "" {
+		def.WriteString(fmt.Sprintf("ENCRYPTION BY PASSWORD = %s ", quoteString(s.EncryptionPassword)))
+	}
+
+	def.WriteString(fmt.Sprintf("WITH SUBJECT = %s", quoteString(s.Subject)))
+
+	if s.ExpiryDate != "" {
+		def.WriteString(fmt.Sprintf(", EXPIRY_DATE = %s", quoteString(s.ExpiryDate)))
+	}
+
+	return def.String()
+}
+
+type Certificate interface {
+	GetId(context.Context) CertificateId
+	GetDb(context.Context) Database
+	Exists(context.Context) bool
+	GetSettings(context.Context) CertificateSettings
+	Drop(context.Context)
+}
+
+func GetCertificate(_ context.Context, db Database, id CertificateId) Certificate {
+	return certificate{db: db, id: id}
+}
+
+func GetCertificateByName(ctx context.Context, db Database, name string) Certificate {
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) Certificate {
+		var id CertificateId
+
+		if err := conn.QueryRowContext(ctx, "SELECT [certificate_id] FROM sys.certificates WHERE [name]=@p1", name).Scan(&id); err != nil {
+			utils.AddError(ctx, fmt.Sprintf("Failed to retrieve certificate ID for name '%s'", name), err)
+			return nil
+		}
+
+		return GetCertificate(ctx, db, id)
+	})
+}
+
+func CreateCertificate(ctx context.Context, db Database, settings CertificateSettings) Certificate {
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) Certificate {
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("CREATE CERTIFICATE [%s] %s", settings.Name, settings.toSqlDefinition())); err != nil {
+			utils.AddError(ctx, "Failed to create certificate", err)
+			return nil
+		}
+
+		return GetCertificateByName(ctx, db, settings.Name)
+	})
+}
+
+var _ Certificate = certificate{}
+
+type certificate struct {
+	db Database
+	id CertificateId
+}
+
+func (c certificate) GetId(context.Context) CertificateId {
+	return c.id
+}
+
+func (c certificate) GetDb(context.Context) Database {
+	return c.db
+}
+
+func (c certificate) Exists(ctx context.Context) bool {
+	return WithConnection(ctx, c.db.connect, func(conn *sql.DB) bool {
+		switch _, err := c.getSettingsRaw(ctx, conn); err {
+		case sql.ErrNoRows:
+			return false
+		case nil:
+			return true
+		default:
+			utils.AddError(ctx, "Failed to check if certificate exists", err)
+			return false
+		}
+	})
+}
+
+func (c certificate) GetSettings(ctx context.Context) CertificateSettings {
+	return WithConnection(ctx, c.db.connect, func(conn *sql.DB) CertificateSettings {
+		settings, err := c.getSettingsRaw(ctx, conn)
+		utils.AddError(ctx, "Failed to retrieve certificate settings", err)
+		return settings
+	})
+}
+
+func (c certificate) Drop(ctx context.Context) {
+	WithConnection(ctx, c.db.connect, func(conn *sql.DB) any {
+		settings, err := c.getSettingsRaw(ctx, conn)
+		if err != nil {
+			utils.AddError(ctx, "Failed to retrieve certificate settings", err)
+			return nil
+		}
+
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("DROP CERTIFICATE [%s]", settings.Name)); err != nil {
+			utils.AddError(ctx, "Failed to drop certificate", err)
+		}
+
+		return nil
+	})
+}
+
+func (c certificate) getSettingsRaw(ctx context.Context, conn *sql.DB) (CertificateSettings, error) {
+	var settings CertificateSettings
+	err := conn.
+		QueryRowContext(ctx, "SELECT [name], [subject], CONVERT(VARCHAR(10), [expiry_date], 23), CONVERT(VARCHAR(MAX), [thumbprint], 1) FROM sys.certificates WHERE [certificate_id]=@p1", c.id).
+		Scan(&settings.Name, &settings.Subject, &settings.ExpiryDate, &settings.Thumbprint)
+	return settings, err
+}
@@ -0,0 +1,101 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestCertificateTestSuite(t *testing.T) {
+	s := &CertificateTestSuite{}
+	suite.Run(t, s)
+}
+
+type CertificateTestSuite struct {
+	SqlTestSuite
+	cert certificate
+}
+
+func (s *CertificateTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.cert = certificate{db: &s.dbMock, id: CertificateId(rand.Int())}
+}
+
+func (s *CertificateTestSuite) TestCreateGeneratedCertificate() {
+	expectExactExec(s.mock, "CREATE CERTIFICATE [test_cert] WITH SUBJECT = 'TDE certificate', EXPIRY_DATE = '2030-12-31'").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectCertificateIdQuery("test_cert", 256)
+
+	cert := CreateCertificate(s.ctx, &s.dbMock, CertificateSettings{Name: "test_cert", Subject: "TDE certificate", ExpiryDate: "2030-12-31"})
+
+	s.Equal(CertificateId(256), cert.GetId(s.ctx))
+}
+
+func (s *CertificateTestSuite) TestCreateCertificateFromFile() {
+	expectExactExec(s.mock, "CREATE CERTIFICATE [test_cert] FROM FILE = '/var/opt/mssql/cert.cer' WITH PRIVATE KEY (FILE = '/var/opt/mssql/cert.pvk', DECRYPTION BY PASSWORD = 'test_password')").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectCertificateIdQuery("test_cert", 257)
+
+	cert := CreateCertificate(s.ctx, &s.dbMock, CertificateSettings{
+		Name:                         "test_cert",
+		FilePath:                     "/var/opt/mssql/cert.cer",
+		PrivateKeyFilePath:           "/var/opt/mssql/cert.pvk",
+		PrivateKeyDecryptionPassword: "test_password",
+	})
+
+	s.Equal(CertificateId(257), cert.GetId(s.ctx))
+}
+
+func (s *CertificateTestSuite) TestCreatePasswordProtectedCertificate() {
+	expectExactExec(s.mock, "CREATE CERTIFICATE [test_cert] ENCRYPTION BY PASSWORD = 'Str0ngPa$$word' WITH SUBJECT = 'Signing certificate'").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectCertificateIdQuery("test_cert", 258)
+
+	cert := CreateCertificate(s.ctx, &s.dbMock, CertificateSettings{Name: "test_cert", Subject: "Signing certificate", EncryptionPassword: "Str0ngPa$$word"})
+
+	s.Equal(CertificateId(258), cert.GetId(s.ctx))
+}
+
+func (s *CertificateTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	s.True(s.cert.Exists(s.ctx))
+}
+
+func (s *CertificateTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.cert.Exists(s.ctx))
+}
+
+func (s *CertificateTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	settings := s.cert.GetSettings(s.ctx)
+
+	s.Equal(CertificateSettings{Name: "test_cert", Subject: "test_subject", ExpiryDate: "2030-12-31", Thumbprint: "0x0102"}, settings)
+}
+
+func (s *CertificateTestSuite) TestDrop() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+	expectExactExec(s.mock, "DROP CERTIFICATE [test_cert]").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.cert.Drop(s.ctx)
+}
+
+func (s *CertificateTestSuite) expectCertificateIdQuery(name string, id int) {
+	expectExactQuery(s.mock, "SELECT [certificate_id] FROM sys.certificates WHERE [name]=@p1").
+		WithArgs(name).
+		WillReturnRows(newRows("certificate_id").AddRow(id))
+}
+
+func (s *CertificateTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, "SELECT [name], [subject], CONVERT(VARCHAR(10), [expiry_date], 23), CONVERT(VARCHAR(MAX), [thumbprint], 1) FROM sys.certificates WHERE [certificate_id]=@p1").
+		WithArgs(s.cert.id)
+}
+
+func (s *CertificateTestSuite) newSettingsRows() *sqlmock.Rows {
+	return newRows("name", "subject", "expiry_date", "thumbprint").AddRow("test_cert", "test_subject", "2030-12-31", "0x0102")
+}
@@ -0,0 +1,231 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"regexp"
+	"strings"
+)
+
+var hexBinaryRegex = regexp.MustCompile("^0[xX][0-9a-fA-F]+$")
+
+type ColumnEncryptionKeyValue struct {
+	ColumnMasterKeyId ColumnMasterKeyId
+	Algorithm         string
+	EncryptedValue    string
+}
+
+type ColumnEncryptionKeySettings struct {
+	Name   string
+	Values []ColumnEncryptionKeyValue
+}
+
+type ColumnEncryptionKey interface {
+	GetId(context.Context) ColumnEncryptionKeyId
+	GetDb(context.Context) Database
+	Exists(context.Context) bool
+	GetSettings(context.Context) ColumnEncryptionKeySettings
+	UpdateSettings(context.Context, ColumnEncryptionKeySettings)
+	Drop(context.Context)
+}
+
+func GetColumnEncryptionKey(_ context.Context, db Database, id ColumnEncryptionKeyId) ColumnEncryptionKey {
+	return columnEncryptionKey{db: db, id: id}
+}
+
+func GetColumnEncryptionKeyByName(ctx context.Context, db Database, name string) ColumnEncryptionKey {
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) ColumnEncryptionKey {
+		var id ColumnEncryptionKeyId
+
+		if err := conn.QueryRowContext(ctx, "SELECT [column_encryption_key_id] FROM sys.column_encryption_keys WHERE [name]=@p1", name).Scan(&id); err != nil {
+			utils.AddError(ctx, fmt.Sprintf("Failed to retrieve column encryption key ID for name '%s'", name), err)
+			return nil
+		}
+
+		return GetColumnEncryptionKey(ctx, db, id)
+	})
+}
+
+func CreateColumnEncryptionKey(ctx context.Context, db Database, settings ColumnEncryptionKeySettings) ColumnEncryptionKey {
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) ColumnEncryptionKey {
+		var values []string
+
+		for _, val := range settings.Values {
+			values = append(values, val.toSqlDefinition(ctx, conn))
+		}
+
+		if utils.HasError(ctx) {
+			return nil
+		}
+
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("CREATE COLUMN ENCRYPTION KEY [%s] WITH VALUES %s", settings.Name, strings.Join(values, ", "))); err != nil {
+			utils.AddError(ctx, "Failed to create column encryption key", err)
+			return nil
+		}
+
+		return GetColumnEncryptionKeyByName(ctx, db, settings.Name)
+	})
+}
+
+func (v ColumnEncryptionKeyValue) toSqlDefinition(ctx context.Context, conn *sql.DB) string {
+	if !hexBinaryRegex.MatchString(v.EncryptedValue) {
+		utils.AddError(ctx, "Invalid encrypted value", errors.New("encrypted value must be a hex-encoded binary, starting with 0x"))
+		return ""
+	}
+
+	cmkName := lookupColumnMasterKeyName(ctx, conn, v.ColumnMasterKeyId)
+
+	return fmt.Sprintf("(COLUMN_MASTER_KEY = [%s], ALGORITHM = %s, ENCRYPTED_VALUE = %s)", cmkName, quoteString(v.Algorithm), v.EncryptedValue)
+}
+
+var _ ColumnEncryptionKey = columnEncryptionKey{}
+
+type columnEncryptionKey struct {
+	db Database
+	id ColumnEncryptionKeyId
+}
+
+func (k columnEncryptionKey) GetId(context.Context) ColumnEncryptionKeyId {
+	return k.id
+}
+
+func (k columnEncryptionKey) GetDb(context.Context) Database {
+	return k.db
+}
+
+func (k columnEncryptionKey) Exists(ctx context.Context) bool {
+	return WithConnection(ctx, k.db.connect, func(conn *sql.DB) bool {
+		switch _, err := k.getName(ctx, conn); err {
+		case sql.ErrNoRows:
+			return false
+		case nil:
+			return true
+		default:
+			utils.AddError(ctx, "Failed to check if column encryption key exists", err)
+			return false
+		}
+	})
+}
+
+func (k columnEncryptionKey) GetSettings(ctx context.Context) ColumnEncryptionKeySettings {
+	return WithConnection(ctx, k.db.connect, func(conn *sql.DB) ColumnEncryptionKeySettings {
+		var (
+			settings ColumnEncryptionKeySettings
+			err      error
+		)
+
+		if settings.Name, err = k.getName(ctx, conn); err != nil {
+			utils.AddError(ctx, "Failed to retrieve column encryption key settings", err)
+			return settings
+		}
+
+		settings.Values = k.getValues(ctx, conn)
+		return settings
+	})
+}
+
+// UpdateSettings adds values encrypted with new column master keys before dropping the ones which are no longer needed,
+// so the key always has at least one value.
+func (k columnEncryptionKey) UpdateSettings(ctx context.Context, settings ColumnEncryptionKeySettings) {
+	WithConnection(ctx, k.db.connect, func(conn *sql.DB) any {
+		name, err := k.getName(ctx, conn)
+		if err != nil {
+			utils.AddError(ctx, "Failed to retrieve column encryption key settings", err)
+			return nil
+		}
+
+		current := map[ColumnMasterKeyId]ColumnEncryptionKeyValue{}
+		for _, val := range k.getValues(ctx, conn) {
+			current[val.ColumnMasterKeyId] = val
+		}
+
+		requested := map[ColumnMasterKeyId]bool{}
+		for _, val := range settings.Values {
+			requested[val.ColumnMasterKeyId] = true
+		}
+
+		exec := func(stat string) {
+			if utils.HasError(ctx) {
+				return
+			}
+
+			if _, err := conn.ExecContext(ctx, fmt.Sprintf("ALTER COLUMN ENCRYPTION KEY [%s] %s", name, stat)); err != nil {
+				utils.AddError(ctx, "Failed to update column encryption key", err)
+			}
+		}
+
+		dropValue := func(id ColumnMasterKeyId) {
+			exec(fmt.Sprintf("DROP VALUE (COLUMN_MASTER_KEY = [%s])", lookupColumnMasterKeyName(ctx, conn, id)))
+		}
+
+		for _, val := range settings.Values {
+			cur, exists := current[val.ColumnMasterKeyId]
+
+			if exists && cur.Algorithm == val.Algorithm && strings.EqualFold(cur.EncryptedValue, val.EncryptedValue) {
+				continue
+			}
+
+			if exists {
+				dropValue(val.ColumnMasterKeyId)
+			}
+
+			exec(fmt.Sprintf("ADD VALUE %s", val.toSqlDefinition(ctx, conn)))
+		}
+
+		for id := range current {
+			if !requested[id] {
+				dropValue(id)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (k columnEncryptionKey) Drop(ctx context.Context) {
+	WithConnection(ctx, k.db.connect, func(conn *sql.DB) any {
+		name, err := k.getName(ctx, conn)
+		if err != nil {
+			utils.AddError(ctx, "Failed to retrieve column encryption key settings", err)
+			return nil
+		}
+
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("DROP COLUMN ENCRYPTION KEY [%s]", name)); err != nil {
+			utils.AddError(ctx, "Failed to drop column encryption key", err)
+		}
+
+		return nil
+	})
+}
+
+func (k columnEncryptionKey) getName(ctx context.Context, conn *sql.DB) (string, error) {
+	var name string
+	err := conn.QueryRowContext(ctx, "SELECT [name] FROM sys.column_encryption_keys WHERE [column_encryption_key_id]=@p1", k.id).Scan(&name)
+	return name, err
+}
+
+func (k columnEncryptionKey) getValues(ctx context.Context, conn *sql.DB) []ColumnEncryptionKeyValue {
+	const errorSummary = "Failed to retrieve column encryption key values"
+	var values []ColumnEncryptionKeyValue
+
+	rows, err := conn.QueryContext(ctx, "SELECT [column_master_key_id], [encryption_algorithm_name], CONVERT(VARCHAR(MAX), [encrypted_value], 1) FROM sys.column_encryption_key_values WHERE [column_encryption_key_id]=@p1", k.id)
+	if err != nil {
+		utils.AddError(ctx, errorSummary, err)
+		return values
+	}
+
+	for rows.Next() {
+		var val ColumnEncryptionKeyValue
+		if err := rows.Scan(&val.ColumnMasterKeyId, &val.Algorithm, &val.EncryptedValue); err != nil {
+			utils.AddError(ctx, errorSummary, err)
+			return values
+		}
+		values = append(values, val)
+	}
+
+	utils.AddError(ctx, errorSummary, rows.Err())
+	return values
+}
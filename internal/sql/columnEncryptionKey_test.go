@@ -0,0 +1,131 @@
+package sql
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestColumnEncryptionKeyTestSuite(t *testing.T) {
+	s := &ColumnEncryptionKeyTestSuite{}
+	suite.Run(t, s)
+}
+
+type ColumnEncryptionKeyTestSuite struct {
+	SqlTestSuite
+	key columnEncryptionKey
+}
+
+func (s *ColumnEncryptionKeyTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.key = columnEncryptionKey{db: &s.dbMock, id: ColumnEncryptionKeyId(rand.Int())}
+}
+
+func (s *ColumnEncryptionKeyTestSuite) TestCreateColumnEncryptionKey() {
+	s.expectCmkNameQuery(3, "test_cmk")
+	s.expectCmkNameQuery(4, "new_cmk")
+	expectExactExec(s.mock, "CREATE COLUMN ENCRYPTION KEY [test_cek] WITH VALUES (COLUMN_MASTER_KEY = [test_cmk], ALGORITHM = 'RSA_OAEP', ENCRYPTED_VALUE = 0x0102), (COLUMN_MASTER_KEY = [new_cmk], ALGORITHM = 'RSA_OAEP', ENCRYPTED_VALUE = 0x0304)").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactQuery(s.mock, "SELECT [column_encryption_key_id] FROM sys.column_encryption_keys WHERE [name]=@p1").
+		WithArgs("test_cek").
+		WillReturnRows(newRows("column_encryption_key_id").AddRow(5))
+
+	key := CreateColumnEncryptionKey(s.ctx, &s.dbMock, ColumnEncryptionKeySettings{
+		Name: "test_cek",
+		Values: []ColumnEncryptionKeyValue{
+			{ColumnMasterKeyId: 3, Algorithm: "RSA_OAEP", EncryptedValue: "0x0102"},
+			{ColumnMasterKeyId: 4, Algorithm: "RSA_OAEP", EncryptedValue: "0x0304"},
+		},
+	})
+
+	s.Equal(ColumnEncryptionKeyId(5), key.GetId(s.ctx))
+}
+
+func (s *ColumnEncryptionKeyTestSuite) TestCreateWithInvalidEncryptedValue() {
+	CreateColumnEncryptionKey(s.ctx, &s.dbMock, ColumnEncryptionKeySettings{
+		Name:   "test_cek",
+		Values: []ColumnEncryptionKeyValue{{ColumnMasterKeyId: 3, Algorithm: "RSA_OAEP", EncryptedValue: "0x01; DROP TABLE x"}},
+	})
+
+	s.verifyError(errors.New("encrypted value must be a hex-encoded binary, starting with 0x"))
+}
+
+func (s *ColumnEncryptionKeyTestSuite) TestExists() {
+	s.expectNameQuery().WillReturnRows(newRows("name").AddRow("test_cek"))
+
+	s.True(s.key.Exists(s.ctx))
+}
+
+func (s *ColumnEncryptionKeyTestSuite) TestNotExists() {
+	s.expectNameQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.key.Exists(s.ctx))
+}
+
+func (s *ColumnEncryptionKeyTestSuite) TestGetSettings() {
+	s.expectNameQuery().WillReturnRows(newRows("name").AddRow("test_cek"))
+	s.expectValuesQuery().WillReturnRows(newRows("column_master_key_id", "encryption_algorithm_name", "encrypted_value").
+		AddRow(3, "RSA_OAEP", "0x0102").
+		AddRow(4, "RSA_OAEP", "0x0304"))
+
+	settings := s.key.GetSettings(s.ctx)
+
+	s.Equal(ColumnEncryptionKeySettings{
+		Name: "test_cek",
+		Values: []ColumnEncryptionKeyValue{
+			{ColumnMasterKeyId: 3, Algorithm: "RSA_OAEP", EncryptedValue: "0x0102"},
+			{ColumnMasterKeyId: 4, Algorithm: "RSA_OAEP", EncryptedValue: "0x0304"},
+		},
+	}, settings)
+}
+
+func (s *ColumnEncryptionKeyTestSuite) TestUpdateSettingsRotatesMasterKey() {
+	s.expectNameQuery().WillReturnRows(newRows("name").AddRow("test_cek"))
+	s.expectValuesQuery().WillReturnRows(newRows("column_master_key_id", "encryption_algorithm_name", "encrypted_value").AddRow(3, "RSA_OAEP", "0x0102"))
+	s.expectCmkNameQuery(4, "new_cmk")
+	expectExactExec(s.mock, "ALTER COLUMN ENCRYPTION KEY [test_cek] ADD VALUE (COLUMN_MASTER_KEY = [new_cmk], ALGORITHM = 'RSA_OAEP', ENCRYPTED_VALUE = 0x0304)").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectCmkNameQuery(3, "test_cmk")
+	expectExactExec(s.mock, "ALTER COLUMN ENCRYPTION KEY [test_cek] DROP VALUE (COLUMN_MASTER_KEY = [test_cmk])").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.key.UpdateSettings(s.ctx, ColumnEncryptionKeySettings{
+		Name:   "test_cek",
+		Values: []ColumnEncryptionKeyValue{{ColumnMasterKeyId: 4, Algorithm: "RSA_OAEP", EncryptedValue: "0x0304"}},
+	})
+}
+
+func (s *ColumnEncryptionKeyTestSuite) TestUpdateSettingsIgnoresHexCase() {
+	s.expectNameQuery().WillReturnRows(newRows("name").AddRow("test_cek"))
+	s.expectValuesQuery().WillReturnRows(newRows("column_master_key_id", "encryption_algorithm_name", "encrypted_value").AddRow(3, "RSA_OAEP", "0x0A0B"))
+
+	s.key.UpdateSettings(s.ctx, ColumnEncryptionKeySettings{
+		Name:   "test_cek",
+		Values: []ColumnEncryptionKeyValue{{ColumnMasterKeyId: 3, Algorithm: "RSA_OAEP", EncryptedValue: "0x0a0b"}},
+	})
+}
+
+func (s *ColumnEncryptionKeyTestSuite) TestDrop() {
+	s.expectNameQuery().WillReturnRows(newRows("name").AddRow("test_cek"))
+	expectExactExec(s.mock, "DROP COLUMN ENCRYPTION KEY [test_cek]").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.key.Drop(s.ctx)
+}
+
+func (s *ColumnEncryptionKeyTestSuite) expectCmkNameQuery(id int, name string) {
+	expectExactQuery(s.mock, "SELECT [name] FROM sys.column_master_keys WHERE [column_master_key_id]=@p1").
+		WithArgs(id).
+		WillReturnRows(newRows("name").AddRow(name))
+}
+
+func (s *ColumnEncryptionKeyTestSuite) expectNameQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, "SELECT [name] FROM sys.column_encryption_keys WHERE [column_encryption_key_id]=@p1").WithArgs(s.key.id)
+}
+
+func (s *ColumnEncryptionKeyTestSuite) expectValuesQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, "SELECT [column_master_key_id], [encryption_algorithm_name], CONVERT(VARCHAR(MAX), [encrypted_value], 1) FROM sys.column_encryption_key_values WHERE [column_encryption_key_id]=@p1").
+		WithArgs(s.key.id)
+}
@@ -0,0 +1,111 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+)
+
+type ColumnMaskSettings struct {
+	Function string
+}
+
+type ColumnMask interface {
+	GetTable(context.Context) Table
+	GetColumnId(context.Context) ColumnId
+	Exists(context.Context) bool
+	GetSettings(context.Context) ColumnMaskSettings
+	UpdateSettings(context.Context, ColumnMaskSettings)
+	Drop(context.Context)
+}
+
+func GetColumnMask(_ context.Context, table Table, columnId ColumnId) ColumnMask {
+	return columnMask{table: table, columnId: columnId}
+}
+
+func CreateColumnMask(ctx context.Context, table Table, columnName string, settings ColumnMaskSettings) ColumnMask {
+	var mask columnMask
+
+	utils.StopOnError(ctx).
+		Then(func() { mask = columnMask{table: table, columnId: table.GetColumnId(ctx, columnName)} }).
+		Then(func() {
+			mask.alterColumn(ctx, fmt.Sprintf("ADD MASKED WITH (FUNCTION = %s)", quoteString(settings.Function)), "Failed to mask column")
+		})
+
+	if utils.HasError(ctx) {
+		return nil
+	}
+
+	return mask
+}
+
+var _ ColumnMask = columnMask{}
+
+type columnMask struct {
+	table    Table
+	columnId ColumnId
+}
+
+func (m columnMask) GetTable(context.Context) Table {
+	return m.table
+}
+
+func (m columnMask) GetColumnId(context.Context) ColumnId {
+	return m.columnId
+}
+
+func (m columnMask) Exists(ctx context.Context) bool {
+	return WithConnection(ctx, m.table.GetDb(ctx).connect, func(conn *sql.DB) bool {
+		switch _, err := m.getSettingsRaw(ctx, conn); err {
+		case sql.ErrNoRows:
+			return false
+		case nil:
+			return true
+		default:
+			utils.AddError(ctx, "Failed to check if column mask exists", err)
+			return false
+		}
+	})
+}
+
+func (m columnMask) GetSettings(ctx context.Context) ColumnMaskSettings {
+	return WithConnection(ctx, m.table.GetDb(ctx).connect, func(conn *sql.DB) ColumnMaskSettings {
+		settings, err := m.getSettingsRaw(ctx, conn)
+		utils.AddError(ctx, "Failed to retrieve column mask settings", err)
+		return settings
+	})
+}
+
+// UpdateSettings replaces the masking function, as adding mask to already masked column overrides the existing one.
+func (m columnMask) UpdateSettings(ctx context.Context, settings ColumnMaskSettings) {
+	m.alterColumn(ctx, fmt.Sprintf("ADD MASKED WITH (FUNCTION = %s)", quoteString(settings.Function)), "Failed to update column mask")
+}
+
+func (m columnMask) Drop(ctx context.Context) {
+	m.alterColumn(ctx, "DROP MASKED", "Failed to drop column mask")
+}
+
+func (m columnMask) alterColumn(ctx context.Context, action string, errorSummary string) {
+	var tableName, columnName string
+
+	utils.StopOnError(ctx).
+		Then(func() { tableName = m.table.GetName(ctx) }).
+		Then(func() { columnName = m.table.GetColumnName(ctx, m.columnId) }).
+		Then(func() {
+			WithConnection(ctx, m.table.GetDb(ctx).connect, func(conn *sql.DB) any {
+				stat := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s %s", quoteSchemaQualifiedName(tableName), quoteIdentifiers([]string{columnName})[0], action)
+				_, err := conn.ExecContext(ctx, stat)
+				utils.AddError(ctx, errorSummary, err)
+				return nil
+			})
+		})
+}
+
+func (m columnMask) getSettingsRaw(ctx context.Context, conn *sql.DB) (ColumnMaskSettings, error) {
+	var settings ColumnMaskSettings
+	err := conn.
+		QueryRowContext(ctx, "SELECT [masking_function] FROM sys.masked_columns WHERE [object_id]=@p1 AND [column_id]=@p2 AND [is_masked]=1", m.table.GetId(ctx), m.columnId).
+		Scan(&settings.Function)
+	return settings, err
+}
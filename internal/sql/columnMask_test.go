@@ -0,0 +1,84 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestColumnMaskTestSuite(t *testing.T) {
+	s := &ColumnMaskTestSuite{}
+	suite.Run(t, s)
+}
+
+type ColumnMaskTestSuite struct {
+	SqlTestSuite
+	mask columnMask
+}
+
+func (s *ColumnMaskTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.mask = columnMask{table: table{db: &s.dbMock, id: 2314}, columnId: 4}
+}
+
+func (s *ColumnMaskTestSuite) TestCreateColumnMask() {
+	expectExactQuery(s.mock, "SELECT COLUMNPROPERTY(@p1, @p2, 'ColumnId')").
+		WithArgs(2314, "Phone").
+		WillReturnRows(newRows("id").AddRow(4))
+	s.expectColumnLookup()
+	expectExactExec(s.mock, `ALTER TABLE [sales].[Customers] ALTER COLUMN [Phone] ADD MASKED WITH (FUNCTION = 'partial(0,"XXX-XXX-",4)')`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	mask := CreateColumnMask(s.ctx, s.mask.table, "Phone", ColumnMaskSettings{Function: `partial(0,"XXX-XXX-",4)`})
+
+	s.Equal(ColumnId(4), mask.GetColumnId(s.ctx))
+}
+
+func (s *ColumnMaskTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(newRows("masking_function").AddRow("default()"))
+
+	s.True(s.mask.Exists(s.ctx))
+}
+
+func (s *ColumnMaskTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.mask.Exists(s.ctx))
+}
+
+func (s *ColumnMaskTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(newRows("masking_function").AddRow("email()"))
+
+	s.Equal(ColumnMaskSettings{Function: "email()"}, s.mask.GetSettings(s.ctx))
+}
+
+func (s *ColumnMaskTestSuite) TestUpdateSettings() {
+	s.expectColumnLookup()
+	expectExactExec(s.mock, "ALTER TABLE [sales].[Customers] ALTER COLUMN [Phone] ADD MASKED WITH (FUNCTION = 'default()')").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.mask.UpdateSettings(s.ctx, ColumnMaskSettings{Function: "default()"})
+}
+
+func (s *ColumnMaskTestSuite) TestDrop() {
+	s.expectColumnLookup()
+	expectExactExec(s.mock, "ALTER TABLE [sales].[Customers] ALTER COLUMN [Phone] DROP MASKED").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.mask.Drop(s.ctx)
+}
+
+func (s *ColumnMaskTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, "SELECT [masking_function] FROM sys.masked_columns WHERE [object_id]=@p1 AND [column_id]=@p2 AND [is_masked]=1").
+		WithArgs(2314, 4)
+}
+
+func (s *ColumnMaskTestSuite) expectColumnLookup() {
+	expectExactQuery(s.mock, "SELECT OBJECT_SCHEMA_NAME(@p1) + '.' + OBJECT_NAME(@p1)").
+		WithArgs(2314).
+		WillReturnRows(newRows("name").AddRow("sales.Customers"))
+	expectExactQuery(s.mock, "SELECT COL_NAME(@p1, @p2)").
+		WithArgs(2314, 4).
+		WillReturnRows(newRows("name").AddRow("Phone"))
+}
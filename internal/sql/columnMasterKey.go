@@ -0,0 +1,124 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+)
+
+type ColumnMasterKeySettings struct {
+	Name                 string
+	KeyStoreProviderName string
+	KeyPath              string
+}
+
+type ColumnMasterKey interface {
+	GetId(context.Context) ColumnMasterKeyId
+	GetDb(context.Context) Database
+	Exists(context.Context) bool
+	GetSettings(context.Context) ColumnMasterKeySettings
+	Drop(context.Context)
+}
+
+func GetColumnMasterKey(_ context.Context, db Database, id ColumnMasterKeyId) ColumnMasterKey {
+	return columnMasterKey{db: db, id: id}
+}
+
+func GetColumnMasterKeyByName(ctx context.Context, db Database, name string) ColumnMasterKey {
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) ColumnMasterKey {
+		var id ColumnMasterKeyId
+
+		if err := conn.QueryRowContext(ctx, "SELECT [column_master_key_id] FROM sys.column_master_keys WHERE [name]=@p1", name).Scan(&id); err != nil {
+			utils.AddError(ctx, fmt.Sprintf("Failed to retrieve column master key ID for name '%s'", name), err)
+			return nil
+		}
+
+		return GetColumnMasterKey(ctx, db, id)
+	})
+}
+
+func CreateColumnMasterKey(ctx context.Context, db Database, settings ColumnMasterKeySettings) ColumnMasterKey {
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) ColumnMasterKey {
+		stat := fmt.Sprintf("CREATE COLUMN MASTER KEY [%s] WITH (KEY_STORE_PROVIDER_NAME = %s, KEY_PATH = %s)",
+			settings.Name, quoteString(settings.KeyStoreProviderName), quoteString(settings.KeyPath))
+
+		if _, err := conn.ExecContext(ctx, stat); err != nil {
+			utils.AddError(ctx, "Failed to create column master key", err)
+			return nil
+		}
+
+		return GetColumnMasterKeyByName(ctx, db, settings.Name)
+	})
+}
+
+var _ ColumnMasterKey = columnMasterKey{}
+
+type columnMasterKey struct {
+	db Database
+	id ColumnMasterKeyId
+}
+
+func (k columnMasterKey) GetId(context.Context) ColumnMasterKeyId {
+	return k.id
+}
+
+func (k columnMasterKey) GetDb(context.Context) Database {
+	return k.db
+}
+
+func (k columnMasterKey) Exists(ctx context.Context) bool {
+	return WithConnection(ctx, k.db.connect, func(conn *sql.DB) bool {
+		switch _, err := k.getSettingsRaw(ctx, conn); err {
+		case sql.ErrNoRows:
+			return false
+		case nil:
+			return true
+		default:
+			utils.AddError(ctx, "Failed to check if column master key exists", err)
+			return false
+		}
+	})
+}
+
+func (k columnMasterKey) GetSettings(ctx context.Context) ColumnMasterKeySettings {
+	return WithConnection(ctx, k.db.connect, func(conn *sql.DB) ColumnMasterKeySettings {
+		settings, err := k.getSettingsRaw(ctx, conn)
+		utils.AddError(ctx, "Failed to retrieve column master key settings", err)
+		return settings
+	})
+}
+
+func (k columnMasterKey) Drop(ctx context.Context) {
+	WithConnection(ctx, k.db.connect, func(conn *sql.DB) any {
+		settings, err := k.getSettingsRaw(ctx, conn)
+		if err != nil {
+			utils.AddError(ctx, "Failed to retrieve column master key settings", err)
+			return nil
+		}
+
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("DROP COLUMN MASTER KEY [%s]", settings.Name)); err != nil {
+			utils.AddError(ctx, "Failed to drop column master key", err)
+		}
+
+		return nil
+	})
+}
+
+func (k columnMasterKey) getSettingsRaw(ctx context.Context, conn *sql.DB) (ColumnMasterKeySettings, error) {
+	var settings ColumnMasterKeySettings
+	err := conn.
+		QueryRowContext(ctx, "SELECT [name], [key_store_provider_name], [key_path] FROM sys.column_master_keys WHERE [column_master_key_id]=@p1", k.id).
+		Scan(&settings.Name, &settings.KeyStoreProviderName, &settings.KeyPath)
+	return settings, err
+}
+
+func lookupColumnMasterKeyName(ctx context.Context, conn *sql.DB, id ColumnMasterKeyId) string {
+	var name string
+
+	if err := conn.QueryRowContext(ctx, "SELECT [name] FROM sys.column_master_keys WHERE [column_master_key_id]=@p1", id).Scan(&name); err != nil {
+		utils.AddError(ctx, fmt.Sprintf("Failed to retrieve name of column master key with ID %d", id), err)
+	}
+
+	return name
+}
@@ -0,0 +1,76 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestColumnMasterKeyTestSuite(t *testing.T) {
+	s := &ColumnMasterKeyTestSuite{}
+	suite.Run(t, s)
+}
+
+type ColumnMasterKeyTestSuite struct {
+	SqlTestSuite
+	key columnMasterKey
+}
+
+func (s *ColumnMasterKeyTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.key = columnMasterKey{db: &s.dbMock, id: ColumnMasterKeyId(rand.Int())}
+}
+
+func (s *ColumnMasterKeyTestSuite) TestCreateColumnMasterKey() {
+	expectExactExec(s.mock, "CREATE COLUMN MASTER KEY [test_cmk] WITH (KEY_STORE_PROVIDER_NAME = 'AZURE_KEY_VAULT', KEY_PATH = 'https://vault.vault.azure.net/keys/cmk/1')").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactQuery(s.mock, "SELECT [column_master_key_id] FROM sys.column_master_keys WHERE [name]=@p1").
+		WithArgs("test_cmk").
+		WillReturnRows(newRows("column_master_key_id").AddRow(3))
+
+	key := CreateColumnMasterKey(s.ctx, &s.dbMock, ColumnMasterKeySettings{
+		Name:                 "test_cmk",
+		KeyStoreProviderName: "AZURE_KEY_VAULT",
+		KeyPath:              "https://vault.vault.azure.net/keys/cmk/1",
+	})
+
+	s.Equal(ColumnMasterKeyId(3), key.GetId(s.ctx))
+}
+
+func (s *ColumnMasterKeyTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	s.True(s.key.Exists(s.ctx))
+}
+
+func (s *ColumnMasterKeyTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.key.Exists(s.ctx))
+}
+
+func (s *ColumnMasterKeyTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	settings := s.key.GetSettings(s.ctx)
+
+	s.Equal(ColumnMasterKeySettings{Name: "test_cmk", KeyStoreProviderName: "MSSQL_CERTIFICATE_STORE", KeyPath: "CurrentUser/My/ABC"}, settings)
+}
+
+func (s *ColumnMasterKeyTestSuite) TestDrop() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+	expectExactExec(s.mock, "DROP COLUMN MASTER KEY [test_cmk]").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.key.Drop(s.ctx)
+}
+
+func (s *ColumnMasterKeyTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, "SELECT [name], [key_store_provider_name], [key_path] FROM sys.column_master_keys WHERE [column_master_key_id]=@p1").
+		WithArgs(s.key.id)
+}
+
+func (s *ColumnMasterKeyTestSuite) newSettingsRows() *sqlmock.Rows {
+	return newRows("name", "key_store_provider_name", "key_path").AddRow("test_cmk", "MSSQL_CERTIFICATE_STORE", "CurrentUser/My/ABC")
+}
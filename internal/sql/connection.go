@@ -3,16 +3,18 @@ package sql
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
 	"github.com/kofalt/go-memoize"
+	"math"
 	"net/url"
 	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	_ "github.com/microsoft/go-mssqldb"
-	_ "github.com/microsoft/go-mssqldb/azuread"
 )
 
 var azureSQLEditionPattern = regexp.MustCompile("^SQL Azure.*")
@@ -29,10 +31,78 @@ type ConnectionAuth interface {
 	getDriverName() string
 }
 
+// connectorAuth is implemented by ConnectionAuth which needs a custom driver connector, e.g. to supply access tokens.
+// When getConnector returns nil connector, the connection is opened using driver name.
+type connectorAuth interface {
+	getConnector(ctx context.Context, connStr string) (driver.Connector, error)
+}
+
+const (
+	ApplicationIntentReadWrite = "ReadWrite"
+	ApplicationIntentReadOnly  = "ReadOnly"
+)
+
+var ApplicationIntents = []string{ApplicationIntentReadWrite, ApplicationIntentReadOnly}
+
 type ConnectionDetails struct {
 	Host     string
 	Database string
-	Auth     ConnectionAuth
+	// Instance is the name of SQL Server named instance. When port is not part of Host, it is resolved using SQL Browser.
+	Instance            string
+	FailoverPartner     string
+	MultiSubnetFailover bool
+	ApplicationIntent   string
+	Auth                ConnectionAuth
+	// When nil, driver defaults are used
+	Encryption *ConnectionEncryption
+	// When nil, DefaultRetryPolicy is used
+	Retry *RetryPolicy
+	// DialTimeout and ConnectTimeout limit time of opening TCP connection and of the whole login. Zero values keep driver defaults.
+	DialTimeout    time.Duration
+	ConnectTimeout time.Duration
+	// CommandTimeout limits each attempt of SQL call. Zero means no limit.
+	CommandTimeout time.Duration
+	Pool           ConnectionPool
+	// WaitForReady is the time to wait for each database to become available before it is used. Zero disables waiting.
+	WaitForReady time.Duration
+}
+
+// ConnectionPool settings are applied to the server connection and to each per-database connection. Zero values keep database/sql defaults.
+type ConnectionPool struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+	// DatabaseConnectionTTL is the time per-database connections are cached for. Defaults to 2 hours.
+	DatabaseConnectionTTL time.Duration
+}
+
+const defaultDatabaseConnectionTTL = 2 * time.Hour
+
+func (p ConnectionPool) apply(db *sql.DB) {
+	if p.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(p.MaxOpenConns)
+	}
+
+	if p.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(p.MaxIdleConns)
+	}
+
+	if p.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(p.ConnMaxLifetime)
+	}
+
+	if p.ConnMaxIdleTime > 0 {
+		db.SetConnMaxIdleTime(p.ConnMaxIdleTime)
+	}
+}
+
+func (p ConnectionPool) getDatabaseConnectionTTL() time.Duration {
+	if p.DatabaseConnectionTTL <= 0 {
+		return defaultDatabaseConnectionTTL
+	}
+
+	return p.DatabaseConnectionTTL
 }
 
 type Connection interface {
@@ -52,22 +122,30 @@ type connection struct {
 	connDetails ConnectionDetails
 	conn        *sql.DB
 	dbConnCache *memoize.Memoizer
+	ready       *readyDatabases
 }
 
 func (cd ConnectionDetails) Open(ctx context.Context) (Connection, diag.Diagnostics) {
 	cs, diags := cd.getConnectionString(ctx)
-	db, err := sql.Open(cd.Auth.getDriverName(), cs)
+	db, err := openDB(ctx, cd, cs)
 
 	if err != nil {
 		diags.AddError("Could not connect to SQL endpoint", err.Error())
 	}
 
-	conn := connection{conn: db, connDetails: cd, dbConnCache: memoize.NewMemoizer(2*time.Hour, time.Hour)}
+	ttl := cd.Pool.getDatabaseConnectionTTL()
+	conn := connection{conn: db, connDetails: cd, dbConnCache: memoize.NewMemoizer(ttl, ttl/2), ready: &readyDatabases{}}
 
 	conn.dbConnCache.Storage.OnEvicted(func(_ string, dbConn interface{}) {
 		dbConn.(*sql.DB).Close()
 	})
 
+	if err == nil {
+		if err := conn.waitForReady(ctx, db, cd.getServerDatabaseName()); err != nil {
+			diags.AddError("Database is not ready", err.Error())
+		}
+	}
+
 	return &conn, diags
 }
 
@@ -130,6 +208,34 @@ func (cd ConnectionDetails) getConnectionString(ctx context.Context) (string, di
 
 	if cd.Database != "" {
 		query.Set("database", cd.Database)
+	} else if cd.ApplicationIntent == ApplicationIntentReadOnly {
+		// Driver requires database to be set for read-only intent
+		query.Set("database", "master")
+	}
+
+	if cd.FailoverPartner != "" {
+		query.Set("failoverpartner", cd.FailoverPartner)
+	}
+
+	// Always set, as the driver enables multi-subnet failover when the parameter is missing
+	query.Set("multisubnetfailover", strconv.FormatBool(cd.MultiSubnetFailover))
+
+	if cd.ApplicationIntent != "" {
+		query.Set("applicationintent", cd.ApplicationIntent)
+	}
+
+	if cd.DialTimeout > 0 {
+		query.Set("dial timeout", formatTimeoutSeconds(cd.DialTimeout))
+	}
+
+	if cd.ConnectTimeout > 0 {
+		query.Set("connection timeout", formatTimeoutSeconds(cd.ConnectTimeout))
+	}
+
+	diags := diag.Diagnostics{}
+
+	if cd.Encryption != nil {
+		diags.Append(cd.Encryption.configure(query)...)
 	}
 
 	u := url.URL{
@@ -138,11 +244,25 @@ func (cd ConnectionDetails) getConnectionString(ctx context.Context) (string, di
 		RawQuery: query.Encode(),
 	}
 
-	diags := cd.Auth.configure(ctx, &u)
+	if cd.Instance != "" {
+		u.Path = cd.Instance
+	}
+
+	if cd.Auth == nil {
+		diags.AddError("Missing SQL authentication config", "None of authentication methods was provided")
+		return "", diags
+	}
+
+	diags.Append(cd.Auth.configure(ctx, &u)...)
 
 	return u.String(), diags
 }
 
+// formatTimeoutSeconds rounds the timeout up to whole seconds, as required by the driver.
+func formatTimeoutSeconds(timeout time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(timeout.Seconds())), 10)
+}
+
 func (c *connection) exec(ctx context.Context, query string, args ...any) sql.Result {
 	res, err := c.conn.ExecContext(ctx, query, args...)
 
@@ -174,27 +294,87 @@ func (c *connection) getDBSqlConnection(ctx context.Context, dbName string) *sql
 	driverName := connDetails.Auth.getDriverName()
 
 	conn, err, _ := c.dbConnCache.Memoize(fmt.Sprintf("%s||%s", driverName, connStr), func() (interface{}, error) {
-		var err error
-		var conn *sql.DB
-		for i := time.Second; i <= 5*time.Second; i += time.Second {
-			conn, err = sql.Open(driverName, connStr)
+		return openDB(ctx, connDetails, connStr)
+	})
 
-			if err == nil {
-				return conn, nil
-			}
+	if err != nil {
+		utils.AddError(ctx, "Failed to open DB connection", err)
+		return nil
+	}
 
-			time.Sleep(i)
+	db := conn.(*sql.DB)
+
+	// Checked on use, not only when the connection is opened, as the cached connection can outlive the database being available, e.g. auto-paused
+	if err := c.waitForReady(ctx, db, dbName); err != nil {
+		utils.AddError(ctx, "Database is not ready", err)
+		return nil
+	}
+
+	return db
+}
+
+func (cd ConnectionDetails) getRetryPolicy() RetryPolicy {
+	if cd.Retry == nil {
+		return DefaultRetryPolicy
+	}
+
+	return *cd.Retry
+}
+
+func openDB(ctx context.Context, cd ConnectionDetails, connStr string) (*sql.DB, error) {
+	var connector driver.Connector
+
+	if ca, ok := cd.Auth.(connectorAuth); ok {
+		var err error
+		if connector, err = ca.getConnector(ctx, connStr); err != nil {
+			return nil, err
 		}
+	}
 
-		return nil, err
-	})
+	if connector == nil {
+		var err error
+		if connector, err = getDriverConnector(cd.Auth.getDriverName(), connStr); err != nil {
+			return nil, err
+		}
+	}
+
+	db := sql.OpenDB(wrapConnector(connector, cd.getRetryPolicy(), cd.CommandTimeout))
+	cd.Pool.apply(db)
+
+	return db, nil
+}
 
+// getDriverConnector creates connector of the driver registered under driverName. database/sql does not expose
+// registered drivers, so the driver is taken from a DB handle, which does not open any connections by itself.
+func getDriverConnector(driverName string, connStr string) (driver.Connector, error) {
+	db, err := sql.Open(driverName, connStr)
 	if err != nil {
-		utils.AddError(ctx, "Failed to open DB connection", err)
-		return nil
+		return nil, err
+	}
+
+	drv := db.Driver()
+	if err := db.Close(); err != nil {
+		return nil, err
 	}
 
-	return conn.(*sql.DB)
+	if dc, ok := drv.(driver.DriverContext); ok {
+		return dc.OpenConnector(connStr)
+	}
+
+	return dsnConnector{driver: drv, dsn: connStr}, nil
+}
+
+type dsnConnector struct {
+	driver driver.Driver
+	dsn    string
+}
+
+func (c dsnConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.driver.Open(c.dsn)
+}
+
+func (c dsnConnector) Driver() driver.Driver {
+	return c.driver
 }
 
 func (c *connection) lookupServerPrincipalName(ctx context.Context, id GenericServerPrincipalId) string {
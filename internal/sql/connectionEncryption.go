@@ -0,0 +1,135 @@
+package sql
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	EncryptionDisable = "disable"
+	EncryptionFalse   = "false"
+	EncryptionTrue    = "true"
+	EncryptionStrict  = "strict"
+)
+
+var EncryptionModes = []string{EncryptionDisable, EncryptionFalse, EncryptionTrue, EncryptionStrict}
+
+type ConnectionEncryption struct {
+	// Mode is one of EncryptionModes. Empty value is treated as EncryptionTrue.
+	Mode string
+	// Certificate is either path to a PEM file or PEM-encoded content of the CA certificate used to validate server certificate.
+	Certificate            string
+	HostNameInCertificate  string
+	TrustServerCertificate bool
+}
+
+func (e ConnectionEncryption) configure(query url.Values) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	switch e.Mode {
+	case EncryptionDisable, EncryptionFalse:
+		query.Set("encrypt", e.Mode)
+	case "", EncryptionTrue:
+		query.Set("encrypt", EncryptionTrue)
+	case EncryptionStrict:
+		if e.TrustServerCertificate {
+			diags.AddError("Invalid encryption config", "Server certificate cannot be trusted without validation in strict encryption mode")
+			return diags
+		}
+		query.Set("encrypt", EncryptionStrict)
+	default:
+		diags.AddError("Invalid encryption config", fmt.Sprintf("Encryption mode %q is not supported", e.Mode))
+		return diags
+	}
+
+	query.Set("TrustServerCertificate", strconv.FormatBool(e.TrustServerCertificate))
+
+	if e.HostNameInCertificate != "" {
+		query.Set("hostNameInCertificate", e.HostNameInCertificate)
+	}
+
+	if e.Certificate != "" {
+		certPath, err := e.getCertificatePath()
+		if err != nil {
+			diags.AddError("Failed to save server CA certificate", err.Error())
+			return diags
+		}
+		query.Set("certificate", certPath)
+	}
+
+	return diags
+}
+
+var (
+	certificateFilesMutex sync.Mutex
+	// certificateDir returns directory of certificate files. It is private to the user, so other users cannot replace the files.
+	certificateDir = func() (string, error) {
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(cacheDir, "terraform-provider-mssql", "certificates"), nil
+	}
+)
+
+// getCertificatePath returns path of the certificate file. The driver accepts only paths, so PEM content is written
+// to a file named after the content hash, which is reused by all connections and provider runs using the same certificate.
+func (e ConnectionEncryption) getCertificatePath() (string, error) {
+	if !strings.Contains(e.Certificate, "-----BEGIN") {
+		return e.Certificate, nil
+	}
+
+	certificateFilesMutex.Lock()
+	defer certificateFilesMutex.Unlock()
+
+	dir, err := certificateDir()
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+
+	// MkdirAll keeps permissions of already existing directory
+	if err := os.Chmod(dir, 0700); err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256([]byte(e.Certificate))
+	certPath := filepath.Join(dir, hex.EncodeToString(hash[:])+".pem")
+
+	if content, err := os.ReadFile(certPath); err == nil && string(content) == e.Certificate {
+		return certPath, nil
+	}
+
+	// Written to temporary file first, so connections opened concurrently by other provider processes never read partial content
+	f, err := os.CreateTemp(dir, "ca-*.tmp")
+	if err != nil {
+		return "", err
+	}
+
+	_, err = f.WriteString(e.Certificate)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(f.Name(), certPath)
+	}
+
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+
+	return certPath, nil
+}
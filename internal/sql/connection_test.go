@@ -11,7 +11,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestConnectionTestSuite(t *testing.T) {
@@ -76,6 +79,156 @@ func (s *ConnectionTestSuite) TestGetConnectionStringReturnsParamsSetByAuthProvi
 	s.True(diags.Contains(testDiag), "diagnostics")
 }
 
+func (s *ConnectionTestSuite) TestGetConnectionStringSetsInstanceAndFailoverParams() {
+	s.connDetails.Host = "hostname_test"
+	s.connDetails.Database = "db_test"
+	s.connDetails.Instance = "instance_test"
+	s.connDetails.FailoverPartner = "partner_test"
+	s.connDetails.MultiSubnetFailover = true
+	s.connDetails.ApplicationIntent = ApplicationIntentReadOnly
+
+	cs, _ := s.getConnectionString()
+
+	s.Equal("/instance_test", cs.Path, "instance")
+	s.Equal("db_test", cs.Query().Get("database"), "database")
+	s.Equal("partner_test", cs.Query().Get("failoverpartner"), "failoverpartner")
+	s.Equal("true", cs.Query().Get("multisubnetfailover"), "multisubnetfailover")
+	s.Equal("ReadOnly", cs.Query().Get("applicationintent"), "applicationintent")
+}
+
+func (s *ConnectionTestSuite) TestGetConnectionStringDisablesMultiSubnetFailoverByDefault() {
+	cs, _ := s.getConnectionString()
+
+	s.Equal("false", cs.Query().Get("multisubnetfailover"), "multisubnetfailover")
+}
+
+func (s *ConnectionTestSuite) TestGetConnectionStringSetsTimeouts() {
+	s.connDetails.DialTimeout = 1500 * time.Millisecond
+	s.connDetails.ConnectTimeout = time.Minute
+
+	cs, _ := s.getConnectionString()
+
+	s.Equal("2", cs.Query().Get("dial timeout"), "dial timeout")
+	s.Equal("60", cs.Query().Get("connection timeout"), "connection timeout")
+}
+
+func (s *ConnectionTestSuite) TestGetConnectionStringWhenTimeoutsNotProvided() {
+	cs, _ := s.getConnectionString()
+
+	s.False(cs.Query().Has("dial timeout"), "dial timeout")
+	s.False(cs.Query().Has("connection timeout"), "connection timeout")
+}
+
+func (s *ConnectionTestSuite) TestGetConnectionStringReadOnlyIntentWithoutDatabase() {
+	s.connDetails.ApplicationIntent = ApplicationIntentReadOnly
+
+	cs, _ := s.getConnectionString()
+
+	s.Equal("master", cs.Query().Get("database"), "database")
+}
+
+func (s *ConnectionTestSuite) TestGetConnectionStringWhenEncryptionNotProvided() {
+	cs, _ := s.getConnectionString()
+
+	s.False(cs.Query().Has("encrypt"), "encrypt")
+	s.False(cs.Query().Has("TrustServerCertificate"), "TrustServerCertificate")
+}
+
+func (s *ConnectionTestSuite) TestGetConnectionStringSetsEncryptionParams() {
+	s.connDetails.Encryption = &ConnectionEncryption{
+		Mode:                   EncryptionFalse,
+		Certificate:            "/test/ca.pem",
+		HostNameInCertificate:  "test.host",
+		TrustServerCertificate: true,
+	}
+
+	cs, diags := s.getConnectionString()
+
+	s.False(diags.HasError(), "diagnostics")
+	s.Equal("false", cs.Query().Get("encrypt"), "encrypt")
+	s.Equal("/test/ca.pem", cs.Query().Get("certificate"), "certificate")
+	s.Equal("test.host", cs.Query().Get("hostNameInCertificate"), "hostNameInCertificate")
+	s.Equal("true", cs.Query().Get("TrustServerCertificate"), "TrustServerCertificate")
+}
+
+func (s *ConnectionTestSuite) TestGetConnectionStringDefaultEncryptionMode() {
+	s.connDetails.Encryption = &ConnectionEncryption{}
+
+	cs, _ := s.getConnectionString()
+
+	s.Equal("true", cs.Query().Get("encrypt"), "encrypt")
+	s.Equal("false", cs.Query().Get("TrustServerCertificate"), "TrustServerCertificate")
+}
+
+func (s *ConnectionTestSuite) TestGetConnectionStringStrictEncryption() {
+	s.connDetails.Encryption = &ConnectionEncryption{Mode: EncryptionStrict}
+
+	cs, diags := s.getConnectionString()
+
+	s.False(diags.HasError(), "diagnostics")
+	s.Equal("strict", cs.Query().Get("encrypt"), "encrypt")
+	s.Equal("false", cs.Query().Get("TrustServerCertificate"), "TrustServerCertificate")
+}
+
+func (s *ConnectionTestSuite) TestGetConnectionStringStrictEncryptionWithTrustedCertificate() {
+	s.connDetails.Encryption = &ConnectionEncryption{Mode: EncryptionStrict, TrustServerCertificate: true}
+
+	_, diags := s.getConnectionString()
+
+	s.True(diags.HasError(), "diagnostics")
+}
+
+func (s *ConnectionTestSuite) TestGetConnectionStringWritesPEMCertificate() {
+	const pem = "-----BEGIN CERTIFICATE-----\ntest\n-----END CERTIFICATE-----\n"
+	s.connDetails.Encryption = &ConnectionEncryption{Certificate: pem}
+	certDir := filepath.Join(s.T().TempDir(), "certificates")
+	defer s.withCertificateDir(certDir)()
+
+	cs, diags := s.getConnectionString()
+
+	s.False(diags.HasError(), "diagnostics")
+	certPath := cs.Query().Get("certificate")
+	s.Equal(certDir, filepath.Dir(certPath), "certificate directory")
+	content, err := os.ReadFile(certPath)
+	s.Require().NoError(err, "certificate file")
+	s.Equal(pem, string(content), "certificate content")
+
+	dirInfo, err := os.Stat(certDir)
+	s.Require().NoError(err, "certificate directory")
+	s.Equal(os.FileMode(0700), dirInfo.Mode().Perm(), "certificate directory permissions")
+
+	cs, _ = s.getConnectionString()
+	s.Equal(certPath, cs.Query().Get("certificate"), "certificate file should be reused")
+
+	entries, err := os.ReadDir(certDir)
+	s.Require().NoError(err, "certificate directory entries")
+	s.Len(entries, 1, "certificate directory entries")
+}
+
+func (s *ConnectionTestSuite) TestGetConnectionStringRewritesChangedPEMCertificate() {
+	const pem = "-----BEGIN CERTIFICATE-----\ntest\n-----END CERTIFICATE-----\n"
+	s.connDetails.Encryption = &ConnectionEncryption{Certificate: pem}
+	defer s.withCertificateDir(s.T().TempDir())()
+
+	cs, _ := s.getConnectionString()
+	certPath := cs.Query().Get("certificate")
+	s.Require().NoError(os.WriteFile(certPath, []byte("replaced"), 0600), "replacing certificate")
+
+	cs, diags := s.getConnectionString()
+
+	s.False(diags.HasError(), "diagnostics")
+	s.Equal(certPath, cs.Query().Get("certificate"), "certificate path")
+	content, err := os.ReadFile(certPath)
+	s.Require().NoError(err, "certificate file")
+	s.Equal(pem, string(content), "certificate content")
+}
+
+func (s *ConnectionTestSuite) withCertificateDir(dir string) func() {
+	original := certificateDir
+	certificateDir = func() (string, error) { return dir, nil }
+	return func() { certificateDir = original }
+}
+
 func TestGrantPermission(t *testing.T) {
 	cases := map[string]struct {
 		stat string
@@ -202,6 +355,18 @@ func TestExec(t *testing.T) {
 	t.Error("Error returned by SQL provider not added to Diagnostics")
 }
 
+func TestConnectionPoolApply(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err, "creating SQL mock")
+	defer db.Close()
+
+	ConnectionPool{MaxOpenConns: 7, ConnMaxLifetime: time.Minute}.apply(db)
+
+	assert.Equal(t, 7, db.Stats().MaxOpenConnections, "max open connections")
+	assert.Equal(t, defaultDatabaseConnectionTTL, ConnectionPool{}.getDatabaseConnectionTTL(), "default TTL")
+	assert.Equal(t, time.Minute, ConnectionPool{DatabaseConnectionTTL: time.Minute}.getDatabaseConnectionTTL(), "TTL")
+}
+
 var _ ConnectionAuth = mockedAuth{}
 
 type mockedAuth struct {
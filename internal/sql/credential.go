@@ -0,0 +1,120 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+)
+
+type CredentialSettings struct {
+	Name     string
+	Identity string
+	Secret   string
+}
+
+func (s CredentialSettings) toSqlOptions() string {
+	opts := fmt.Sprintf("IDENTITY = %s", quoteString(s.Identity))
+
+	if s.Secret != "" {
+		opts += fmt.Sprintf(", SECRET = %s", quoteString(s.Secret))
+	}
+
+	return opts
+}
+
+// toAlterSqlOptions always includes SECRET, because ALTER without it clears the secret stored by SQL Server.
+func (s CredentialSettings) toAlterSqlOptions() string {
+	return fmt.Sprintf("IDENTITY = %s, SECRET = %s", quoteString(s.Identity), quoteString(s.Secret))
+}
+
+type Credential interface {
+	GetId(context.Context) CredentialId
+	Exists(context.Context) bool
+	GetSettings(context.Context) CredentialSettings
+	UpdateSettings(ctx context.Context, settings CredentialSettings)
+	Drop(context.Context)
+}
+
+func GetCredential(_ context.Context, conn Connection, id CredentialId) Credential {
+	return credential{conn: conn, id: id}
+}
+
+func GetCredentialByName(ctx context.Context, conn Connection, name string) Credential {
+	var id CredentialId
+
+	err := conn.getSqlConnection(ctx).QueryRowContext(ctx, "SELECT [credential_id] FROM sys.credentials WHERE [name]=@p1", name).Scan(&id)
+	if err != nil {
+		utils.AddError(ctx, fmt.Sprintf("Failed to retrieve credential ID for name '%s'", name), err)
+		return nil
+	}
+
+	return GetCredential(ctx, conn, id)
+}
+
+func CreateCredential(ctx context.Context, conn Connection, settings CredentialSettings) Credential {
+	var cred Credential
+
+	utils.StopOnError(ctx).
+		Then(func() {
+			conn.exec(ctx, fmt.Sprintf("CREATE CREDENTIAL [%s] WITH %s", settings.Name, settings.toSqlOptions()))
+		}).
+		Then(func() { cred = GetCredentialByName(ctx, conn, settings.Name) })
+
+	return cred
+}
+
+var _ Credential = credential{}
+
+type credential struct {
+	conn Connection
+	id   CredentialId
+}
+
+func (c credential) GetId(context.Context) CredentialId {
+	return c.id
+}
+
+func (c credential) Exists(ctx context.Context) bool {
+	switch _, err := c.getSettingsRaw(ctx); err {
+	case sql.ErrNoRows:
+		return false
+	case nil:
+		return true
+	default:
+		utils.AddError(ctx, "Failed to check if credential exists", err)
+		return false
+	}
+}
+
+func (c credential) GetSettings(ctx context.Context) CredentialSettings {
+	settings, err := c.getSettingsRaw(ctx)
+	utils.AddError(ctx, "Failed to retrieve credential settings", err)
+	return settings
+}
+
+func (c credential) UpdateSettings(ctx context.Context, settings CredentialSettings) {
+	var name string
+
+	utils.StopOnError(ctx).
+		Then(func() { name = c.GetSettings(ctx).Name }).
+		Then(func() {
+			c.conn.exec(ctx, fmt.Sprintf("ALTER CREDENTIAL [%s] WITH %s", name, settings.toAlterSqlOptions()))
+		})
+}
+
+func (c credential) Drop(ctx context.Context) {
+	var name string
+
+	utils.StopOnError(ctx).
+		Then(func() { name = c.GetSettings(ctx).Name }).
+		Then(func() { c.conn.exec(ctx, fmt.Sprintf("DROP CREDENTIAL [%s]", name)) })
+}
+
+func (c credential) getSettingsRaw(ctx context.Context) (CredentialSettings, error) {
+	var settings CredentialSettings
+	err := c.conn.getSqlConnection(ctx).
+		QueryRowContext(ctx, "SELECT [name], [credential_identity] FROM sys.credentials WHERE [credential_id]=@p1", c.id).
+		Scan(&settings.Name, &settings.Identity)
+	return settings, err
+}
@@ -0,0 +1,105 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestCredentialTestSuite(t *testing.T) {
+	s := &CredentialTestSuite{}
+	suite.Run(t, s)
+}
+
+type CredentialTestSuite struct {
+	SqlTestSuite
+	cred credential
+}
+
+func (s *CredentialTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.cred = credential{conn: s.connMock, id: CredentialId(rand.Int())}
+}
+
+func (s *CredentialTestSuite) TestGetCredentialByName() {
+	s.expectCredentialIdQuery("test_cred", 256)
+
+	cred := GetCredentialByName(s.ctx, s.connMock, "test_cred")
+
+	s.Equal(CredentialId(256), cred.GetId(s.ctx))
+}
+
+func (s *CredentialTestSuite) TestCreateCredential() {
+	expectExactExec(s.mock, "CREATE CREDENTIAL [test_cred] WITH IDENTITY = 'test_identity', SECRET = 'it''s secret'").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectCredentialIdQuery("test_cred", 101)
+
+	cred := CreateCredential(s.ctx, s.connMock, CredentialSettings{Name: "test_cred", Identity: "test_identity", Secret: "it's secret"})
+
+	s.Equal(CredentialId(101), cred.GetId(s.ctx))
+}
+
+func (s *CredentialTestSuite) TestCreateCredentialWithoutSecret() {
+	expectExactExec(s.mock, "CREATE CREDENTIAL [test_cred] WITH IDENTITY = 'Managed Identity'").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectCredentialIdQuery("test_cred", 102)
+
+	cred := CreateCredential(s.ctx, s.connMock, CredentialSettings{Name: "test_cred", Identity: "Managed Identity"})
+
+	s.Equal(CredentialId(102), cred.GetId(s.ctx))
+}
+
+func (s *CredentialTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(newRows("name", "credential_identity").AddRow("test_cred", "test_identity"))
+
+	s.True(s.cred.Exists(s.ctx))
+}
+
+func (s *CredentialTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.cred.Exists(s.ctx))
+}
+
+func (s *CredentialTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(newRows("name", "credential_identity").AddRow("test_cred", "test_identity"))
+
+	settings := s.cred.GetSettings(s.ctx)
+
+	s.Equal(CredentialSettings{Name: "test_cred", Identity: "test_identity"}, settings)
+}
+
+func (s *CredentialTestSuite) TestUpdateSettings() {
+	s.expectSettingsQuery().WillReturnRows(newRows("name", "credential_identity").AddRow("test_cred", "old_identity"))
+	expectExactExec(s.mock, "ALTER CREDENTIAL [test_cred] WITH IDENTITY = 'new_identity', SECRET = 'new_secret'").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.cred.UpdateSettings(s.ctx, CredentialSettings{Name: "test_cred", Identity: "new_identity", Secret: "new_secret"})
+}
+
+func (s *CredentialTestSuite) TestUpdateSettingsWithoutSecret() {
+	s.expectSettingsQuery().WillReturnRows(newRows("name", "credential_identity").AddRow("test_cred", "old_identity"))
+	expectExactExec(s.mock, "ALTER CREDENTIAL [test_cred] WITH IDENTITY = 'Managed Identity', SECRET = ''").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.cred.UpdateSettings(s.ctx, CredentialSettings{Name: "test_cred", Identity: "Managed Identity"})
+}
+
+func (s *CredentialTestSuite) TestDrop() {
+	s.expectSettingsQuery().WillReturnRows(newRows("name", "credential_identity").AddRow("test_cred", "test_identity"))
+	expectExactExec(s.mock, "DROP CREDENTIAL [test_cred]").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.cred.Drop(s.ctx)
+}
+
+func (s *CredentialTestSuite) expectCredentialIdQuery(name string, id int) {
+	expectExactQuery(s.mock, "SELECT [credential_id] FROM sys.credentials WHERE [name]=@p1").
+		WithArgs(name).
+		WillReturnRows(newRows("credential_id").AddRow(id))
+}
+
+func (s *CredentialTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, "SELECT [name], [credential_identity] FROM sys.credentials WHERE [credential_id]=@p1").WithArgs(s.cred.id)
+}
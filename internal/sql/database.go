@@ -6,10 +6,27 @@ import (
 	"fmt"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
 	"strings"
+	"time"
 )
 
 const NullDatabaseId = DatabaseId(-1)
 
+const (
+	databaseEncryptionStateUnencrypted = 1
+	databaseEncryptionStateEncrypted   = 3
+)
+
+// Values of encryption_scan_state which require manual intervention, as the scan will not complete on its own
+const (
+	databaseEncryptionScanStateSuspended = 2
+	databaseEncryptionScanStateAborted   = 3
+)
+
+var (
+	encryptionStatePollInterval = 5 * time.Second
+	encryptionStateTimeout      = time.Hour
+)
+
 type DatabaseSettings struct {
 	Name      string
 	Collation string
@@ -29,6 +46,8 @@ type Database interface {
 	GetSettings(context.Context) DatabaseSettings
 	Rename(_ context.Context, name string)
 	SetCollation(_ context.Context, collation string)
+	IsEncrypted(context.Context) bool
+	SetEncryption(_ context.Context, enabled bool)
 	Drop(context.Context)
 	Query(ctx context.Context, query string) []map[string]string
 	Exec(ctx context.Context, script string)
@@ -139,6 +158,32 @@ func (db *database) SetCollation(ctx context.Context, collation string) {
 	db.conn.exec(ctx, fmt.Sprintf("ALTER DATABASE [%s] COLLATE %s", settings.Name, collation))
 }
 
+func (db *database) IsEncrypted(ctx context.Context) bool {
+	var encrypted bool
+
+	err := db.conn.getSqlConnection(ctx).QueryRowContext(ctx, "SELECT [is_encrypted] FROM sys.databases WHERE [database_id] = @p1", db.id).Scan(&encrypted)
+	utils.AddError(ctx, "Failed to retrieve DB encryption status", err)
+
+	return encrypted
+}
+
+func (db *database) SetEncryption(ctx context.Context, enabled bool) {
+	var (
+		settings DatabaseSettings
+		option   = "OFF"
+		state    = databaseEncryptionStateUnencrypted
+	)
+
+	if enabled {
+		option, state = "ON", databaseEncryptionStateEncrypted
+	}
+
+	utils.StopOnError(ctx).
+		Then(func() { settings = db.GetSettings(ctx) }).
+		Then(func() { db.conn.exec(ctx, fmt.Sprintf("ALTER DATABASE [%s] SET ENCRYPTION %s", settings.Name, option)) }).
+		Then(func() { db.waitForEncryptionState(ctx, state) })
+}
+
 func (db *database) Drop(ctx context.Context) {
 	settings := db.GetSettings(ctx)
 	db.conn.exec(ctx, fmt.Sprintf("DROP DATABASE [%s]", settings.Name))
@@ -279,6 +324,90 @@ func (db *database) getSettingsRaw(ctx context.Context) (DatabaseSettings, error
 	return settings, err
 }
 
+func (db *database) waitForEncryptionState(ctx context.Context, expected int) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, encryptionStateTimeout)
+	defer cancel()
+
+	for {
+		state, scanState, err := db.getEncryptionState(ctx)
+
+		switch err {
+		case sql.ErrNoRows:
+			if expected == databaseEncryptionStateUnencrypted {
+				return
+			}
+		case nil:
+			if state == expected {
+				return
+			}
+
+			if scanState == databaseEncryptionScanStateSuspended || scanState == databaseEncryptionScanStateAborted {
+				utils.AddError(ctx, "DB encryption scan did not complete", fmt.Errorf("encryption scan is suspended or aborted (encryption_scan_state = %d), use ALTER DATABASE ... SET ENCRYPTION RESUME to continue it", scanState))
+				return
+			}
+		default:
+			utils.AddError(ctx, "Failed to retrieve DB encryption state", err)
+			return
+		}
+
+		select {
+		case <-timeoutCtx.Done():
+			utils.AddError(ctx, "DB encryption state did not reach the expected value", timeoutCtx.Err())
+			return
+		case <-time.After(encryptionStatePollInterval):
+		}
+	}
+}
+
+// getEncryptionState selects all columns, because encryption_scan_state is available only since SQL Server 2019.
+// When the column is missing, returned scan state is 0.
+func (db *database) getEncryptionState(ctx context.Context) (state int, scanState int, err error) {
+	rows, err := db.conn.getSqlConnection(ctx).QueryContext(ctx, "SELECT * FROM sys.dm_database_encryption_keys WHERE [database_id] = @p1", db.id)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err = rows.Err(); err == nil {
+			err = sql.ErrNoRows
+		}
+		return
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return
+	}
+
+	values := make([]any, len(columns))
+	for i := range values {
+		values[i] = new(any)
+	}
+
+	if err = rows.Scan(values...); err != nil {
+		return
+	}
+
+	for i, column := range columns {
+		var value sql.NullInt64
+		switch column {
+		case "encryption_state":
+			err = value.Scan(*values[i].(*any))
+			state = int(value.Int64)
+		case "encryption_scan_state":
+			err = value.Scan(*values[i].(*any))
+			scanState = int(value.Int64)
+		}
+
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
 func (db *database) connect(ctx context.Context) *sql.DB {
 	settings := db.GetSettings(ctx)
 	if utils.HasError(ctx) {
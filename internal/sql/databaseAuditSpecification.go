@@ -0,0 +1,256 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"strings"
+)
+
+type DatabaseAuditAction struct {
+	Action      string
+	Securable   string
+	PrincipalId GenericDatabasePrincipalId
+}
+
+func (a DatabaseAuditAction) toSql(ctx context.Context, db Database) string {
+	securable := a.Securable
+	if parts := strings.SplitN(securable, "::", 2); len(parts) == 2 {
+		securable = fmt.Sprintf("%s::%s", strings.ToUpper(parts[0]), quoteSchemaQualifiedName(parts[1]))
+	}
+
+	return fmt.Sprintf("%s ON %s BY [%s]", strings.ToUpper(a.Action), securable, db.getUserName(ctx, a.PrincipalId))
+}
+
+type DatabaseAuditSpecificationSettings struct {
+	Name         string
+	AuditId      ServerAuditId
+	ActionGroups []string
+	Actions      []DatabaseAuditAction
+	Enabled      bool
+}
+
+func (s DatabaseAuditSpecificationSettings) toSqlActions(ctx context.Context, db Database) []string {
+	actions := append([]string{}, s.ActionGroups...)
+
+	for _, action := range s.Actions {
+		actions = append(actions, action.toSql(ctx, db))
+	}
+
+	return actions
+}
+
+type DatabaseAuditSpecification interface {
+	GetId(context.Context) DatabaseAuditSpecificationId
+	GetDb(context.Context) Database
+	Exists(context.Context) bool
+	GetSettings(context.Context) DatabaseAuditSpecificationSettings
+	UpdateSettings(context.Context, DatabaseAuditSpecificationSettings)
+	Drop(context.Context)
+}
+
+func GetDatabaseAuditSpecification(_ context.Context, db Database, id DatabaseAuditSpecificationId) DatabaseAuditSpecification {
+	return databaseAuditSpecification{db: db, id: id}
+}
+
+func CreateDatabaseAuditSpecification(ctx context.Context, db Database, settings DatabaseAuditSpecificationSettings) DatabaseAuditSpecification {
+	var auditName string
+
+	utils.StopOnError(ctx).
+		Then(func() { auditName = GetServerAudit(ctx, db.GetConnection(ctx), settings.AuditId).GetSettings(ctx).Name })
+
+	if utils.HasError(ctx) {
+		return nil
+	}
+
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) DatabaseAuditSpecification {
+		var actions []string
+		for _, action := range settings.toSqlActions(ctx, db) {
+			actions = append(actions, fmt.Sprintf("ADD (%s)", action))
+		}
+
+		stat := fmt.Sprintf("CREATE DATABASE AUDIT SPECIFICATION [%s] FOR SERVER AUDIT [%s] %s WITH (STATE = %s)",
+			settings.Name, auditName, strings.Join(actions, ", "), onOff(settings.Enabled))
+
+		if _, err := conn.ExecContext(ctx, stat); err != nil {
+			utils.AddError(ctx, "Failed to create database audit specification", err)
+			return nil
+		}
+
+		var id DatabaseAuditSpecificationId
+		err := conn.QueryRowContext(ctx, "SELECT [database_specification_id] FROM sys.database_audit_specifications WHERE [name]=@p1", settings.Name).Scan(&id)
+		if err != nil {
+			utils.AddError(ctx, "Failed to retrieve database audit specification ID", err)
+			return nil
+		}
+
+		return GetDatabaseAuditSpecification(ctx, db, id)
+	})
+}
+
+var _ DatabaseAuditSpecification = databaseAuditSpecification{}
+
+type databaseAuditSpecification struct {
+	db Database
+	id DatabaseAuditSpecificationId
+}
+
+func (s databaseAuditSpecification) GetId(context.Context) DatabaseAuditSpecificationId {
+	return s.id
+}
+
+func (s databaseAuditSpecification) GetDb(context.Context) Database {
+	return s.db
+}
+
+func (s databaseAuditSpecification) Exists(ctx context.Context) bool {
+	return WithConnection(ctx, s.db.connect, func(conn *sql.DB) bool {
+		switch _, err := s.getSettingsRaw(ctx, conn); err {
+		case sql.ErrNoRows:
+			return false
+		case nil:
+			return true
+		default:
+			utils.AddError(ctx, "Failed to check if database audit specification exists", err)
+			return false
+		}
+	})
+}
+
+func (s databaseAuditSpecification) GetSettings(ctx context.Context) DatabaseAuditSpecificationSettings {
+	return WithConnection(ctx, s.db.connect, func(conn *sql.DB) DatabaseAuditSpecificationSettings {
+		settings, err := s.getSettingsRaw(ctx, conn)
+		if err != nil {
+			utils.AddError(ctx, "Failed to retrieve database audit specification settings", err)
+			return settings
+		}
+
+		settings.ActionGroups, settings.Actions = s.getActions(ctx, conn)
+		return settings
+	})
+}
+
+// UpdateSettings disables the specification for the time of the change, as SQL Server does not allow altering enabled specifications.
+func (s databaseAuditSpecification) UpdateSettings(ctx context.Context, settings DatabaseAuditSpecificationSettings) {
+	var (
+		current   DatabaseAuditSpecificationSettings
+		auditName string
+	)
+
+	utils.StopOnError(ctx).
+		Then(func() { current = s.GetSettings(ctx) }).
+		Then(func() {
+			auditName = GetServerAudit(ctx, s.db.GetConnection(ctx), settings.AuditId).GetSettings(ctx).Name
+		})
+
+	if utils.HasError(ctx) {
+		return
+	}
+
+	WithConnection(ctx, s.db.connect, func(conn *sql.DB) any {
+		exec := func(stat string, errorSummary string) {
+			if utils.HasError(ctx) {
+				return
+			}
+
+			_, err := conn.ExecContext(ctx, stat)
+			utils.AddError(ctx, errorSummary, err)
+		}
+
+		if current.Enabled {
+			exec(fmt.Sprintf("ALTER DATABASE AUDIT SPECIFICATION [%s] WITH (STATE = OFF)", current.Name), "Failed to disable database audit specification")
+		}
+
+		actions := diffActionGroups(current.toSqlActions(ctx, s.db), settings.toSqlActions(ctx, s.db))
+		if len(actions) > 0 || current.AuditId != settings.AuditId {
+			stat := fmt.Sprintf("ALTER DATABASE AUDIT SPECIFICATION [%s] FOR SERVER AUDIT [%s] %s", current.Name, auditName, strings.Join(actions, ", "))
+			exec(strings.TrimSpace(stat), "Failed to update database audit specification")
+		}
+
+		if settings.Enabled {
+			exec(fmt.Sprintf("ALTER DATABASE AUDIT SPECIFICATION [%s] WITH (STATE = ON)", current.Name), "Failed to enable database audit specification")
+		}
+
+		return nil
+	})
+}
+
+func (s databaseAuditSpecification) Drop(ctx context.Context) {
+	WithConnection(ctx, s.db.connect, func(conn *sql.DB) any {
+		settings, err := s.getSettingsRaw(ctx, conn)
+		if err != nil {
+			utils.AddError(ctx, "Failed to retrieve database audit specification settings", err)
+			return nil
+		}
+
+		if settings.Enabled {
+			if _, err := conn.ExecContext(ctx, fmt.Sprintf("ALTER DATABASE AUDIT SPECIFICATION [%s] WITH (STATE = OFF)", settings.Name)); err != nil {
+				utils.AddError(ctx, "Failed to disable database audit specification", err)
+				return nil
+			}
+		}
+
+		_, err = conn.ExecContext(ctx, fmt.Sprintf("DROP DATABASE AUDIT SPECIFICATION [%s]", settings.Name))
+		utils.AddError(ctx, "Failed to drop database audit specification", err)
+		return nil
+	})
+}
+
+func (s databaseAuditSpecification) getSettingsRaw(ctx context.Context, conn *sql.DB) (DatabaseAuditSpecificationSettings, error) {
+	var settings DatabaseAuditSpecificationSettings
+	err := conn.
+		QueryRowContext(ctx, `
+SELECT s.[name], a.[audit_id], s.[is_state_enabled]
+FROM sys.database_audit_specifications s
+INNER JOIN sys.server_audits a ON a.[audit_guid] = s.[audit_guid]
+WHERE s.[database_specification_id]=@p1`, s.id).
+		Scan(&settings.Name, &settings.AuditId, &settings.Enabled)
+	return settings, err
+}
+
+func (s databaseAuditSpecification) getActions(ctx context.Context, conn *sql.DB) ([]string, []DatabaseAuditAction) {
+	const errorSummary = "Failed to retrieve database audit specification actions"
+	var (
+		groups  []string
+		actions []DatabaseAuditAction
+	)
+
+	rows, err := conn.QueryContext(ctx, `
+SELECT
+    [audit_action_name],
+    [is_group],
+    CASE [class]
+        WHEN 0 THEN 'DATABASE::' + DB_NAME()
+        WHEN 3 THEN 'SCHEMA::' + SCHEMA_NAME([major_id])
+        ELSE 'OBJECT::' + OBJECT_SCHEMA_NAME([major_id]) + '.' + OBJECT_NAME([major_id])
+    END,
+    [audited_principal_id]
+FROM sys.database_audit_specification_details
+WHERE [database_specification_id]=@p1`, s.id)
+	if err != nil {
+		utils.AddError(ctx, errorSummary, err)
+		return groups, actions
+	}
+
+	for rows.Next() {
+		var (
+			action  DatabaseAuditAction
+			isGroup bool
+		)
+
+		if err := rows.Scan(&action.Action, &isGroup, &action.Securable, &action.PrincipalId); err != nil {
+			utils.AddError(ctx, errorSummary, err)
+			return groups, actions
+		}
+
+		if isGroup {
+			groups = append(groups, action.Action)
+		} else {
+			actions = append(actions, action)
+		}
+	}
+
+	utils.AddError(ctx, errorSummary, rows.Err())
+	return groups, actions
+}
@@ -0,0 +1,149 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestDatabaseAuditSpecificationTestSuite(t *testing.T) {
+	s := &DatabaseAuditSpecificationTestSuite{}
+	suite.Run(t, s)
+}
+
+type DatabaseAuditSpecificationTestSuite struct {
+	SqlTestSuite
+	spec databaseAuditSpecification
+}
+
+func (s *DatabaseAuditSpecificationTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.spec = databaseAuditSpecification{db: &s.dbMock, id: DatabaseAuditSpecificationId(rand.Int())}
+}
+
+func (s *DatabaseAuditSpecificationTestSuite) TestCreate() {
+	s.expectAuditNameQuery()
+	s.dbMock.expectUsernameLookup(0, "public")
+	expectExactExec(s.mock, "CREATE DATABASE AUDIT SPECIFICATION [test_spec] FOR SERVER AUDIT [test_audit] ADD (SCHEMA_OBJECT_CHANGE_GROUP), ADD (SELECT ON OBJECT::[dbo].[Customers] BY [public]) WITH (STATE = ON)").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactQuery(s.mock, "SELECT [database_specification_id] FROM sys.database_audit_specifications WHERE [name]=@p1").
+		WithArgs("test_spec").
+		WillReturnRows(newRows("database_specification_id").AddRow(65539))
+
+	spec := CreateDatabaseAuditSpecification(s.ctx, &s.dbMock, DatabaseAuditSpecificationSettings{
+		Name:         "test_spec",
+		AuditId:      65536,
+		ActionGroups: []string{"SCHEMA_OBJECT_CHANGE_GROUP"},
+		Actions:      []DatabaseAuditAction{{Action: "select", Securable: "OBJECT::dbo.Customers", PrincipalId: 0}},
+		Enabled:      true,
+	})
+
+	s.Equal(DatabaseAuditSpecificationId(65539), spec.GetId(s.ctx))
+}
+
+func (s *DatabaseAuditSpecificationTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(true))
+
+	s.True(s.spec.Exists(s.ctx))
+}
+
+func (s *DatabaseAuditSpecificationTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.spec.Exists(s.ctx))
+}
+
+func (s *DatabaseAuditSpecificationTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(true))
+	s.expectActionsQuery()
+
+	s.Equal(DatabaseAuditSpecificationSettings{
+		Name:         "test_spec",
+		AuditId:      65536,
+		ActionGroups: []string{"SCHEMA_OBJECT_CHANGE_GROUP"},
+		Actions: []DatabaseAuditAction{
+			{Action: "SELECT", Securable: "OBJECT::dbo.Customers", PrincipalId: 0},
+			{Action: "DELETE", Securable: "SCHEMA::sales", PrincipalId: 5},
+		},
+		Enabled: true,
+	}, s.spec.GetSettings(s.ctx))
+}
+
+func (s *DatabaseAuditSpecificationTestSuite) TestUpdateSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(true))
+	s.expectActionsQuery()
+	s.expectAuditNameQuery()
+	s.dbMock.expectUsernameLookup(0, "public")
+	s.dbMock.expectUsernameLookup(5, "sales_user")
+	expectExactExec(s.mock, "ALTER DATABASE AUDIT SPECIFICATION [test_spec] WITH (STATE = OFF)").WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactExec(s.mock, "ALTER DATABASE AUDIT SPECIFICATION [test_spec] FOR SERVER AUDIT [test_audit] ADD (DATABASE_ROLE_MEMBER_CHANGE_GROUP), DROP (SCHEMA_OBJECT_CHANGE_GROUP), DROP (DELETE ON SCHEMA::[sales] BY [sales_user])").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.spec.UpdateSettings(s.ctx, DatabaseAuditSpecificationSettings{
+		Name:         "test_spec",
+		AuditId:      65536,
+		ActionGroups: []string{"DATABASE_ROLE_MEMBER_CHANGE_GROUP"},
+		Actions:      []DatabaseAuditAction{{Action: "SELECT", Securable: "object::dbo.Customers", PrincipalId: 0}},
+		Enabled:      false,
+	})
+}
+
+func (s *DatabaseAuditSpecificationTestSuite) TestDrop() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(false))
+	expectExactExec(s.mock, "DROP DATABASE AUDIT SPECIFICATION [test_spec]").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.spec.Drop(s.ctx)
+}
+
+func (s *DatabaseAuditSpecificationTestSuite) expectAuditNameQuery() {
+	expectExactQuery(s.mock, `
+SELECT
+    a.[name],
+    a.[type],
+    ISNULL(f.[log_file_path], ''),
+    ISNULL(f.[max_file_size], 0),
+    ISNULL(f.[max_rollover_files], 0),
+    a.[queue_delay],
+    a.[on_failure],
+    a.[is_state_enabled]
+FROM sys.server_audits a
+LEFT JOIN sys.server_file_audits f ON f.[audit_id] = a.[audit_id]
+WHERE a.[audit_id]=@p1`).
+		WithArgs(65536).
+		WillReturnRows(newRows("name", "type", "log_file_path", "max_file_size", "max_rollover_files", "queue_delay", "on_failure", "is_state_enabled").
+			AddRow("test_audit", "AL", "", 0, 0, 1000, 0, true))
+}
+
+func (s *DatabaseAuditSpecificationTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, `
+SELECT s.[name], a.[audit_id], s.[is_state_enabled]
+FROM sys.database_audit_specifications s
+INNER JOIN sys.server_audits a ON a.[audit_guid] = s.[audit_guid]
+WHERE s.[database_specification_id]=@p1`).WithArgs(s.spec.id)
+}
+
+func (s *DatabaseAuditSpecificationTestSuite) newSettingsRows(enabled bool) *sqlmock.Rows {
+	return newRows("name", "audit_id", "is_state_enabled").AddRow("test_spec", 65536, enabled)
+}
+
+func (s *DatabaseAuditSpecificationTestSuite) expectActionsQuery() {
+	expectExactQuery(s.mock, `
+SELECT
+    [audit_action_name],
+    [is_group],
+    CASE [class]
+        WHEN 0 THEN 'DATABASE::' + DB_NAME()
+        WHEN 3 THEN 'SCHEMA::' + SCHEMA_NAME([major_id])
+        ELSE 'OBJECT::' + OBJECT_SCHEMA_NAME([major_id]) + '.' + OBJECT_NAME([major_id])
+    END,
+    [audited_principal_id]
+FROM sys.database_audit_specification_details
+WHERE [database_specification_id]=@p1`).
+		WithArgs(s.spec.id).
+		WillReturnRows(newRows("audit_action_name", "is_group", "securable", "audited_principal_id").
+			AddRow("SCHEMA_OBJECT_CHANGE_GROUP", true, "DATABASE::test_db", 0).
+			AddRow("SELECT", false, "OBJECT::dbo.Customers", 0).
+			AddRow("DELETE", false, "SCHEMA::sales", 5))
+}
@@ -0,0 +1,148 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"strings"
+)
+
+type DatabaseEncryptionKeySettings struct {
+	Algorithm           string
+	ServerCertificateId CertificateId
+}
+
+type DatabaseEncryptionKey interface {
+	GetDb(context.Context) Database
+	Exists(context.Context) bool
+	GetSettings(context.Context) DatabaseEncryptionKeySettings
+	UpdateSettings(ctx context.Context, settings DatabaseEncryptionKeySettings)
+	Drop(context.Context)
+}
+
+func GetDatabaseEncryptionKey(_ context.Context, db Database) DatabaseEncryptionKey {
+	return databaseEncryptionKey{db: db}
+}
+
+func CreateDatabaseEncryptionKey(ctx context.Context, db Database, settings DatabaseEncryptionKeySettings) DatabaseEncryptionKey {
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) DatabaseEncryptionKey {
+		certName := lookupServerCertificateName(ctx, conn, settings.ServerCertificateId)
+		if utils.HasError(ctx) {
+			return nil
+		}
+
+		stat := fmt.Sprintf("CREATE DATABASE ENCRYPTION KEY WITH ALGORITHM = %s ENCRYPTION BY SERVER CERTIFICATE [%s]", settings.Algorithm, certName)
+		if _, err := conn.ExecContext(ctx, stat); err != nil {
+			utils.AddError(ctx, "Failed to create database encryption key", err)
+			return nil
+		}
+
+		return GetDatabaseEncryptionKey(ctx, db)
+	})
+}
+
+var _ DatabaseEncryptionKey = databaseEncryptionKey{}
+
+type databaseEncryptionKey struct {
+	db Database
+}
+
+func (k databaseEncryptionKey) GetDb(context.Context) Database {
+	return k.db
+}
+
+func (k databaseEncryptionKey) Exists(ctx context.Context) bool {
+	return WithConnection(ctx, k.db.connect, func(conn *sql.DB) bool {
+		switch _, err := k.getSettingsRaw(ctx, conn); err {
+		case sql.ErrNoRows:
+			return false
+		case nil:
+			return true
+		default:
+			utils.AddError(ctx, "Failed to check if database encryption key exists", err)
+			return false
+		}
+	})
+}
+
+func (k databaseEncryptionKey) GetSettings(ctx context.Context) DatabaseEncryptionKeySettings {
+	return WithConnection(ctx, k.db.connect, func(conn *sql.DB) DatabaseEncryptionKeySettings {
+		settings, err := k.getSettingsRaw(ctx, conn)
+		utils.AddError(ctx, "Failed to retrieve database encryption key settings", err)
+		return settings
+	})
+}
+
+func (k databaseEncryptionKey) UpdateSettings(ctx context.Context, settings DatabaseEncryptionKeySettings) {
+	WithConnection(ctx, k.db.connect, func(conn *sql.DB) any {
+		current, err := k.getSettingsRaw(ctx, conn)
+		if err != nil {
+			utils.AddError(ctx, "Failed to retrieve database encryption key settings", err)
+			return nil
+		}
+
+		if current.Algorithm != settings.Algorithm {
+			if _, err := conn.ExecContext(ctx, fmt.Sprintf("ALTER DATABASE ENCRYPTION KEY REGENERATE WITH ALGORITHM = %s", settings.Algorithm)); err != nil {
+				utils.AddError(ctx, "Failed to regenerate database encryption key", err)
+				return nil
+			}
+		}
+
+		if current.ServerCertificateId != settings.ServerCertificateId {
+			certName := lookupServerCertificateName(ctx, conn, settings.ServerCertificateId)
+			if utils.HasError(ctx) {
+				return nil
+			}
+
+			if _, err := conn.ExecContext(ctx, fmt.Sprintf("ALTER DATABASE ENCRYPTION KEY ENCRYPTION BY SERVER CERTIFICATE [%s]", certName)); err != nil {
+				utils.AddError(ctx, "Failed to change database encryption key encryptor", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (k databaseEncryptionKey) Drop(ctx context.Context) {
+	WithConnection(ctx, k.db.connect, func(conn *sql.DB) any {
+		if _, err := conn.ExecContext(ctx, "DROP DATABASE ENCRYPTION KEY"); err != nil {
+			utils.AddError(ctx, "Failed to drop database encryption key", err)
+		}
+
+		return nil
+	})
+}
+
+func (k databaseEncryptionKey) getSettingsRaw(ctx context.Context, conn *sql.DB) (DatabaseEncryptionKeySettings, error) {
+	var (
+		settings  DatabaseEncryptionKeySettings
+		algorithm string
+		keyLength int
+		certId    sql.NullInt32
+	)
+
+	err := conn.
+		QueryRowContext(ctx, "SELECT dek.[key_algorithm], dek.[key_length], c.[certificate_id] FROM sys.dm_database_encryption_keys dek LEFT JOIN master.sys.certificates c ON c.[thumbprint] = dek.[encryptor_thumbprint] WHERE dek.[database_id] = DB_ID()").
+		Scan(&algorithm, &keyLength, &certId)
+
+	if strings.EqualFold(algorithm, "TRIPLE_DES") {
+		settings.Algorithm = "TRIPLE_DES_3KEY"
+	} else {
+		settings.Algorithm = fmt.Sprintf("%s_%d", strings.ToUpper(algorithm), keyLength)
+	}
+
+	settings.ServerCertificateId = CertificateId(certId.Int32)
+
+	return settings, err
+}
+
+func lookupServerCertificateName(ctx context.Context, conn *sql.DB, id CertificateId) string {
+	var name string
+
+	if err := conn.QueryRowContext(ctx, "SELECT [name] FROM master.sys.certificates WHERE [certificate_id]=@p1", id).Scan(&name); err != nil {
+		utils.AddError(ctx, fmt.Sprintf("Failed to retrieve name of server certificate with ID %d", id), err)
+	}
+
+	return name
+}
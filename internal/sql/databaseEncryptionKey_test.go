@@ -0,0 +1,91 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestDatabaseEncryptionKeyTestSuite(t *testing.T) {
+	s := &DatabaseEncryptionKeyTestSuite{}
+	suite.Run(t, s)
+}
+
+type DatabaseEncryptionKeyTestSuite struct {
+	SqlTestSuite
+	key databaseEncryptionKey
+}
+
+func (s *DatabaseEncryptionKeyTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.key = databaseEncryptionKey{db: &s.dbMock}
+}
+
+func (s *DatabaseEncryptionKeyTestSuite) TestCreateDatabaseEncryptionKey() {
+	s.expectCertificateNameQuery(256, "tde_cert")
+	expectExactExec(s.mock, "CREATE DATABASE ENCRYPTION KEY WITH ALGORITHM = AES_256 ENCRYPTION BY SERVER CERTIFICATE [tde_cert]").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	key := CreateDatabaseEncryptionKey(s.ctx, &s.dbMock, DatabaseEncryptionKeySettings{Algorithm: "AES_256", ServerCertificateId: 256})
+
+	s.Equal(&s.dbMock, key.GetDb(s.ctx))
+}
+
+func (s *DatabaseEncryptionKeyTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(newRows("key_algorithm", "key_length", "certificate_id").AddRow("AES", 256, 256))
+
+	s.True(s.key.Exists(s.ctx))
+}
+
+func (s *DatabaseEncryptionKeyTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.key.Exists(s.ctx))
+}
+
+func (s *DatabaseEncryptionKeyTestSuite) TestGetSettings() {
+	cases := map[string]struct {
+		algorithm string
+		keyLength int
+		expected  string
+	}{
+		"AES":        {algorithm: "AES", keyLength: 128, expected: "AES_128"},
+		"TRIPLE_DES": {algorithm: "TRIPLE_DES", keyLength: 192, expected: "TRIPLE_DES_3KEY"},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.expectSettingsQuery().WillReturnRows(newRows("key_algorithm", "key_length", "certificate_id").AddRow(tc.algorithm, tc.keyLength, 256))
+
+			settings := s.key.GetSettings(s.ctx)
+
+			s.Equal(DatabaseEncryptionKeySettings{Algorithm: tc.expected, ServerCertificateId: 256}, settings)
+		})
+	}
+}
+
+func (s *DatabaseEncryptionKeyTestSuite) TestUpdateSettings() {
+	s.expectSettingsQuery().WillReturnRows(newRows("key_algorithm", "key_length", "certificate_id").AddRow("AES", 128, 256))
+	expectExactExec(s.mock, "ALTER DATABASE ENCRYPTION KEY REGENERATE WITH ALGORITHM = AES_256").WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectCertificateNameQuery(257, "new_cert")
+	expectExactExec(s.mock, "ALTER DATABASE ENCRYPTION KEY ENCRYPTION BY SERVER CERTIFICATE [new_cert]").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.key.UpdateSettings(s.ctx, DatabaseEncryptionKeySettings{Algorithm: "AES_256", ServerCertificateId: 257})
+}
+
+func (s *DatabaseEncryptionKeyTestSuite) TestDrop() {
+	expectExactExec(s.mock, "DROP DATABASE ENCRYPTION KEY").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.key.Drop(s.ctx)
+}
+
+func (s *DatabaseEncryptionKeyTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, "SELECT dek.[key_algorithm], dek.[key_length], c.[certificate_id] FROM sys.dm_database_encryption_keys dek LEFT JOIN master.sys.certificates c ON c.[thumbprint] = dek.[encryptor_thumbprint] WHERE dek.[database_id] = DB_ID()")
+}
+
+func (s *DatabaseEncryptionKeyTestSuite) expectCertificateNameQuery(id int, name string) {
+	expectExactQuery(s.mock, "SELECT [name] FROM master.sys.certificates WHERE [certificate_id]=@p1").
+		WithArgs(id).
+		WillReturnRows(newRows("name").AddRow(name))
+}
@@ -0,0 +1,95 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"regexp"
+	"strings"
+)
+
+// DatabaseScopedConfigurationPrimary is the secondary value meaning that secondary replicas use the same value as the primary.
+const DatabaseScopedConfigurationPrimary = "PRIMARY"
+
+var (
+	scopedConfigurationNameRegex    = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	scopedConfigurationKeywordRegex = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+)
+
+type DatabaseScopedConfiguration struct {
+	Name              string
+	Value             string
+	ValueForSecondary string
+	IsDefault         bool
+}
+
+// ScopedConfigurationValuesEqual compares configuration values, taking into account that ON/OFF options are reported as 1/0 by sys.database_scoped_configurations.
+func ScopedConfigurationValuesEqual(a string, b string) bool {
+	normalize := func(value string) string {
+		switch strings.ToUpper(value) {
+		case "ON":
+			return "1"
+		case "OFF":
+			return "0"
+		default:
+			return strings.ToUpper(value)
+		}
+	}
+
+	return normalize(a) == normalize(b)
+}
+
+func GetDatabaseScopedConfigurations(ctx context.Context, db Database) []DatabaseScopedConfiguration {
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) []DatabaseScopedConfiguration {
+		rows, err := conn.QueryContext(ctx, `SELECT [name], ISNULL(CONVERT(NVARCHAR(MAX), [value]), ''), ISNULL(CONVERT(NVARCHAR(MAX), [value_for_secondary]), ''), [is_value_default]
+			FROM sys.database_scoped_configurations`)
+		if err != nil {
+			utils.AddError(ctx, "Failed to read database scoped configurations", err)
+			return nil
+		}
+		defer rows.Close()
+
+		var configs []DatabaseScopedConfiguration
+		for rows.Next() {
+			var config DatabaseScopedConfiguration
+			if err := rows.Scan(&config.Name, &config.Value, &config.ValueForSecondary, &config.IsDefault); err != nil {
+				utils.AddError(ctx, "Failed to read database scoped configurations", err)
+				return nil
+			}
+
+			if config.ValueForSecondary == "" {
+				config.ValueForSecondary = DatabaseScopedConfigurationPrimary
+			}
+
+			configs = append(configs, config)
+		}
+
+		utils.AddError(ctx, "Failed to read database scoped configurations", rows.Err())
+		return configs
+	})
+}
+
+// SetDatabaseScopedConfiguration changes value of the configuration for primary or, when forSecondary is true, secondary replicas.
+func SetDatabaseScopedConfiguration(ctx context.Context, db Database, name string, value string, forSecondary bool) {
+	if !scopedConfigurationNameRegex.MatchString(name) {
+		utils.AddError(ctx, "Invalid database scoped configuration", fmt.Errorf("'%s' is not a valid configuration name", name))
+		return
+	}
+
+	if !scopedConfigurationKeywordRegex.MatchString(value) {
+		value = quoteString(value)
+	}
+
+	target := ""
+	if forSecondary {
+		target = "FOR SECONDARY "
+	}
+
+	WithConnection(ctx, db.connect, func(conn *sql.DB) any {
+		stat := fmt.Sprintf("ALTER DATABASE SCOPED CONFIGURATION %sSET %s = %s", target, strings.ToUpper(name), value)
+		_, err := conn.ExecContext(ctx, stat)
+		utils.AddError(ctx, fmt.Sprintf("Failed to set database scoped configuration %s", name), err)
+		return nil
+	})
+}
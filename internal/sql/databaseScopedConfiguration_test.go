@@ -0,0 +1,68 @@
+package sql
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestDatabaseScopedConfigurationTestSuite(t *testing.T) {
+	s := &DatabaseScopedConfigurationTestSuite{}
+	suite.Run(t, s)
+}
+
+type DatabaseScopedConfigurationTestSuite struct {
+	SqlTestSuite
+}
+
+func (s *DatabaseScopedConfigurationTestSuite) TestGetConfigurations() {
+	expectExactQuery(s.mock, `SELECT [name], ISNULL(CONVERT(NVARCHAR(MAX), [value]), ''), ISNULL(CONVERT(NVARCHAR(MAX), [value_for_secondary]), ''), [is_value_default]
+			FROM sys.database_scoped_configurations`).
+		WillReturnRows(newRows("name", "value", "value_for_secondary", "is_value_default").
+			AddRow("MAXDOP", "4", "1", false).
+			AddRow("LEGACY_CARDINALITY_ESTIMATION", "0", "", true))
+
+	configs := GetDatabaseScopedConfigurations(s.ctx, &s.dbMock)
+
+	s.Equal([]DatabaseScopedConfiguration{
+		{Name: "MAXDOP", Value: "4", ValueForSecondary: "1"},
+		{Name: "LEGACY_CARDINALITY_ESTIMATION", Value: "0", ValueForSecondary: "PRIMARY", IsDefault: true},
+	}, configs)
+}
+
+func (s *DatabaseScopedConfigurationTestSuite) TestSetConfiguration() {
+	cases := map[string]struct {
+		name         string
+		value        string
+		forSecondary bool
+		expected     string
+	}{
+		"keyword":   {name: "legacy_cardinality_estimation", value: "ON", expected: "ALTER DATABASE SCOPED CONFIGURATION SET LEGACY_CARDINALITY_ESTIMATION = ON"},
+		"number":    {name: "MAXDOP", value: "8", expected: "ALTER DATABASE SCOPED CONFIGURATION SET MAXDOP = 8"},
+		"secondary": {name: "MAXDOP", value: "PRIMARY", forSecondary: true, expected: "ALTER DATABASE SCOPED CONFIGURATION FOR SECONDARY SET MAXDOP = PRIMARY"},
+		"string":    {name: "LEDGER_DIGEST_STORAGE_ENDPOINT", value: "https://example.com/'x", expected: "ALTER DATABASE SCOPED CONFIGURATION SET LEDGER_DIGEST_STORAGE_ENDPOINT = 'https://example.com/''x'"},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			expectExactExec(s.mock, tc.expected).WillReturnResult(sqlmock.NewResult(0, 1))
+
+			SetDatabaseScopedConfiguration(s.ctx, &s.dbMock, tc.name, tc.value, tc.forSecondary)
+		})
+	}
+}
+
+func (s *DatabaseScopedConfigurationTestSuite) TestSetInvalidName() {
+	SetDatabaseScopedConfiguration(s.ctx, &s.dbMock, "MAXDOP = 1; DROP TABLE x --", "1", false)
+
+	s.verifyError(errors.New("'MAXDOP = 1; DROP TABLE x --' is not a valid configuration name"))
+}
+
+func (s *DatabaseScopedConfigurationTestSuite) TestValuesEqual() {
+	s.True(ScopedConfigurationValuesEqual("ON", "1"))
+	s.True(ScopedConfigurationValuesEqual("off", "0"))
+	s.True(ScopedConfigurationValuesEqual("when_supported", "WHEN_SUPPORTED"))
+	s.False(ScopedConfigurationValuesEqual("ON", "0"))
+	s.False(ScopedConfigurationValuesEqual("4", "1"))
+}
@@ -0,0 +1,122 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+)
+
+type DatabaseScopedCredential interface {
+	GetId(context.Context) DatabaseScopedCredentialId
+	GetDb(context.Context) Database
+	Exists(context.Context) bool
+	GetSettings(context.Context) CredentialSettings
+	UpdateSettings(ctx context.Context, settings CredentialSettings)
+	Drop(context.Context)
+}
+
+func GetDatabaseScopedCredential(_ context.Context, db Database, id DatabaseScopedCredentialId) DatabaseScopedCredential {
+	return databaseScopedCredential{db: db, id: id}
+}
+
+func GetDatabaseScopedCredentialByName(ctx context.Context, db Database, name string) DatabaseScopedCredential {
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) DatabaseScopedCredential {
+		var id DatabaseScopedCredentialId
+
+		if err := conn.QueryRowContext(ctx, "SELECT [credential_id] FROM sys.database_scoped_credentials WHERE [name]=@p1", name).Scan(&id); err != nil {
+			utils.AddError(ctx, fmt.Sprintf("Failed to retrieve database scoped credential ID for name '%s'", name), err)
+			return nil
+		}
+
+		return GetDatabaseScopedCredential(ctx, db, id)
+	})
+}
+
+func CreateDatabaseScopedCredential(ctx context.Context, db Database, settings CredentialSettings) DatabaseScopedCredential {
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) DatabaseScopedCredential {
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("CREATE DATABASE SCOPED CREDENTIAL [%s] WITH %s", settings.Name, settings.toSqlOptions())); err != nil {
+			utils.AddError(ctx, "Failed to create database scoped credential", err)
+			return nil
+		}
+
+		return GetDatabaseScopedCredentialByName(ctx, db, settings.Name)
+	})
+}
+
+var _ DatabaseScopedCredential = databaseScopedCredential{}
+
+type databaseScopedCredential struct {
+	db Database
+	id DatabaseScopedCredentialId
+}
+
+func (c databaseScopedCredential) GetId(context.Context) DatabaseScopedCredentialId {
+	return c.id
+}
+
+func (c databaseScopedCredential) GetDb(context.Context) Database {
+	return c.db
+}
+
+func (c databaseScopedCredential) Exists(ctx context.Context) bool {
+	return WithConnection(ctx, c.db.connect, func(conn *sql.DB) bool {
+		switch _, err := c.getSettingsRaw(ctx, conn); err {
+		case sql.ErrNoRows:
+			return false
+		case nil:
+			return true
+		default:
+			utils.AddError(ctx, "Failed to check if database scoped credential exists", err)
+			return false
+		}
+	})
+}
+
+func (c databaseScopedCredential) GetSettings(ctx context.Context) CredentialSettings {
+	return WithConnection(ctx, c.db.connect, func(conn *sql.DB) CredentialSettings {
+		settings, err := c.getSettingsRaw(ctx, conn)
+		utils.AddError(ctx, "Failed to retrieve database scoped credential settings", err)
+		return settings
+	})
+}
+
+func (c databaseScopedCredential) UpdateSettings(ctx context.Context, settings CredentialSettings) {
+	WithConnection(ctx, c.db.connect, func(conn *sql.DB) any {
+		current, err := c.getSettingsRaw(ctx, conn)
+		if err != nil {
+			utils.AddError(ctx, "Failed to retrieve database scoped credential settings", err)
+			return nil
+		}
+
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("ALTER DATABASE SCOPED CREDENTIAL [%s] WITH %s", current.Name, settings.toAlterSqlOptions())); err != nil {
+			utils.AddError(ctx, "Failed to update database scoped credential", err)
+		}
+
+		return nil
+	})
+}
+
+func (c databaseScopedCredential) Drop(ctx context.Context) {
+	WithConnection(ctx, c.db.connect, func(conn *sql.DB) any {
+		current, err := c.getSettingsRaw(ctx, conn)
+		if err != nil {
+			utils.AddError(ctx, "Failed to retrieve database scoped credential settings", err)
+			return nil
+		}
+
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("DROP DATABASE SCOPED CREDENTIAL [%s]", current.Name)); err != nil {
+			utils.AddError(ctx, "Failed to drop database scoped credential", err)
+		}
+
+		return nil
+	})
+}
+
+func (c databaseScopedCredential) getSettingsRaw(ctx context.Context, conn *sql.DB) (CredentialSettings, error) {
+	var settings CredentialSettings
+	err := conn.
+		QueryRowContext(ctx, "SELECT [name], [credential_identity] FROM sys.database_scoped_credentials WHERE [credential_id]=@p1", c.id).
+		Scan(&settings.Name, &settings.Identity)
+	return settings, err
+}
@@ -0,0 +1,80 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestDatabaseScopedCredentialTestSuite(t *testing.T) {
+	s := &DatabaseScopedCredentialTestSuite{}
+	suite.Run(t, s)
+}
+
+type DatabaseScopedCredentialTestSuite struct {
+	SqlTestSuite
+	cred databaseScopedCredential
+}
+
+func (s *DatabaseScopedCredentialTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.cred = databaseScopedCredential{db: &s.dbMock, id: DatabaseScopedCredentialId(rand.Int())}
+}
+
+func (s *DatabaseScopedCredentialTestSuite) TestCreateDatabaseScopedCredential() {
+	expectExactExec(s.mock, "CREATE DATABASE SCOPED CREDENTIAL [test_cred] WITH IDENTITY = 'SHARED ACCESS SIGNATURE', SECRET = 'sv=2022&sig=abc'").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactQuery(s.mock, "SELECT [credential_id] FROM sys.database_scoped_credentials WHERE [name]=@p1").
+		WithArgs("test_cred").
+		WillReturnRows(newRows("credential_id").AddRow(65536))
+
+	cred := CreateDatabaseScopedCredential(s.ctx, &s.dbMock, CredentialSettings{
+		Name:     "test_cred",
+		Identity: "SHARED ACCESS SIGNATURE",
+		Secret:   "sv=2022&sig=abc",
+	})
+
+	s.Equal(DatabaseScopedCredentialId(65536), cred.GetId(s.ctx))
+}
+
+func (s *DatabaseScopedCredentialTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(newRows("name", "credential_identity").AddRow("test_cred", "test_identity"))
+
+	s.True(s.cred.Exists(s.ctx))
+}
+
+func (s *DatabaseScopedCredentialTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.cred.Exists(s.ctx))
+}
+
+func (s *DatabaseScopedCredentialTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(newRows("name", "credential_identity").AddRow("test_cred", "test_identity"))
+
+	settings := s.cred.GetSettings(s.ctx)
+
+	s.Equal(CredentialSettings{Name: "test_cred", Identity: "test_identity"}, settings)
+}
+
+func (s *DatabaseScopedCredentialTestSuite) TestUpdateSettings() {
+	s.expectSettingsQuery().WillReturnRows(newRows("name", "credential_identity").AddRow("test_cred", "old_identity"))
+	expectExactExec(s.mock, "ALTER DATABASE SCOPED CREDENTIAL [test_cred] WITH IDENTITY = 'new_identity', SECRET = 'rotated'").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.cred.UpdateSettings(s.ctx, CredentialSettings{Name: "test_cred", Identity: "new_identity", Secret: "rotated"})
+}
+
+func (s *DatabaseScopedCredentialTestSuite) TestDrop() {
+	s.expectSettingsQuery().WillReturnRows(newRows("name", "credential_identity").AddRow("test_cred", "test_identity"))
+	expectExactExec(s.mock, "DROP DATABASE SCOPED CREDENTIAL [test_cred]").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.cred.Drop(s.ctx)
+}
+
+func (s *DatabaseScopedCredentialTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, "SELECT [name], [credential_identity] FROM sys.database_scoped_credentials WHERE [credential_id]=@p1").
+		WithArgs(s.cred.id)
+}
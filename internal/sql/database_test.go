@@ -1,12 +1,14 @@
 package sql
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/suite"
 	"math/rand"
 	"testing"
+	"time"
 )
 
 func TestDatabaseTestSuite(t *testing.T) {
@@ -148,6 +150,72 @@ func (s *DatabaseTestSuite) TestSetCollation() {
 	s.db.SetCollation(s.ctx, newCollation)
 }
 
+func (s *DatabaseTestSuite) TestIsEncrypted() {
+	expectExactQuery(s.mock, "SELECT [is_encrypted] FROM sys.databases WHERE [database_id] = @p1").
+		WithArgs(s.db.id).
+		WillReturnRows(newRows("is_encrypted").AddRow(true))
+
+	s.True(s.db.IsEncrypted(s.ctx))
+}
+
+func (s *DatabaseTestSuite) TestSetEncryptionOn() {
+	encryptionStatePollInterval = time.Millisecond
+	s.expectDatabaseSettingQuery().WillReturnRows(newRows("name", "collation_name").AddRow("test_db", ""))
+	expectExactExec(s.mock, "ALTER DATABASE [test_db] SET ENCRYPTION ON").WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectEncryptionStateQuery().WillReturnRows(newRows("encryption_state").AddRow(2))
+	s.expectEncryptionStateQuery().WillReturnRows(newRows("encryption_state").AddRow(3))
+
+	s.db.SetEncryption(s.ctx, true)
+}
+
+func (s *DatabaseTestSuite) TestSetEncryptionOff() {
+	encryptionStatePollInterval = time.Millisecond
+	s.expectDatabaseSettingQuery().WillReturnRows(newRows("name", "collation_name").AddRow("test_db", ""))
+	expectExactExec(s.mock, "ALTER DATABASE [test_db] SET ENCRYPTION OFF").WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectEncryptionStateQuery().WillReturnRows(newRows("encryption_state").AddRow(5))
+	s.expectEncryptionStateQuery().WillReturnRows(newRows("encryption_state").AddRow(1))
+
+	s.db.SetEncryption(s.ctx, false)
+}
+
+func (s *DatabaseTestSuite) TestSetEncryptionTimeout() {
+	encryptionStatePollInterval = time.Hour
+	ctx, cancel := context.WithTimeout(s.ctx, 50*time.Millisecond)
+	defer cancel()
+	s.expectDatabaseSettingQuery().WillReturnRows(newRows("name", "collation_name").AddRow("test_db", ""))
+	expectExactExec(s.mock, "ALTER DATABASE [test_db] SET ENCRYPTION ON").WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectEncryptionStateQuery().WillReturnRows(newRows("encryption_state").AddRow(2))
+
+	s.db.SetEncryption(ctx, true)
+
+	s.verifyError(context.DeadlineExceeded)
+}
+
+func (s *DatabaseTestSuite) TestSetEncryptionDefaultTimeout() {
+	encryptionStatePollInterval = time.Hour
+	encryptionStateTimeout = 20 * time.Millisecond
+	defer func() { encryptionStateTimeout = time.Hour }()
+	s.expectDatabaseSettingQuery().WillReturnRows(newRows("name", "collation_name").AddRow("test_db", ""))
+	expectExactExec(s.mock, "ALTER DATABASE [test_db] SET ENCRYPTION ON").WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectEncryptionStateQuery().WillReturnRows(newRows("encryption_state").AddRow(2))
+
+	s.db.SetEncryption(s.ctx, true)
+
+	s.verifyError(context.DeadlineExceeded)
+}
+
+func (s *DatabaseTestSuite) TestSetEncryptionScanSuspended() {
+	encryptionStatePollInterval = time.Millisecond
+	s.expectDatabaseSettingQuery().WillReturnRows(newRows("name", "collation_name").AddRow("test_db", ""))
+	expectExactExec(s.mock, "ALTER DATABASE [test_db] SET ENCRYPTION ON").WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectEncryptionStateQuery().WillReturnRows(newRows("database_id", "encryption_state", "encryption_scan_state").AddRow(s.db.id, 2, 1))
+	s.expectEncryptionStateQuery().WillReturnRows(newRows("database_id", "encryption_state", "encryption_scan_state").AddRow(s.db.id, 2, 2))
+
+	s.db.SetEncryption(s.ctx, true)
+
+	s.verifyError(errors.New("encryption scan is suspended or aborted (encryption_scan_state = 2), use ALTER DATABASE ... SET ENCRYPTION RESUME to continue it"))
+}
+
 func (s *DatabaseTestSuite) TestDrop() {
 	const dbName = "test_db_name"
 	s.expectDatabaseSettingQuery().WithArgs(s.db.id).WillReturnRows(newRows("name", "collation_name").AddRow(dbName, ""))
@@ -252,6 +320,10 @@ func (s *DatabaseTestSuite) expectDatabaseSettingQuery() *sqlmock.ExpectedQuery
 	return expectExactQuery(s.mock, "SELECT [name], collation_name FROM sys.databases WHERE [database_id] = @p1").WithArgs(s.db.id)
 }
 
+func (s *DatabaseTestSuite) expectEncryptionStateQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, "SELECT * FROM sys.dm_database_encryption_keys WHERE [database_id] = @p1").WithArgs(s.db.id)
+}
+
 func (s *DatabaseTestSuite) expectDatabaseIdQuery() *sqlmock.ExpectedQuery {
 	return expectExactQuery(s.mock, "SELECT database_id FROM sys.databases WHERE [name] = @p1")
 }
@@ -36,6 +36,14 @@ func (m *dbMock) SetCollation(ctx context.Context, collation string) {
 	m.Called(ctx, collation)
 }
 
+func (m *dbMock) IsEncrypted(ctx context.Context) bool {
+	return m.Called(ctx).Bool(0)
+}
+
+func (m *dbMock) SetEncryption(ctx context.Context, enabled bool) {
+	m.Called(ctx, enabled)
+}
+
 func (m *dbMock) Drop(ctx context.Context) {
 	m.Called(ctx)
 }
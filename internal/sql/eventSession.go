@@ -0,0 +1,487 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type EventSessionEvent struct {
+	Name      string
+	Predicate string
+	Actions   []string
+}
+
+func (e EventSessionEvent) toSql() string {
+	var options []string
+
+	if len(e.Actions) > 0 {
+		options = append(options, fmt.Sprintf("ACTION (%s)", strings.Join(e.Actions, ", ")))
+	}
+
+	if e.Predicate != "" {
+		options = append(options, fmt.Sprintf("WHERE (%s)", e.Predicate))
+	}
+
+	if len(options) == 0 {
+		return "EVENT " + e.Name
+	}
+
+	return fmt.Sprintf("EVENT %s (%s)", e.Name, strings.Join(options, " "))
+}
+
+// IsEquivalent ignores differences in quoting, casing and parentheses of predicates, as they are normalized by SQL Server.
+func (e EventSessionEvent) IsEquivalent(other EventSessionEvent) bool {
+	normalize := func(s string) string {
+		return strings.ToUpper(strings.NewReplacer("[", "", "]", "", "(", "", ")", "", " ", "", "N'", "'").Replace(s))
+	}
+
+	return strings.EqualFold(e.Name, other.Name) && normalize(e.Predicate) == normalize(other.Predicate) && equalFoldSets(e.Actions, other.Actions)
+}
+
+type EventSessionTarget struct {
+	Name    string
+	Options map[string]string
+}
+
+func (t EventSessionTarget) toSql() string {
+	if len(t.Options) == 0 {
+		return "TARGET " + t.Name
+	}
+
+	var options []string
+	for name, value := range t.Options {
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			value = quoteString(value)
+		}
+		options = append(options, fmt.Sprintf("%s = %s", name, value))
+	}
+
+	sort.Strings(options)
+	return fmt.Sprintf("TARGET %s (SET %s)", t.Name, strings.Join(options, ", "))
+}
+
+func (t EventSessionTarget) IsEquivalent(other EventSessionTarget) bool {
+	if !strings.EqualFold(t.Name, other.Name) || len(t.Options) != len(other.Options) {
+		return false
+	}
+
+	for name, value := range t.Options {
+		if otherValue, ok := other.Options[name]; !ok || otherValue != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+type EventSessionSettings struct {
+	Name         string
+	Events       []EventSessionEvent
+	Targets      []EventSessionTarget
+	StartupState bool
+	Running      bool
+}
+
+type EventSession interface {
+	GetId(context.Context) EventSessionId
+	Exists(context.Context) bool
+	GetSettings(context.Context) EventSessionSettings
+	UpdateSettings(context.Context, EventSessionSettings)
+	Drop(context.Context)
+}
+
+func GetServerEventSession(_ context.Context, conn Connection, id EventSessionId) EventSession {
+	return eventSession{connect: conn.getSqlConnection, scope: serverEventSessionScope, id: id}
+}
+
+func GetDatabaseEventSession(_ context.Context, db Database, id EventSessionId) EventSession {
+	return eventSession{connect: db.connect, scope: databaseEventSessionScope, id: id}
+}
+
+func CreateServerEventSession(ctx context.Context, conn Connection, settings EventSessionSettings) EventSession {
+	return createEventSession(ctx, eventSession{connect: conn.getSqlConnection, scope: serverEventSessionScope}, settings)
+}
+
+func CreateDatabaseEventSession(ctx context.Context, db Database, settings EventSessionSettings) EventSession {
+	return createEventSession(ctx, eventSession{connect: db.connect, scope: databaseEventSessionScope}, settings)
+}
+
+func createEventSession(ctx context.Context, session eventSession, settings EventSessionSettings) EventSession {
+	var definitions []string
+
+	for _, event := range settings.Events {
+		definitions = append(definitions, "ADD "+event.toSql())
+	}
+
+	var targets []string
+	for _, target := range settings.Targets {
+		targets = append(targets, "ADD "+target.toSql())
+	}
+
+	stat := fmt.Sprintf("CREATE EVENT SESSION [%s] ON %s %s", settings.Name, session.scope.target, strings.Join(definitions, ", "))
+	if len(targets) > 0 {
+		stat += " " + strings.Join(targets, ", ")
+	}
+	stat += fmt.Sprintf(" WITH (STARTUP_STATE = %s)", onOff(settings.StartupState))
+
+	utils.StopOnError(ctx).
+		Then(func() { session.exec(ctx, stat, "Failed to create event session") }).
+		Then(func() {
+			WithConnection(ctx, session.connect, func(conn *sql.DB) any {
+				query := fmt.Sprintf("SELECT [event_session_id] FROM sys.%s_event_sessions WHERE [name]=@p1", session.scope.catalogPrefix)
+				err := conn.QueryRowContext(ctx, query, settings.Name).Scan(&session.id)
+				utils.AddError(ctx, "Failed to retrieve event session ID", err)
+				return nil
+			})
+		}).
+		Then(func() {
+			if settings.Running {
+				session.setState(ctx, settings.Name, true)
+			}
+		})
+
+	if utils.HasError(ctx) {
+		return nil
+	}
+
+	return session
+}
+
+type eventSessionScope struct {
+	target        string
+	catalogPrefix string
+	runtimeView   string
+}
+
+var (
+	serverEventSessionScope   = eventSessionScope{target: "SERVER", catalogPrefix: "server", runtimeView: "sys.dm_xe_sessions"}
+	databaseEventSessionScope = eventSessionScope{target: "DATABASE", catalogPrefix: "database", runtimeView: "sys.dm_xe_database_sessions"}
+)
+
+var _ EventSession = eventSession{}
+
+type eventSession struct {
+	connect func(context.Context) *sql.DB
+	scope   eventSessionScope
+	id      EventSessionId
+}
+
+func (s eventSession) GetId(context.Context) EventSessionId {
+	return s.id
+}
+
+func (s eventSession) Exists(ctx context.Context) bool {
+	return WithConnection(ctx, s.connect, func(conn *sql.DB) bool {
+		switch _, err := s.getSettingsRaw(ctx, conn); err {
+		case sql.ErrNoRows:
+			return false
+		case nil:
+			return true
+		default:
+			utils.AddError(ctx, "Failed to check if event session exists", err)
+			return false
+		}
+	})
+}
+
+func (s eventSession) GetSettings(ctx context.Context) EventSessionSettings {
+	return WithConnection(ctx, s.connect, func(conn *sql.DB) EventSessionSettings {
+		settings, err := s.getSettingsRaw(ctx, conn)
+		if err != nil {
+			utils.AddError(ctx, "Failed to retrieve event session settings", err)
+			return settings
+		}
+
+		utils.StopOnError(ctx).
+			Then(func() { settings.Events = s.getEvents(ctx, conn) }).
+			Then(func() { settings.Targets = s.getTargets(ctx, conn) })
+
+		return settings
+	})
+}
+
+// UpdateSettings stops running session for the time of changing its events or targets and starts it again afterwards, if requested.
+func (s eventSession) UpdateSettings(ctx context.Context, settings EventSessionSettings) {
+	var current EventSessionSettings
+
+	utils.StopOnError(ctx).
+		Then(func() { current = s.GetSettings(ctx) })
+
+	if utils.HasError(ctx) {
+		return
+	}
+
+	var dropEvents, addEvents, dropTargets, addTargets []string
+
+	for _, event := range current.Events {
+		if !containsEquivalent(settings.Events, event) {
+			dropEvents = append(dropEvents, "DROP EVENT "+event.Name)
+		}
+	}
+
+	for _, event := range settings.Events {
+		if !containsEquivalent(current.Events, event) {
+			addEvents = append(addEvents, "ADD "+event.toSql())
+		}
+	}
+
+	for _, target := range current.Targets {
+		if !containsEquivalent(settings.Targets, target) {
+			dropTargets = append(dropTargets, "DROP TARGET "+target.Name)
+		}
+	}
+
+	for _, target := range settings.Targets {
+		if !containsEquivalent(current.Targets, target) {
+			addTargets = append(addTargets, "ADD "+target.toSql())
+		}
+	}
+
+	running := current.Running
+	alter := func(clauses []string) {
+		if len(clauses) == 0 || utils.HasError(ctx) {
+			return
+		}
+
+		if running {
+			s.setState(ctx, current.Name, false)
+			running = false
+		}
+
+		s.exec(ctx, fmt.Sprintf("ALTER EVENT SESSION [%s] ON %s %s", current.Name, s.scope.target, strings.Join(clauses, ", ")), "Failed to update event session")
+	}
+
+	alter(dropEvents)
+	alter(addEvents)
+	alter(dropTargets)
+	alter(addTargets)
+
+	utils.StopOnError(ctx).
+		Then(func() {
+			if current.StartupState != settings.StartupState {
+				stat := fmt.Sprintf("ALTER EVENT SESSION [%s] ON %s WITH (STARTUP_STATE = %s)", current.Name, s.scope.target, onOff(settings.StartupState))
+				s.exec(ctx, stat, "Failed to update event session startup state")
+			}
+		}).
+		Then(func() {
+			if running != settings.Running {
+				s.setState(ctx, current.Name, settings.Running)
+			}
+		})
+}
+
+func (s eventSession) Drop(ctx context.Context) {
+	var settings EventSessionSettings
+
+	utils.StopOnError(ctx).
+		Then(func() {
+			WithConnection(ctx, s.connect, func(conn *sql.DB) any {
+				var err error
+				settings, err = s.getSettingsRaw(ctx, conn)
+				utils.AddError(ctx, "Failed to retrieve event session settings", err)
+				return nil
+			})
+		}).
+		Then(func() {
+			s.exec(ctx, fmt.Sprintf("DROP EVENT SESSION [%s] ON %s", settings.Name, s.scope.target), "Failed to drop event session")
+		})
+}
+
+func (s eventSession) setState(ctx context.Context, name string, running bool) {
+	state := "STOP"
+	if running {
+		state = "START"
+	}
+
+	s.exec(ctx, fmt.Sprintf("ALTER EVENT SESSION [%s] ON %s STATE = %s", name, s.scope.target, state), "Failed to change event session state")
+}
+
+func (s eventSession) exec(ctx context.Context, stat string, errorSummary string) {
+	WithConnection(ctx, s.connect, func(conn *sql.DB) any {
+		_, err := conn.ExecContext(ctx, stat)
+		utils.AddError(ctx, errorSummary, err)
+		return nil
+	})
+}
+
+func (s eventSession) getSettingsRaw(ctx context.Context, conn *sql.DB) (EventSessionSettings, error) {
+	var settings EventSessionSettings
+	err := conn.
+		QueryRowContext(ctx, fmt.Sprintf(`
+SELECT
+    s.[name],
+    s.[startup_state],
+    CAST(CASE WHEN EXISTS (SELECT 1 FROM %s x WHERE x.[name] = s.[name]) THEN 1 ELSE 0 END AS BIT)
+FROM sys.%s_event_sessions s
+WHERE s.[event_session_id]=@p1`, s.scope.runtimeView, s.scope.catalogPrefix), s.id).
+		Scan(&settings.Name, &settings.StartupState, &settings.Running)
+	return settings, err
+}
+
+func (s eventSession) getEvents(ctx context.Context, conn *sql.DB) []EventSessionEvent {
+	const errorSummary = "Failed to retrieve event session events"
+	var (
+		events  []EventSessionEvent
+		indexes = map[int]int{}
+	)
+
+	rows, err := conn.QueryContext(ctx, fmt.Sprintf(`
+SELECT [event_id], [package] + '.' + [name], ISNULL(CAST([predicate] AS NVARCHAR(MAX)), '')
+FROM sys.%s_event_session_events
+WHERE [event_session_id]=@p1`, s.scope.catalogPrefix), s.id)
+	if err != nil {
+		utils.AddError(ctx, errorSummary, err)
+		return events
+	}
+
+	for rows.Next() {
+		var (
+			eventId int
+			event   EventSessionEvent
+		)
+
+		if err := rows.Scan(&eventId, &event.Name, &event.Predicate); err != nil {
+			utils.AddError(ctx, errorSummary, err)
+			return events
+		}
+
+		indexes[eventId] = len(events)
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.AddError(ctx, errorSummary, err)
+		return events
+	}
+
+	rows, err = conn.QueryContext(ctx, fmt.Sprintf(`
+SELECT [event_id], [package] + '.' + [name]
+FROM sys.%s_event_session_actions
+WHERE [event_session_id]=@p1`, s.scope.catalogPrefix), s.id)
+	if err != nil {
+		utils.AddError(ctx, errorSummary, err)
+		return events
+	}
+
+	for rows.Next() {
+		var (
+			eventId int
+			action  string
+		)
+
+		if err := rows.Scan(&eventId, &action); err != nil {
+			utils.AddError(ctx, errorSummary, err)
+			return events
+		}
+
+		if idx, ok := indexes[eventId]; ok {
+			events[idx].Actions = append(events[idx].Actions, action)
+		}
+	}
+
+	utils.AddError(ctx, errorSummary, rows.Err())
+	return events
+}
+
+func (s eventSession) getTargets(ctx context.Context, conn *sql.DB) []EventSessionTarget {
+	const errorSummary = "Failed to retrieve event session targets"
+	var (
+		targets []EventSessionTarget
+		indexes = map[int]int{}
+	)
+
+	rows, err := conn.QueryContext(ctx, fmt.Sprintf(`
+SELECT [target_id], [package] + '.' + [name]
+FROM sys.%s_event_session_targets
+WHERE [event_session_id]=@p1`, s.scope.catalogPrefix), s.id)
+	if err != nil {
+		utils.AddError(ctx, errorSummary, err)
+		return targets
+	}
+
+	for rows.Next() {
+		var (
+			targetId int
+			target   EventSessionTarget
+		)
+
+		if err := rows.Scan(&targetId, &target.Name); err != nil {
+			utils.AddError(ctx, errorSummary, err)
+			return targets
+		}
+
+		indexes[targetId] = len(targets)
+		targets = append(targets, target)
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.AddError(ctx, errorSummary, err)
+		return targets
+	}
+
+	rows, err = conn.QueryContext(ctx, fmt.Sprintf(`
+SELECT f.[object_id], f.[name], CAST(f.[value] AS NVARCHAR(MAX))
+FROM sys.%[1]s_event_session_fields f
+INNER JOIN sys.%[1]s_event_session_targets t ON t.[event_session_id] = f.[event_session_id] AND t.[target_id] = f.[object_id]
+WHERE f.[event_session_id]=@p1`, s.scope.catalogPrefix), s.id)
+	if err != nil {
+		utils.AddError(ctx, errorSummary, err)
+		return targets
+	}
+
+	for rows.Next() {
+		var (
+			targetId    int
+			name, value string
+		)
+
+		if err := rows.Scan(&targetId, &name, &value); err != nil {
+			utils.AddError(ctx, errorSummary, err)
+			return targets
+		}
+
+		if idx, ok := indexes[targetId]; ok {
+			if targets[idx].Options == nil {
+				targets[idx].Options = map[string]string{}
+			}
+			targets[idx].Options[name] = value
+		}
+	}
+
+	utils.AddError(ctx, errorSummary, rows.Err())
+	return targets
+}
+
+func containsEquivalent[T interface{ IsEquivalent(T) bool }](items []T, item T) bool {
+	for _, i := range items {
+		if i.IsEquivalent(item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func equalFoldSets(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	values := map[string]bool{}
+	for _, v := range a {
+		values[strings.ToUpper(v)] = true
+	}
+
+	for _, v := range b {
+		if !values[strings.ToUpper(v)] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,183 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestEventSessionTestSuite(t *testing.T) {
+	s := &EventSessionTestSuite{}
+	suite.Run(t, s)
+}
+
+type EventSessionTestSuite struct {
+	SqlTestSuite
+	session eventSession
+}
+
+func (s *EventSessionTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.session = GetServerEventSession(s.ctx, s.connMock, EventSessionId(rand.Int())).(eventSession)
+}
+
+func (s *EventSessionTestSuite) TestCreateServerSession() {
+	expectExactExec(s.mock, "CREATE EVENT SESSION [test_session] ON SERVER ADD EVENT sqlserver.rpc_completed (ACTION (sqlserver.sql_text, sqlserver.username) WHERE ([duration] > 1000)), ADD EVENT sqlserver.error_reported ADD TARGET package0.event_file (SET filename = 'test.xel', max_file_size = 10) WITH (STARTUP_STATE = ON)").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactQuery(s.mock, "SELECT [event_session_id] FROM sys.server_event_sessions WHERE [name]=@p1").
+		WithArgs("test_session").
+		WillReturnRows(newRows("event_session_id").AddRow(65536))
+	expectExactExec(s.mock, "ALTER EVENT SESSION [test_session] ON SERVER STATE = START").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	session := CreateServerEventSession(s.ctx, s.connMock, EventSessionSettings{
+		Name: "test_session",
+		Events: []EventSessionEvent{
+			{Name: "sqlserver.rpc_completed", Predicate: "[duration] > 1000", Actions: []string{"sqlserver.sql_text", "sqlserver.username"}},
+			{Name: "sqlserver.error_reported"},
+		},
+		Targets: []EventSessionTarget{
+			{Name: "package0.event_file", Options: map[string]string{"max_file_size": "10", "filename": "test.xel"}},
+		},
+		StartupState: true,
+		Running:      true,
+	})
+
+	s.Equal(EventSessionId(65536), session.GetId(s.ctx))
+}
+
+func (s *EventSessionTestSuite) TestCreateDatabaseSession() {
+	expectExactExec(s.mock, "CREATE EVENT SESSION [test_session] ON DATABASE ADD EVENT sqlserver.error_reported ADD TARGET package0.ring_buffer WITH (STARTUP_STATE = OFF)").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactQuery(s.mock, "SELECT [event_session_id] FROM sys.database_event_sessions WHERE [name]=@p1").
+		WithArgs("test_session").
+		WillReturnRows(newRows("event_session_id").AddRow(65537))
+
+	session := CreateDatabaseEventSession(s.ctx, &s.dbMock, EventSessionSettings{
+		Name:    "test_session",
+		Events:  []EventSessionEvent{{Name: "sqlserver.error_reported"}},
+		Targets: []EventSessionTarget{{Name: "package0.ring_buffer"}},
+	})
+
+	s.Equal(EventSessionId(65537), session.GetId(s.ctx))
+}
+
+func (s *EventSessionTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(newRows("name", "startup_state", "running").AddRow("test_session", false, true))
+
+	s.True(s.session.Exists(s.ctx))
+}
+
+func (s *EventSessionTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.session.Exists(s.ctx))
+}
+
+func (s *EventSessionTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(newRows("name", "startup_state", "running").AddRow("test_session", true, false))
+	s.expectDefinitionQueries()
+
+	s.Equal(EventSessionSettings{
+		Name: "test_session",
+		Events: []EventSessionEvent{
+			{Name: "sqlserver.rpc_completed", Predicate: "([package0].[greater_than_uint64]([duration],(1000)))", Actions: []string{"sqlserver.sql_text"}},
+		},
+		Targets: []EventSessionTarget{
+			{Name: "package0.event_file", Options: map[string]string{"filename": "test.xel"}},
+		},
+		StartupState: true,
+		Running:      false,
+	}, s.session.GetSettings(s.ctx))
+}
+
+func (s *EventSessionTestSuite) TestUpdateSettings() {
+	s.expectSettingsQuery().WillReturnRows(newRows("name", "startup_state", "running").AddRow("test_session", true, true))
+	s.expectDefinitionQueries()
+	expectExactExec(s.mock, "ALTER EVENT SESSION [test_session] ON SERVER STATE = STOP").WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactExec(s.mock, "ALTER EVENT SESSION [test_session] ON SERVER ADD EVENT sqlserver.error_reported").WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactExec(s.mock, "ALTER EVENT SESSION [test_session] ON SERVER DROP TARGET package0.event_file").WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactExec(s.mock, "ALTER EVENT SESSION [test_session] ON SERVER ADD TARGET package0.ring_buffer (SET max_memory = 4096)").WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactExec(s.mock, "ALTER EVENT SESSION [test_session] ON SERVER WITH (STARTUP_STATE = OFF)").WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactExec(s.mock, "ALTER EVENT SESSION [test_session] ON SERVER STATE = START").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.session.UpdateSettings(s.ctx, EventSessionSettings{
+		Name: "test_session",
+		Events: []EventSessionEvent{
+			{Name: "SQLSERVER.rpc_completed", Predicate: "[package0].[greater_than_uint64]([duration], 1000)", Actions: []string{"sqlserver.SQL_TEXT"}},
+			{Name: "sqlserver.error_reported"},
+		},
+		Targets: []EventSessionTarget{
+			{Name: "package0.ring_buffer", Options: map[string]string{"max_memory": "4096"}},
+		},
+		StartupState: false,
+		Running:      true,
+	})
+}
+
+func (s *EventSessionTestSuite) TestUpdateStateOnly() {
+	s.expectSettingsQuery().WillReturnRows(newRows("name", "startup_state", "running").AddRow("test_session", true, true))
+	s.expectDefinitionQueries()
+	expectExactExec(s.mock, "ALTER EVENT SESSION [test_session] ON SERVER STATE = STOP").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.session.UpdateSettings(s.ctx, EventSessionSettings{
+		Name: "test_session",
+		Events: []EventSessionEvent{
+			{Name: "sqlserver.rpc_completed", Predicate: "([package0].[greater_than_uint64]([duration],(1000)))", Actions: []string{"sqlserver.sql_text"}},
+		},
+		Targets: []EventSessionTarget{
+			{Name: "package0.event_file", Options: map[string]string{"filename": "test.xel"}},
+		},
+		StartupState: true,
+		Running:      false,
+	})
+}
+
+func (s *EventSessionTestSuite) TestDrop() {
+	s.expectSettingsQuery().WillReturnRows(newRows("name", "startup_state", "running").AddRow("test_session", false, true))
+	expectExactExec(s.mock, "DROP EVENT SESSION [test_session] ON SERVER").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.session.Drop(s.ctx)
+}
+
+func (s *EventSessionTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, `
+SELECT
+    s.[name],
+    s.[startup_state],
+    CAST(CASE WHEN EXISTS (SELECT 1 FROM sys.dm_xe_sessions x WHERE x.[name] = s.[name]) THEN 1 ELSE 0 END AS BIT)
+FROM sys.server_event_sessions s
+WHERE s.[event_session_id]=@p1`).WithArgs(s.session.id)
+}
+
+func (s *EventSessionTestSuite) expectDefinitionQueries() {
+	expectExactQuery(s.mock, `
+SELECT [event_id], [package] + '.' + [name], ISNULL(CAST([predicate] AS NVARCHAR(MAX)), '')
+FROM sys.server_event_session_events
+WHERE [event_session_id]=@p1`).
+		WithArgs(s.session.id).
+		WillReturnRows(newRows("event_id", "name", "predicate").AddRow(1, "sqlserver.rpc_completed", "([package0].[greater_than_uint64]([duration],(1000)))"))
+
+	expectExactQuery(s.mock, `
+SELECT [event_id], [package] + '.' + [name]
+FROM sys.server_event_session_actions
+WHERE [event_session_id]=@p1`).
+		WithArgs(s.session.id).
+		WillReturnRows(newRows("event_id", "name").AddRow(1, "sqlserver.sql_text"))
+
+	expectExactQuery(s.mock, `
+SELECT [target_id], [package] + '.' + [name]
+FROM sys.server_event_session_targets
+WHERE [event_session_id]=@p1`).
+		WithArgs(s.session.id).
+		WillReturnRows(newRows("target_id", "name").AddRow(2, "package0.event_file"))
+
+	expectExactQuery(s.mock, `
+SELECT f.[object_id], f.[name], CAST(f.[value] AS NVARCHAR(MAX))
+FROM sys.server_event_session_fields f
+INNER JOIN sys.server_event_session_targets t ON t.[event_session_id] = f.[event_session_id] AND t.[target_id] = f.[object_id]
+WHERE f.[event_session_id]=@p1`).
+		WithArgs(s.session.id).
+		WillReturnRows(newRows("object_id", "name", "value").AddRow(2, "filename", "test.xel"))
+}
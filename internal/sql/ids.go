@@ -16,6 +16,46 @@ type AADObjectId string
 
 type SchemaId int
 
+type CredentialId int
+
+type DatabaseScopedCredentialId int
+
+type CertificateId int
+
+type AsymmetricKeyId int
+
+type ColumnMasterKeyId int
+
+type ColumnEncryptionKeyId int
+
+type SecurityPolicyId int
+
+type TableId int
+
+type ColumnId int
+
+type ServerAuditId int
+
+type ServerAuditSpecificationId int
+
+type DatabaseAuditSpecificationId int
+
+type EventSessionId int
+
+type AgentJobId string
+
+type AgentScheduleId int
+
+type AgentOperatorId int
+
+type AgentAlertId int
+
+type LinkedServerId int
+
+type ResourcePoolId int
+
+type WorkloadGroupId int
+
 type DatabasePrincipalId interface {
 	UserId | DatabaseRoleId | GenericDatabasePrincipalId
 }
@@ -29,11 +69,11 @@ type SqlLoginId GenericServerPrincipalId
 const EmptyServerPrincipalId GenericServerPrincipalId = -1
 
 type NumericObjectId interface {
-	DatabaseId | DatabasePrincipalId | SchemaId | GenericServerPrincipalId
+	DatabaseId | DatabasePrincipalId | SchemaId | GenericServerPrincipalId | CredentialId | DatabaseScopedCredentialId | CertificateId | AsymmetricKeyId | ColumnMasterKeyId | ColumnEncryptionKeyId | SecurityPolicyId | TableId | ColumnId | ServerAuditId | ServerAuditSpecificationId | DatabaseAuditSpecificationId | EventSessionId | AgentScheduleId | AgentOperatorId | AgentAlertId | LinkedServerId | ResourcePoolId | WorkloadGroupId
 }
 
 type StringObjectId interface {
-	LoginId
+	LoginId | AgentJobId
 }
 
 type ObjectId interface {
@@ -0,0 +1,63 @@
+package sql
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"net/url"
+)
+
+const defaultKrb5ConfigPath = "/etc/krb5.conf"
+
+// ConnectionAuthKerberos uses Kerberos integrated authentication. It is supported only on non-Windows platforms,
+// where the driver's krb5 authenticator is available.
+type ConnectionAuthKerberos struct {
+	// Krb5ConfigPath defaults to /etc/krb5.conf
+	Krb5ConfigPath string
+	// Either KeytabPath, along with Username and Realm, or CredentialCachePath must be set
+	KeytabPath          string
+	CredentialCachePath string
+	Username            string
+	Realm               string
+	// ServerSPN overrides SPN generated from host and port, e.g. MSSQLSvc/sql.example.com:1433@EXAMPLE.COM
+	ServerSPN string
+}
+
+func (auth ConnectionAuthKerberos) configure(_ context.Context, u *url.URL) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	if auth.KeytabPath == "" && auth.CredentialCachePath == "" {
+		diags.AddError("Invalid Kerberos auth config", "Either keytab_path or credential_cache_path must be set")
+	}
+
+	if auth.KeytabPath != "" && (auth.Username == "" || auth.Realm == "") {
+		diags.AddError("Invalid Kerberos auth config", "username and realm are required when using keytab")
+	}
+
+	q := u.Query()
+	q.Set("authenticator", "krb5")
+
+	if auth.Krb5ConfigPath != "" {
+		q.Set("krb5-configfile", auth.Krb5ConfigPath)
+	} else {
+		q.Set("krb5-configfile", defaultKrb5ConfigPath)
+	}
+
+	setIfNotEmpty := func(name string, value string) {
+		if value != "" {
+			q.Set(name, value)
+		}
+	}
+
+	setIfNotEmpty("krb5-keytabfile", auth.KeytabPath)
+	setIfNotEmpty("krb5-credcachefile", auth.CredentialCachePath)
+	setIfNotEmpty("user id", auth.Username)
+	setIfNotEmpty("krb5-realm", auth.Realm)
+	setIfNotEmpty("ServerSPN", auth.ServerSPN)
+
+	u.RawQuery = q.Encode()
+	return diags
+}
+
+func (ConnectionAuthKerberos) getDriverName() string {
+	return "sqlserver"
+}
@@ -0,0 +1,6 @@
+//go:build !windows
+
+package sql
+
+// Registers krb5 integrated authenticator used by ConnectionAuthKerberos
+import _ "github.com/microsoft/go-mssqldb/integratedauth/krb5"
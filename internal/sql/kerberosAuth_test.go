@@ -0,0 +1,88 @@
+package sql
+
+import (
+	"context"
+	"github.com/microsoft/go-mssqldb/msdsn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/url"
+	"testing"
+)
+
+// Parameter names are read by github.com/microsoft/go-mssqldb/integratedauth/krb5
+func TestKerberosConfigureParsedByDriver(t *testing.T) {
+	u := url.URL{Scheme: "sqlserver", Host: "sql.test.local"}
+	auth := ConnectionAuthKerberos{
+		Krb5ConfigPath:      "/test/krb5.conf",
+		KeytabPath:          "/test/user.keytab",
+		CredentialCachePath: "/tmp/krb5cc_1000",
+		Username:            "test_user",
+		Realm:               "TEST.LOCAL",
+		ServerSPN:           "MSSQLSvc/sql.test.local:1433@TEST.LOCAL",
+	}
+
+	diags := auth.configure(context.Background(), &u)
+	require.False(t, diags.HasError(), "diagnostics")
+
+	cfg, err := msdsn.Parse(u.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, "krb5", cfg.Parameters["authenticator"], "authenticator")
+	assert.Equal(t, "/test/krb5.conf", cfg.Parameters["krb5-configfile"], "krb5-configfile")
+	assert.Equal(t, "/test/user.keytab", cfg.Parameters["krb5-keytabfile"], "krb5-keytabfile")
+	assert.Equal(t, "/tmp/krb5cc_1000", cfg.Parameters["krb5-credcachefile"], "krb5-credcachefile")
+	assert.Equal(t, "TEST.LOCAL", cfg.Parameters["krb5-realm"], "krb5-realm")
+	assert.Equal(t, "test_user", cfg.User, "user")
+	assert.Equal(t, "MSSQLSvc/sql.test.local:1433@TEST.LOCAL", cfg.ServerSPN, "ServerSPN")
+}
+
+func TestKerberosConfigureKeytab(t *testing.T) {
+	u := url.URL{}
+	auth := ConnectionAuthKerberos{
+		Krb5ConfigPath: "/test/krb5.conf",
+		KeytabPath:     "/test/user.keytab",
+		Username:       "test_user",
+		Realm:          "TEST.LOCAL",
+		ServerSPN:      "MSSQLSvc/sql.test.local:1433@TEST.LOCAL",
+	}
+
+	diags := auth.configure(context.Background(), &u)
+
+	assert.False(t, diags.HasError(), "diagnostics")
+	assert.Equal(t, "krb5", u.Query().Get("authenticator"), "authenticator")
+	assert.Equal(t, "/test/krb5.conf", u.Query().Get("krb5-configfile"), "krb5-configfile")
+	assert.Equal(t, "/test/user.keytab", u.Query().Get("krb5-keytabfile"), "krb5-keytabfile")
+	assert.Equal(t, "test_user", u.Query().Get("user id"), "user id")
+	assert.Equal(t, "TEST.LOCAL", u.Query().Get("krb5-realm"), "krb5-realm")
+	assert.Equal(t, "MSSQLSvc/sql.test.local:1433@TEST.LOCAL", u.Query().Get("ServerSPN"), "ServerSPN")
+	assert.False(t, u.Query().Has("krb5-credcachefile"), "krb5-credcachefile")
+}
+
+func TestKerberosConfigureCredentialCache(t *testing.T) {
+	u := url.URL{}
+	auth := ConnectionAuthKerberos{CredentialCachePath: "/tmp/krb5cc_1000"}
+
+	diags := auth.configure(context.Background(), &u)
+
+	assert.False(t, diags.HasError(), "diagnostics")
+	assert.Equal(t, "/etc/krb5.conf", u.Query().Get("krb5-configfile"), "default krb5-configfile")
+	assert.Equal(t, "/tmp/krb5cc_1000", u.Query().Get("krb5-credcachefile"), "krb5-credcachefile")
+	assert.False(t, u.Query().Has("ServerSPN"), "ServerSPN")
+}
+
+func TestKerberosConfigureInvalid(t *testing.T) {
+	cases := map[string]ConnectionAuthKerberos{
+		"no credentials":       {},
+		"keytab without realm": {KeytabPath: "/test/user.keytab", Username: "test_user"},
+		"keytab without user":  {KeytabPath: "/test/user.keytab", Realm: "TEST.LOCAL"},
+	}
+
+	for name, auth := range cases {
+		auth := auth
+		t.Run(name, func(t *testing.T) {
+			diags := auth.configure(context.Background(), &url.URL{})
+
+			assert.True(t, diags.HasError(), "diagnostics")
+		})
+	}
+}
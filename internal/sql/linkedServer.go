@@ -0,0 +1,143 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+)
+
+type LinkedServerSettings struct {
+	Name                string
+	Product             string
+	Provider            string
+	DataSource          string
+	Catalog             string
+	RpcEnabled          bool
+	RpcOutEnabled       bool
+	DataAccessEnabled   bool
+	CollationCompatible bool
+	UseRemoteCollation  bool
+	CollationName       string
+}
+
+type LinkedServer interface {
+	GetId(context.Context) LinkedServerId
+	Exists(context.Context) bool
+	GetSettings(context.Context) LinkedServerSettings
+	UpdateSettings(context.Context, LinkedServerSettings)
+	Drop(context.Context)
+}
+
+func GetLinkedServer(_ context.Context, conn Connection, id LinkedServerId) LinkedServer {
+	return linkedServer{conn: conn, id: id}
+}
+
+func GetLinkedServerByName(ctx context.Context, conn Connection, name string) LinkedServer {
+	var id LinkedServerId
+
+	err := conn.getSqlConnection(ctx).QueryRowContext(ctx, "SELECT [server_id] FROM sys.servers WHERE [is_linked]=1 AND [name]=@p1", name).Scan(&id)
+	if err != nil {
+		utils.AddError(ctx, "Failed to retrieve linked server ID", err)
+		return nil
+	}
+
+	return GetLinkedServer(ctx, conn, id)
+}
+
+func CreateLinkedServer(ctx context.Context, conn Connection, settings LinkedServerSettings) LinkedServer {
+	var server LinkedServer
+
+	utils.StopOnError(ctx).
+		Then(func() {
+			conn.exec(ctx, "EXEC sp_addlinkedserver @server=@p1, @srvproduct=@p2, @provider=@p3, @datasrc=@p4, @catalog=@p5",
+				settings.Name, settings.Product, nullIfEmpty(settings.Provider), nullIfEmpty(settings.DataSource), nullIfEmpty(settings.Catalog))
+		}).
+		Then(func() { server = GetLinkedServerByName(ctx, conn, settings.Name) }).
+		Then(func() { server.UpdateSettings(ctx, settings) })
+
+	return server
+}
+
+var _ LinkedServer = linkedServer{}
+
+type linkedServer struct {
+	conn Connection
+	id   LinkedServerId
+}
+
+func (s linkedServer) GetId(context.Context) LinkedServerId {
+	return s.id
+}
+
+func (s linkedServer) Exists(ctx context.Context) bool {
+	switch _, err := s.getSettingsRaw(ctx); err {
+	case sql.ErrNoRows:
+		return false
+	case nil:
+		return true
+	default:
+		utils.AddError(ctx, "Failed to check if linked server exists", err)
+		return false
+	}
+}
+
+func (s linkedServer) GetSettings(ctx context.Context) LinkedServerSettings {
+	settings, err := s.getSettingsRaw(ctx)
+	utils.AddError(ctx, "Failed to retrieve linked server settings", err)
+	return settings
+}
+
+// UpdateSettings changes server options. Product, provider, data source and catalog can be set only when the linked server is created.
+func (s linkedServer) UpdateSettings(ctx context.Context, settings LinkedServerSettings) {
+	var current LinkedServerSettings
+
+	setOption := func(name string, value any) {
+		utils.StopOnError(ctx).Then(func() {
+			s.conn.exec(ctx, "EXEC sp_serveroption @server=@p1, @optname=@p2, @optvalue=@p3", current.Name, name, value)
+		})
+	}
+
+	setFlag := func(name string, currentValue bool, value bool) {
+		if currentValue != value {
+			optValue := "false"
+			if value {
+				optValue = "true"
+			}
+
+			setOption(name, optValue)
+		}
+	}
+
+	utils.StopOnError(ctx).
+		Then(func() { current = s.GetSettings(ctx) }).
+		Then(func() {
+			setFlag("rpc", current.RpcEnabled, settings.RpcEnabled)
+			setFlag("rpc out", current.RpcOutEnabled, settings.RpcOutEnabled)
+			setFlag("data access", current.DataAccessEnabled, settings.DataAccessEnabled)
+			setFlag("collation compatible", current.CollationCompatible, settings.CollationCompatible)
+			setFlag("use remote collation", current.UseRemoteCollation, settings.UseRemoteCollation)
+
+			if current.CollationName != settings.CollationName {
+				setOption("collation name", nullIfEmpty(settings.CollationName))
+			}
+		})
+}
+
+func (s linkedServer) Drop(ctx context.Context) {
+	var settings LinkedServerSettings
+
+	utils.StopOnError(ctx).
+		Then(func() { settings = s.GetSettings(ctx) }).
+		Then(func() { s.conn.exec(ctx, "EXEC sp_dropserver @server=@p1, @droplogins='droplogins'", settings.Name) })
+}
+
+func (s linkedServer) getSettingsRaw(ctx context.Context) (LinkedServerSettings, error) {
+	var settings LinkedServerSettings
+	err := s.conn.getSqlConnection(ctx).
+		QueryRowContext(ctx, `SELECT [name], [product], [provider], ISNULL([data_source], ''), ISNULL([catalog], ''), [is_remote_login_enabled], [is_rpc_out_enabled],
+			[is_data_access_enabled], [is_collation_compatible], [uses_remote_collation], ISNULL([collation_name], '')
+			FROM sys.servers WHERE [is_linked]=1 AND [server_id]=@p1`, s.id).
+		Scan(&settings.Name, &settings.Product, &settings.Provider, &settings.DataSource, &settings.Catalog, &settings.RpcEnabled, &settings.RpcOutEnabled,
+			&settings.DataAccessEnabled, &settings.CollationCompatible, &settings.UseRemoteCollation, &settings.CollationName)
+	return settings, err
+}
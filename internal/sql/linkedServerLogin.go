@@ -0,0 +1,132 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+)
+
+type LinkedServerLoginSettings struct {
+	// Empty LocalLoginId maps all local logins without explicit mapping
+	LocalLoginId   LoginId
+	UseSelf        bool
+	RemoteUser     string
+	RemotePassword string
+}
+
+type LinkedServerLogin interface {
+	GetServer(context.Context) LinkedServer
+	GetLocalLoginId(context.Context) LoginId
+	Exists(context.Context) bool
+	GetSettings(context.Context) LinkedServerLoginSettings
+	UpdateSettings(context.Context, LinkedServerLoginSettings)
+	Drop(context.Context)
+}
+
+func GetLinkedServerLogin(_ context.Context, conn Connection, serverId LinkedServerId, localLoginId LoginId) LinkedServerLogin {
+	return linkedServerLogin{conn: conn, serverId: serverId, localLoginId: localLoginId}
+}
+
+func CreateLinkedServerLogin(ctx context.Context, conn Connection, serverId LinkedServerId, settings LinkedServerLoginSettings) LinkedServerLogin {
+	login := GetLinkedServerLogin(ctx, conn, serverId, settings.LocalLoginId)
+	login.UpdateSettings(ctx, settings)
+
+	if utils.HasError(ctx) {
+		return nil
+	}
+
+	return login
+}
+
+var _ LinkedServerLogin = linkedServerLogin{}
+
+type linkedServerLogin struct {
+	conn         Connection
+	serverId     LinkedServerId
+	localLoginId LoginId
+}
+
+func (l linkedServerLogin) GetServer(ctx context.Context) LinkedServer {
+	return GetLinkedServer(ctx, l.conn, l.serverId)
+}
+
+func (l linkedServerLogin) GetLocalLoginId(context.Context) LoginId {
+	return l.localLoginId
+}
+
+func (l linkedServerLogin) Exists(ctx context.Context) bool {
+	switch _, err := l.getSettingsRaw(ctx); err {
+	case sql.ErrNoRows:
+		return false
+	case nil:
+		return true
+	default:
+		utils.AddError(ctx, "Failed to check if linked server login exists", err)
+		return false
+	}
+}
+
+// GetSettings returns mapping settings. RemotePassword cannot be retrieved and is always empty.
+func (l linkedServerLogin) GetSettings(ctx context.Context) LinkedServerLoginSettings {
+	settings, err := l.getSettingsRaw(ctx)
+	utils.AddError(ctx, "Failed to retrieve linked server login settings", err)
+	return settings
+}
+
+// UpdateSettings creates or replaces the mapping, as sp_addlinkedsrvlogin overwrites existing one.
+func (l linkedServerLogin) UpdateSettings(ctx context.Context, settings LinkedServerLoginSettings) {
+	var (
+		server    LinkedServerSettings
+		loginName any
+	)
+
+	useSelf := "FALSE"
+	if settings.UseSelf {
+		useSelf = "TRUE"
+	}
+
+	utils.StopOnError(ctx).
+		Then(func() { server = l.GetServer(ctx).GetSettings(ctx) }).
+		Then(func() { loginName = l.getLocalLoginName(ctx) }).
+		Then(func() {
+			l.conn.exec(ctx, "EXEC sp_addlinkedsrvlogin @rmtsrvname=@p1, @useself=@p2, @locallogin=@p3, @rmtuser=@p4, @rmtpassword=@p5",
+				server.Name, useSelf, loginName, nullIfEmpty(settings.RemoteUser), nullIfEmpty(settings.RemotePassword))
+		})
+}
+
+func (l linkedServerLogin) Drop(ctx context.Context) {
+	var (
+		server    LinkedServerSettings
+		loginName any
+	)
+
+	utils.StopOnError(ctx).
+		Then(func() { server = l.GetServer(ctx).GetSettings(ctx) }).
+		Then(func() { loginName = l.getLocalLoginName(ctx) }).
+		Then(func() {
+			l.conn.exec(ctx, "EXEC sp_droplinkedsrvlogin @rmtsrvname=@p1, @locallogin=@p2", server.Name, loginName)
+		})
+}
+
+func (l linkedServerLogin) getLocalLoginName(ctx context.Context) any {
+	if l.localLoginId == "" {
+		return nil
+	}
+
+	var name string
+	err := l.conn.getSqlConnection(ctx).
+		QueryRowContext(ctx, "SELECT [name] FROM sys.server_principals WHERE CONVERT(VARCHAR(85), [sid], 1)=@p1", l.localLoginId).
+		Scan(&name)
+	utils.AddError(ctx, "Failed to retrieve local login name", err)
+	return name
+}
+
+func (l linkedServerLogin) getSettingsRaw(ctx context.Context) (LinkedServerLoginSettings, error) {
+	settings := LinkedServerLoginSettings{LocalLoginId: l.localLoginId}
+	err := l.conn.getSqlConnection(ctx).
+		QueryRowContext(ctx, `SELECT ll.[uses_self_credential], ISNULL(ll.[remote_name], '') FROM sys.linked_logins ll
+			LEFT JOIN sys.server_principals sp ON sp.[principal_id] = ll.[local_principal_id]
+			WHERE ll.[server_id]=@p1 AND ISNULL(CONVERT(VARCHAR(85), sp.[sid], 1), '')=@p2`, l.serverId, l.localLoginId).
+		Scan(&settings.UseSelf, &settings.RemoteUser)
+	return settings, err
+}
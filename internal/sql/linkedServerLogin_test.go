@@ -0,0 +1,95 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestLinkedServerLoginTestSuite(t *testing.T) {
+	s := &LinkedServerLoginTestSuite{}
+	suite.Run(t, s)
+}
+
+type LinkedServerLoginTestSuite struct {
+	SqlTestSuite
+	login linkedServerLogin
+}
+
+func (s *LinkedServerLoginTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.login = linkedServerLogin{conn: s.connMock, serverId: 3, localLoginId: "0x0102"}
+}
+
+func (s *LinkedServerLoginTestSuite) TestCreate() {
+	s.expectServerNameQuery()
+	s.expectLoginNameQuery()
+	expectExactExec(s.mock, "EXEC sp_addlinkedsrvlogin @rmtsrvname=@p1, @useself=@p2, @locallogin=@p3, @rmtuser=@p4, @rmtpassword=@p5").
+		WithArgs("remote", "FALSE", "app_login", "remote_user", "P@ssw0rd").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	login := CreateLinkedServerLogin(s.ctx, s.connMock, 3, LinkedServerLoginSettings{LocalLoginId: "0x0102", RemoteUser: "remote_user", RemotePassword: "P@ssw0rd"})
+
+	s.Equal(LoginId("0x0102"), login.GetLocalLoginId(s.ctx))
+}
+
+func (s *LinkedServerLoginTestSuite) TestCreateDefaultMapping() {
+	s.expectServerNameQuery()
+	expectExactExec(s.mock, "EXEC sp_addlinkedsrvlogin @rmtsrvname=@p1, @useself=@p2, @locallogin=@p3, @rmtuser=@p4, @rmtpassword=@p5").
+		WithArgs("remote", "TRUE", nil, nil, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	CreateLinkedServerLogin(s.ctx, s.connMock, 3, LinkedServerLoginSettings{UseSelf: true})
+}
+
+func (s *LinkedServerLoginTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(newRows("uses_self_credential", "remote_name").AddRow(false, "remote_user"))
+
+	s.True(s.login.Exists(s.ctx))
+}
+
+func (s *LinkedServerLoginTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.login.Exists(s.ctx))
+}
+
+func (s *LinkedServerLoginTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(newRows("uses_self_credential", "remote_name").AddRow(false, "remote_user"))
+
+	s.Equal(LinkedServerLoginSettings{LocalLoginId: "0x0102", RemoteUser: "remote_user"}, s.login.GetSettings(s.ctx))
+}
+
+func (s *LinkedServerLoginTestSuite) TestDrop() {
+	s.expectServerNameQuery()
+	s.expectLoginNameQuery()
+	expectExactExec(s.mock, "EXEC sp_droplinkedsrvlogin @rmtsrvname=@p1, @locallogin=@p2").
+		WithArgs("remote", "app_login").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.login.Drop(s.ctx)
+}
+
+func (s *LinkedServerLoginTestSuite) expectServerNameQuery() {
+	expectExactQuery(s.mock, `SELECT [name], [product], [provider], ISNULL([data_source], ''), ISNULL([catalog], ''), [is_remote_login_enabled], [is_rpc_out_enabled],
+			[is_data_access_enabled], [is_collation_compatible], [uses_remote_collation], ISNULL([collation_name], '')
+			FROM sys.servers WHERE [is_linked]=1 AND [server_id]=@p1`).
+		WithArgs(3).
+		WillReturnRows(newRows("name", "product", "provider", "data_source", "catalog", "is_remote_login_enabled", "is_rpc_out_enabled",
+			"is_data_access_enabled", "is_collation_compatible", "uses_remote_collation", "collation_name").
+			AddRow("remote", "", "MSOLEDBSQL", "remote-host", "", false, false, true, false, true, ""))
+}
+
+func (s *LinkedServerLoginTestSuite) expectLoginNameQuery() {
+	expectExactQuery(s.mock, "SELECT [name] FROM sys.server_principals WHERE CONVERT(VARCHAR(85), [sid], 1)=@p1").
+		WithArgs("0x0102").
+		WillReturnRows(newRows("name").AddRow("app_login"))
+}
+
+func (s *LinkedServerLoginTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, `SELECT ll.[uses_self_credential], ISNULL(ll.[remote_name], '') FROM sys.linked_logins ll
+			LEFT JOIN sys.server_principals sp ON sp.[principal_id] = ll.[local_principal_id]
+			WHERE ll.[server_id]=@p1 AND ISNULL(CONVERT(VARCHAR(85), sp.[sid], 1), '')=@p2`).
+		WithArgs(3, "0x0102")
+}
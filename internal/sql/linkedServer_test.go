@@ -0,0 +1,121 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestLinkedServerTestSuite(t *testing.T) {
+	s := &LinkedServerTestSuite{}
+	suite.Run(t, s)
+}
+
+type LinkedServerTestSuite struct {
+	SqlTestSuite
+	server linkedServer
+}
+
+func (s *LinkedServerTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.server = linkedServer{conn: s.connMock, id: LinkedServerId(rand.Int())}
+}
+
+func (s *LinkedServerTestSuite) TestCreate() {
+	expectExactExec(s.mock, "EXEC sp_addlinkedserver @server=@p1, @srvproduct=@p2, @provider=@p3, @datasrc=@p4, @catalog=@p5").
+		WithArgs("remote", "", "MSOLEDBSQL", "remote-host,1433", nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactQuery(s.mock, "SELECT [server_id] FROM sys.servers WHERE [is_linked]=1 AND [name]=@p1").
+		WithArgs("remote").
+		WillReturnRows(newRows("server_id").AddRow(s.server.id))
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(false))
+	s.expectServerOption("rpc out", "true")
+
+	server := CreateLinkedServer(s.ctx, s.connMock, LinkedServerSettings{
+		Name:               "remote",
+		Provider:           "MSOLEDBSQL",
+		DataSource:         "remote-host,1433",
+		RpcOutEnabled:      true,
+		DataAccessEnabled:  true,
+		UseRemoteCollation: true,
+	})
+
+	s.Equal(s.server.id, server.GetId(s.ctx))
+}
+
+func (s *LinkedServerTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(false))
+
+	s.True(s.server.Exists(s.ctx))
+}
+
+func (s *LinkedServerTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.server.Exists(s.ctx))
+}
+
+func (s *LinkedServerTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(true))
+
+	s.Equal(LinkedServerSettings{
+		Name:                "remote",
+		Provider:            "MSOLEDBSQL",
+		DataSource:          "remote-host,1433",
+		RpcEnabled:          true,
+		DataAccessEnabled:   true,
+		CollationCompatible: true,
+		UseRemoteCollation:  true,
+		CollationName:       "Latin1_General_CI_AS",
+	}, s.server.GetSettings(s.ctx))
+}
+
+func (s *LinkedServerTestSuite) TestUpdateSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(true))
+	s.expectServerOption("rpc", "false")
+	s.expectServerOption("rpc out", "true")
+	s.expectServerOption("collation compatible", "false")
+	expectExactExec(s.mock, "EXEC sp_serveroption @server=@p1, @optname=@p2, @optvalue=@p3").
+		WithArgs("remote", "collation name", nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.server.UpdateSettings(s.ctx, LinkedServerSettings{
+		Name:               "remote",
+		RpcOutEnabled:      true,
+		DataAccessEnabled:  true,
+		UseRemoteCollation: true,
+	})
+}
+
+func (s *LinkedServerTestSuite) TestDrop() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(false))
+	expectExactExec(s.mock, "EXEC sp_dropserver @server=@p1, @droplogins='droplogins'").WithArgs("remote").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.server.Drop(s.ctx)
+}
+
+func (s *LinkedServerTestSuite) expectServerOption(name string, value string) {
+	expectExactExec(s.mock, "EXEC sp_serveroption @server=@p1, @optname=@p2, @optvalue=@p3").
+		WithArgs("remote", name, value).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func (s *LinkedServerTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, `SELECT [name], [product], [provider], ISNULL([data_source], ''), ISNULL([catalog], ''), [is_remote_login_enabled], [is_rpc_out_enabled],
+			[is_data_access_enabled], [is_collation_compatible], [uses_remote_collation], ISNULL([collation_name], '')
+			FROM sys.servers WHERE [is_linked]=1 AND [server_id]=@p1`).
+		WithArgs(s.server.id)
+}
+
+func (s *LinkedServerTestSuite) newSettingsRows(customized bool) *sqlmock.Rows {
+	rows := newRows("name", "product", "provider", "data_source", "catalog", "is_remote_login_enabled", "is_rpc_out_enabled",
+		"is_data_access_enabled", "is_collation_compatible", "uses_remote_collation", "collation_name")
+
+	if customized {
+		return rows.AddRow("remote", "", "MSOLEDBSQL", "remote-host,1433", "", true, false, true, true, true, "Latin1_General_CI_AS")
+	}
+
+	return rows.AddRow("remote", "", "MSOLEDBSQL", "remote-host,1433", "", false, false, true, false, true, "")
+}
@@ -0,0 +1,68 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+)
+
+type MasterKey interface {
+	GetDb(context.Context) Database
+	Exists(context.Context) bool
+	SetPassword(ctx context.Context, password string)
+	Drop(context.Context)
+}
+
+func GetMasterKey(_ context.Context, db Database) MasterKey {
+	return masterKey{db: db}
+}
+
+func CreateMasterKey(ctx context.Context, db Database, password string) MasterKey {
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) MasterKey {
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("CREATE MASTER KEY ENCRYPTION BY PASSWORD = %s", quoteString(password))); err != nil {
+			utils.AddError(ctx, "Failed to create master key", err)
+			return nil
+		}
+
+		return GetMasterKey(ctx, db)
+	})
+}
+
+var _ MasterKey = masterKey{}
+
+type masterKey struct {
+	db Database
+}
+
+func (k masterKey) GetDb(context.Context) Database {
+	return k.db
+}
+
+func (k masterKey) Exists(ctx context.Context) bool {
+	return WithConnection(ctx, k.db.connect, func(conn *sql.DB) bool {
+		var count int
+
+		if err := conn.QueryRowContext(ctx, "SELECT COUNT(*) FROM sys.symmetric_keys WHERE [name]='##MS_DatabaseMasterKey##'").Scan(&count); err != nil {
+			utils.AddError(ctx, "Failed to check if master key exists", err)
+		}
+
+		return count > 0
+	})
+}
+
+func (k masterKey) SetPassword(ctx context.Context, password string) {
+	k.exec(ctx, "Failed to regenerate master key", fmt.Sprintf("ALTER MASTER KEY REGENERATE WITH ENCRYPTION BY PASSWORD = %s", quoteString(password)))
+}
+
+func (k masterKey) Drop(ctx context.Context) {
+	k.exec(ctx, "Failed to drop master key", "DROP MASTER KEY")
+}
+
+func (k masterKey) exec(ctx context.Context, errorSummary string, stat string) {
+	WithConnection(ctx, k.db.connect, func(conn *sql.DB) any {
+		_, err := conn.ExecContext(ctx, stat)
+		utils.AddError(ctx, errorSummary, err)
+		return nil
+	})
+}
@@ -0,0 +1,58 @@
+package sql
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestMasterKeyTestSuite(t *testing.T) {
+	s := &MasterKeyTestSuite{}
+	suite.Run(t, s)
+}
+
+type MasterKeyTestSuite struct {
+	SqlTestSuite
+	key masterKey
+}
+
+func (s *MasterKeyTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.key = masterKey{db: &s.dbMock}
+}
+
+func (s *MasterKeyTestSuite) TestCreateMasterKey() {
+	expectExactExec(s.mock, "CREATE MASTER KEY ENCRYPTION BY PASSWORD = 'Str0ng''Pa$$word'").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	key := CreateMasterKey(s.ctx, &s.dbMock, "Str0ng'Pa$$word")
+
+	s.Equal(&s.dbMock, key.GetDb(s.ctx))
+}
+
+func (s *MasterKeyTestSuite) TestExists() {
+	s.expectExistsQuery().WillReturnRows(newRows("count").AddRow(1))
+
+	s.True(s.key.Exists(s.ctx))
+}
+
+func (s *MasterKeyTestSuite) TestNotExists() {
+	s.expectExistsQuery().WillReturnRows(newRows("count").AddRow(0))
+
+	s.False(s.key.Exists(s.ctx))
+}
+
+func (s *MasterKeyTestSuite) TestSetPassword() {
+	expectExactExec(s.mock, "ALTER MASTER KEY REGENERATE WITH ENCRYPTION BY PASSWORD = 'new_password'").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.key.SetPassword(s.ctx, "new_password")
+}
+
+func (s *MasterKeyTestSuite) TestDrop() {
+	expectExactExec(s.mock, "DROP MASTER KEY").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.key.Drop(s.ctx)
+}
+
+func (s *MasterKeyTestSuite) expectExistsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, "SELECT COUNT(*) FROM sys.symmetric_keys WHERE [name]='##MS_DatabaseMasterKey##'")
+}
@@ -0,0 +1,126 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"strings"
+)
+
+const (
+	QueryStoreOff       = "OFF"
+	QueryStoreReadOnly  = "READ_ONLY"
+	QueryStoreReadWrite = "READ_WRITE"
+
+	// queryStoreReadonlyStorageFull is the readonly_reason flag set when Query Store exceeded max_storage_size_mb.
+	queryStoreReadonlyStorageFull = 65536
+)
+
+type QueryStoreSettings struct {
+	OperationMode    string
+	QueryCaptureMode string
+	// Zero values of numeric settings are left unchanged
+	MaxStorageSizeMb        int
+	StaleQueryThresholdDays int
+	IntervalLengthMinutes   int
+	WaitStatsCaptureMode    bool
+}
+
+type QueryStoreStatus struct {
+	ActualState        string
+	ReadonlyReason     int
+	CurrentStorageSize int
+}
+
+// IsStorageFull reports whether Query Store switched to READ_ONLY because it reached the maximum storage size.
+func (s QueryStoreStatus) IsStorageFull() bool {
+	return s.ActualState == QueryStoreReadOnly && s.ReadonlyReason&queryStoreReadonlyStorageFull != 0
+}
+
+type QueryStore interface {
+	GetDb(context.Context) Database
+	GetSettings(context.Context) QueryStoreSettings
+	GetStatus(context.Context) QueryStoreStatus
+	UpdateSettings(ctx context.Context, settings QueryStoreSettings)
+}
+
+func GetQueryStore(_ context.Context, db Database) QueryStore {
+	return queryStore{db: db}
+}
+
+var _ QueryStore = queryStore{}
+
+type queryStore struct {
+	db Database
+}
+
+func (qs queryStore) GetDb(context.Context) Database {
+	return qs.db
+}
+
+func (qs queryStore) GetSettings(ctx context.Context) QueryStoreSettings {
+	return WithConnection(ctx, qs.db.connect, func(conn *sql.DB) QueryStoreSettings {
+		var (
+			settings  QueryStoreSettings
+			waitStats string
+		)
+
+		err := conn.QueryRowContext(ctx, `SELECT [desired_state_desc], [query_capture_mode_desc], [max_storage_size_mb], [stale_query_threshold_days], [interval_length_minutes], [wait_stats_capture_mode_desc]
+			FROM sys.database_query_store_options`).
+			Scan(&settings.OperationMode, &settings.QueryCaptureMode, &settings.MaxStorageSizeMb, &settings.StaleQueryThresholdDays, &settings.IntervalLengthMinutes, &waitStats)
+		utils.AddError(ctx, "Failed to retrieve Query Store settings", err)
+
+		settings.WaitStatsCaptureMode = waitStats == "ON"
+		return settings
+	})
+}
+
+func (qs queryStore) GetStatus(ctx context.Context) QueryStoreStatus {
+	return WithConnection(ctx, qs.db.connect, func(conn *sql.DB) QueryStoreStatus {
+		var status QueryStoreStatus
+
+		err := conn.QueryRowContext(ctx, "SELECT [actual_state_desc], [readonly_reason], [current_storage_size_mb] FROM sys.database_query_store_options").
+			Scan(&status.ActualState, &status.ReadonlyReason, &status.CurrentStorageSize)
+		utils.AddError(ctx, "Failed to retrieve Query Store status", err)
+
+		return status
+	})
+}
+
+func (qs queryStore) UpdateSettings(ctx context.Context, settings QueryStoreSettings) {
+	WithConnection(ctx, qs.db.connect, func(conn *sql.DB) any {
+		stat := "ALTER DATABASE CURRENT SET QUERY_STORE = OFF"
+		if settings.OperationMode != QueryStoreOff {
+			stat = fmt.Sprintf("ALTER DATABASE CURRENT SET QUERY_STORE = ON (%s)", settings.toSqlOptions())
+		}
+
+		_, err := conn.ExecContext(ctx, stat)
+		utils.AddError(ctx, "Failed to configure Query Store", err)
+		return nil
+	})
+}
+
+func (s QueryStoreSettings) toSqlOptions() string {
+	options := []string{fmt.Sprintf("OPERATION_MODE = %s", s.OperationMode)}
+
+	if s.QueryCaptureMode != "" {
+		options = append(options, fmt.Sprintf("QUERY_CAPTURE_MODE = %s", s.QueryCaptureMode))
+	}
+
+	if s.MaxStorageSizeMb != 0 {
+		options = append(options, fmt.Sprintf("MAX_STORAGE_SIZE_MB = %d", s.MaxStorageSizeMb))
+	}
+
+	if s.StaleQueryThresholdDays != 0 {
+		options = append(options, fmt.Sprintf("CLEANUP_POLICY = (STALE_QUERY_THRESHOLD_DAYS = %d)", s.StaleQueryThresholdDays))
+	}
+
+	if s.IntervalLengthMinutes != 0 {
+		options = append(options, fmt.Sprintf("INTERVAL_LENGTH_MINUTES = %d", s.IntervalLengthMinutes))
+	}
+
+	options = append(options, fmt.Sprintf("WAIT_STATS_CAPTURE_MODE = %s", onOff(s.WaitStatsCaptureMode)))
+
+	return strings.Join(options, ", ")
+}
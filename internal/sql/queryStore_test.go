@@ -0,0 +1,89 @@
+package sql
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestQueryStoreTestSuite(t *testing.T) {
+	s := &QueryStoreTestSuite{}
+	suite.Run(t, s)
+}
+
+type QueryStoreTestSuite struct {
+	SqlTestSuite
+	queryStore queryStore
+}
+
+func (s *QueryStoreTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.queryStore = queryStore{db: &s.dbMock}
+}
+
+func (s *QueryStoreTestSuite) TestGetSettings() {
+	expectExactQuery(s.mock, `SELECT [desired_state_desc], [query_capture_mode_desc], [max_storage_size_mb], [stale_query_threshold_days], [interval_length_minutes], [wait_stats_capture_mode_desc]
+			FROM sys.database_query_store_options`).
+		WillReturnRows(newRows("desired_state_desc", "query_capture_mode_desc", "max_storage_size_mb", "stale_query_threshold_days", "interval_length_minutes", "wait_stats_capture_mode_desc").
+			AddRow("READ_WRITE", "AUTO", 1000, 30, 60, "ON"))
+
+	s.Equal(QueryStoreSettings{
+		OperationMode:           QueryStoreReadWrite,
+		QueryCaptureMode:        "AUTO",
+		MaxStorageSizeMb:        1000,
+		StaleQueryThresholdDays: 30,
+		IntervalLengthMinutes:   60,
+		WaitStatsCaptureMode:    true,
+	}, s.queryStore.GetSettings(s.ctx))
+}
+
+func (s *QueryStoreTestSuite) TestGetStatus() {
+	expectExactQuery(s.mock, "SELECT [actual_state_desc], [readonly_reason], [current_storage_size_mb] FROM sys.database_query_store_options").
+		WillReturnRows(newRows("actual_state_desc", "readonly_reason", "current_storage_size_mb").AddRow("READ_ONLY", 65536, 1000))
+
+	status := s.queryStore.GetStatus(s.ctx)
+
+	s.Equal(QueryStoreStatus{ActualState: QueryStoreReadOnly, ReadonlyReason: 65536, CurrentStorageSize: 1000}, status)
+	s.True(status.IsStorageFull())
+}
+
+func (s *QueryStoreTestSuite) TestIsStorageFull() {
+	s.False(QueryStoreStatus{ActualState: QueryStoreReadOnly, ReadonlyReason: 1}.IsStorageFull(), "read-only for other reason")
+	s.False(QueryStoreStatus{ActualState: QueryStoreReadWrite}.IsStorageFull(), "read-write")
+}
+
+func (s *QueryStoreTestSuite) TestUpdateSettings() {
+	cases := map[string]struct {
+		settings QueryStoreSettings
+		expected string
+	}{
+		"off": {
+			settings: QueryStoreSettings{OperationMode: QueryStoreOff, MaxStorageSizeMb: 100},
+			expected: "ALTER DATABASE CURRENT SET QUERY_STORE = OFF",
+		},
+		"minimal": {
+			settings: QueryStoreSettings{OperationMode: QueryStoreReadOnly},
+			expected: "ALTER DATABASE CURRENT SET QUERY_STORE = ON (OPERATION_MODE = READ_ONLY, WAIT_STATS_CAPTURE_MODE = OFF)",
+		},
+		"full": {
+			settings: QueryStoreSettings{
+				OperationMode:           QueryStoreReadWrite,
+				QueryCaptureMode:        "ALL",
+				MaxStorageSizeMb:        2048,
+				StaleQueryThresholdDays: 14,
+				IntervalLengthMinutes:   15,
+				WaitStatsCaptureMode:    true,
+			},
+			expected: "ALTER DATABASE CURRENT SET QUERY_STORE = ON (OPERATION_MODE = READ_WRITE, QUERY_CAPTURE_MODE = ALL, MAX_STORAGE_SIZE_MB = 2048, " +
+				"CLEANUP_POLICY = (STALE_QUERY_THRESHOLD_DAYS = 14), INTERVAL_LENGTH_MINUTES = 15, WAIT_STATS_CAPTURE_MODE = ON)",
+		},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			expectExactExec(s.mock, tc.expected).WillReturnResult(sqlmock.NewResult(0, 1))
+
+			s.queryStore.UpdateSettings(s.ctx, tc.settings)
+		})
+	}
+}
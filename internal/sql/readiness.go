@@ -0,0 +1,136 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var readinessPollInterval = 5 * time.Second
+
+// readinessCheckTTL is the time a successful readiness check is trusted, so the database is not checked on every use.
+var readinessCheckTTL = time.Minute
+
+// DatabaseNotReadyError is returned when the database did not become available within WaitForReady timeout.
+type DatabaseNotReadyError struct {
+	Database string
+	Timeout  time.Duration
+	// Cause is the result of the last readiness check
+	Cause error
+}
+
+func (e DatabaseNotReadyError) Error() string {
+	return fmt.Sprintf("database %q did not become ready within %s: %v", e.Database, e.Timeout, e.Cause)
+}
+
+func (e DatabaseNotReadyError) Unwrap() error {
+	return e.Cause
+}
+
+// readyDatabases keeps time of the last successful readiness check of each database.
+type readyDatabases struct {
+	mutex     sync.Mutex
+	checkedAt map[string]time.Time
+}
+
+func (r *readyDatabases) isReady(dbName string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	checkedAt, ok := r.checkedAt[dbName]
+	return ok && time.Since(checkedAt) < readinessCheckTTL
+}
+
+func (r *readyDatabases) setReady(dbName string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.checkedAt == nil {
+		r.checkedAt = map[string]time.Time{}
+	}
+	r.checkedAt[dbName] = time.Now()
+}
+
+// waitForReady waits for the database, when WaitForReady is set in connection details. Successful checks are
+// reused for readinessCheckTTL.
+func (c *connection) waitForReady(ctx context.Context, db *sql.DB, dbName string) error {
+	if c.connDetails.WaitForReady <= 0 {
+		return nil
+	}
+
+	if c.ready != nil && c.ready.isReady(dbName) {
+		return nil
+	}
+
+	if err := c.waitForDatabase(ctx, db, dbName, c.connDetails.WaitForReady); err != nil {
+		return err
+	}
+
+	if c.ready != nil {
+		c.ready.setReady(dbName)
+	}
+
+	return nil
+}
+
+// getServerDatabaseName returns name of the database the server connection uses.
+func (cd ConnectionDetails) getServerDatabaseName() string {
+	if cd.Database == "" {
+		return "master"
+	}
+
+	return cd.Database
+}
+
+// waitForDatabase polls until the database is ONLINE and accepts queries, e.g. after Azure SQL serverless database
+// was resumed from auto-pause.
+func (c *connection) waitForDatabase(ctx context.Context, db *sql.DB, dbName string, timeout time.Duration) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var lastErr error
+
+	for {
+		err := checkDatabaseReady(timeoutCtx, c.conn, db, dbName)
+		if err == nil {
+			return nil
+		}
+
+		// Keep the reason reported by the server, instead of the deadline error caused by the timeout itself
+		if lastErr == nil || timeoutCtx.Err() == nil {
+			lastErr = err
+		}
+
+		tflog.Debug(ctx, "Waiting for database to become ready", map[string]any{"database": dbName, "reason": err.Error()})
+
+		select {
+		case <-timeoutCtx.Done():
+			return DatabaseNotReadyError{Database: dbName, Timeout: timeout, Cause: lastErr}
+		case <-time.After(readinessPollInterval):
+		}
+	}
+}
+
+func checkDatabaseReady(ctx context.Context, serverDB *sql.DB, db *sql.DB, dbName string) error {
+	var status sql.NullString
+
+	if err := serverDB.QueryRowContext(ctx, "SELECT CONVERT(nvarchar(60), DATABASEPROPERTYEX(@p1, 'Status'))", dbName).Scan(&status); err != nil {
+		return err
+	}
+
+	if !status.Valid {
+		return errors.New("database does not exist")
+	}
+
+	if status.String != "ONLINE" {
+		return fmt.Errorf("database status is %s", status.String)
+	}
+
+	var result int
+	return db.QueryRowContext(ctx, "SELECT 1").Scan(&result)
+}
@@ -0,0 +1,127 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	mssql "github.com/microsoft/go-mssqldb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+const testDatabaseStatusQuery = "SELECT CONVERT(nvarchar(60), DATABASEPROPERTYEX(@p1, 'Status'))"
+
+func TestWaitForDatabase(t *testing.T) {
+	readinessPollInterval = time.Millisecond
+	serverDB, serverMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer serverDB.Close()
+	db, dbMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	c := connection{conn: serverDB}
+
+	expectExactQuery(serverMock, testDatabaseStatusQuery).WithArgs("test_db").WillReturnRows(newRows("status").AddRow("OFFLINE"))
+	expectExactQuery(serverMock, testDatabaseStatusQuery).WithArgs("test_db").WillReturnRows(newRows("status").AddRow("ONLINE"))
+	expectExactQuery(dbMock, "SELECT 1").WillReturnError(mssql.Error{Number: 40613, Message: "Database is not currently available"})
+	expectExactQuery(serverMock, testDatabaseStatusQuery).WithArgs("test_db").WillReturnRows(newRows("status").AddRow("ONLINE"))
+	expectExactQuery(dbMock, "SELECT 1").WillReturnRows(newRows("").AddRow(1))
+
+	err = c.waitForDatabase(context.Background(), db, "test_db", time.Minute)
+
+	assert.NoError(t, err)
+	assert.NoError(t, serverMock.ExpectationsWereMet(), "server mock expectations")
+	assert.NoError(t, dbMock.ExpectationsWereMet(), "db mock expectations")
+}
+
+func TestWaitForDatabaseTimeout(t *testing.T) {
+	readinessPollInterval = time.Hour
+	serverDB, serverMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer serverDB.Close()
+	c := connection{conn: serverDB}
+
+	expectExactQuery(serverMock, testDatabaseStatusQuery).WithArgs("test_db").WillReturnRows(newRows("status").AddRow("RESTORING"))
+
+	err = c.waitForDatabase(context.Background(), serverDB, "test_db", 20*time.Millisecond)
+
+	var notReadyErr DatabaseNotReadyError
+	require.True(t, errors.As(err, &notReadyErr), "not ready error")
+	assert.Equal(t, "test_db", notReadyErr.Database, "database")
+	assert.Contains(t, err.Error(), "RESTORING", "last status")
+}
+
+func TestWaitForDatabaseNotExisting(t *testing.T) {
+	readinessPollInterval = time.Hour
+	serverDB, serverMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer serverDB.Close()
+	c := connection{conn: serverDB}
+
+	expectExactQuery(serverMock, testDatabaseStatusQuery).WithArgs("test_db").WillReturnRows(newRows("status").AddRow(nil))
+
+	err = c.waitForDatabase(context.Background(), serverDB, "test_db", 20*time.Millisecond)
+
+	assert.ErrorContains(t, err, "does not exist")
+}
+
+func TestWaitForReadyReusesSuccessfulCheck(t *testing.T) {
+	readinessPollInterval = time.Millisecond
+	serverDB, serverMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer serverDB.Close()
+	db, dbMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	c := connection{conn: serverDB, connDetails: ConnectionDetails{WaitForReady: time.Minute}, ready: &readyDatabases{}}
+
+	expectExactQuery(serverMock, testDatabaseStatusQuery).WithArgs("test_db").WillReturnRows(newRows("status").AddRow("ONLINE"))
+	expectExactQuery(dbMock, "SELECT 1").WillReturnRows(newRows("").AddRow(1))
+
+	assert.NoError(t, c.waitForReady(context.Background(), db, "test_db"), "first use")
+	assert.NoError(t, c.waitForReady(context.Background(), db, "test_db"), "second use")
+	assert.NoError(t, serverMock.ExpectationsWereMet(), "server mock expectations")
+	assert.NoError(t, dbMock.ExpectationsWereMet(), "db mock expectations")
+}
+
+func TestWaitForReadyChecksAgainAfterTTL(t *testing.T) {
+	readinessPollInterval = time.Millisecond
+	defer func(ttl time.Duration) { readinessCheckTTL = ttl }(readinessCheckTTL)
+	readinessCheckTTL = 0
+	serverDB, serverMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer serverDB.Close()
+	db, dbMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	c := connection{conn: serverDB, connDetails: ConnectionDetails{WaitForReady: time.Minute}, ready: &readyDatabases{}}
+
+	expectExactQuery(serverMock, testDatabaseStatusQuery).WithArgs("test_db").WillReturnRows(newRows("status").AddRow("ONLINE"))
+	expectExactQuery(dbMock, "SELECT 1").WillReturnRows(newRows("").AddRow(1))
+	expectExactQuery(serverMock, testDatabaseStatusQuery).WithArgs("test_db").WillReturnRows(newRows("status").AddRow("ONLINE"))
+	expectExactQuery(dbMock, "SELECT 1").WillReturnError(mssql.Error{Number: 40613, Message: "Database is not currently available"})
+	expectExactQuery(serverMock, testDatabaseStatusQuery).WithArgs("test_db").WillReturnRows(newRows("status").AddRow("ONLINE"))
+	expectExactQuery(dbMock, "SELECT 1").WillReturnRows(newRows("").AddRow(1))
+
+	assert.NoError(t, c.waitForReady(context.Background(), db, "test_db"), "first use")
+	assert.NoError(t, c.waitForReady(context.Background(), db, "test_db"), "second use")
+	assert.NoError(t, serverMock.ExpectationsWereMet(), "server mock expectations")
+	assert.NoError(t, dbMock.ExpectationsWereMet(), "db mock expectations")
+}
+
+func TestWaitForReadyDisabled(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	c := connection{conn: db}
+
+	assert.NoError(t, c.waitForReady(context.Background(), db, "test_db"))
+	assert.NoError(t, mock.ExpectationsWereMet(), "mock expectations")
+}
+
+func TestGetServerDatabaseName(t *testing.T) {
+	assert.Equal(t, "master", ConnectionDetails{}.getServerDatabaseName(), "default")
+	assert.Equal(t, "test_db", ConnectionDetails{Database: "test_db"}.getServerDatabaseName(), "database")
+}
@@ -0,0 +1,142 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"sort"
+	"strings"
+)
+
+type ResourceGovernorClassifierRoute struct {
+	LoginId         LoginId
+	WorkloadGroupId WorkloadGroupId
+}
+
+type ResourceGovernorClassifierSettings struct {
+	// FunctionSchema and FunctionName identify the classifier function in master database
+	FunctionSchema string
+	FunctionName   string
+	// When Routes is not nil, the classifier function is generated by the provider, routing sessions of given logins to workload groups.
+	// All other sessions are assigned to the default group.
+	Routes []ResourceGovernorClassifierRoute
+}
+
+func (s ResourceGovernorClassifierSettings) IsManaged() bool {
+	return s.Routes != nil
+}
+
+func (s ResourceGovernorClassifierSettings) quotedFunctionName() string {
+	return fmt.Sprintf("[%s].[%s]", s.FunctionSchema, s.FunctionName)
+}
+
+// GetResourceGovernorClassifier returns classifier function currently used by Resource Governor.
+// Routes are never populated, as they cannot be reliably parsed from the function definition.
+func GetResourceGovernorClassifier(ctx context.Context, conn Connection) ResourceGovernorClassifierSettings {
+	var settings ResourceGovernorClassifierSettings
+
+	err := conn.getSqlConnection(ctx).
+		QueryRowContext(ctx, "SELECT ISNULL(OBJECT_SCHEMA_NAME([classifier_function_id], 1), ''), ISNULL(OBJECT_NAME([classifier_function_id], 1), '') FROM sys.resource_governor_configuration").
+		Scan(&settings.FunctionSchema, &settings.FunctionName)
+	utils.AddError(ctx, "Failed to retrieve Resource Governor classifier function", err)
+
+	return settings
+}
+
+// IsResourceGovernorClassifierUpToDate checks if definition of managed classifier function matches the routes.
+func IsResourceGovernorClassifierUpToDate(ctx context.Context, conn Connection, settings ResourceGovernorClassifierSettings) bool {
+	var (
+		body       string
+		definition sql.NullString
+	)
+
+	utils.StopOnError(ctx).
+		Then(func() { body = settings.toFunctionBody(ctx, conn) }).
+		Then(func() {
+			err := conn.getDBSqlConnection(ctx, "master").
+				QueryRowContext(ctx, "SELECT [definition] FROM sys.sql_modules WHERE [object_id]=OBJECT_ID(@p1)", settings.quotedFunctionName()).
+				Scan(&definition)
+			if err != sql.ErrNoRows {
+				utils.AddError(ctx, "Failed to retrieve classifier function definition", err)
+			}
+		})
+
+	return definition.Valid && strings.Contains(definition.String, body)
+}
+
+// SetResourceGovernorClassifier generates classifier function, when managed, and makes Resource Governor use it.
+func SetResourceGovernorClassifier(ctx context.Context, conn Connection, settings ResourceGovernorClassifierSettings) {
+	var (
+		current ResourceGovernorClassifierSettings
+		body    string
+	)
+
+	utils.StopOnError(ctx).
+		Then(func() { current = GetResourceGovernorClassifier(ctx, conn) }).
+		Then(func() {
+			if settings.IsManaged() {
+				body = settings.toFunctionBody(ctx, conn)
+			}
+		}).
+		Then(func() {
+			if !settings.IsManaged() {
+				return
+			}
+
+			// Function bound to Resource Governor cannot be altered
+			if current.FunctionName != "" {
+				conn.exec(ctx, fmt.Sprintf("ALTER RESOURCE GOVERNOR WITH (CLASSIFIER_FUNCTION = NULL); %s", resourceGovernorReconfigure))
+			}
+
+			utils.StopOnError(ctx).Then(func() {
+				stat := fmt.Sprintf("CREATE OR ALTER FUNCTION %s()\n%s", settings.quotedFunctionName(), body)
+				_, err := conn.getDBSqlConnection(ctx, "master").ExecContext(ctx, stat)
+				utils.AddError(ctx, "Failed to create classifier function", err)
+			})
+		}).
+		Then(func() {
+			conn.exec(ctx, fmt.Sprintf("ALTER RESOURCE GOVERNOR WITH (CLASSIFIER_FUNCTION = %s); %s", settings.quotedFunctionName(), resourceGovernorReconfigure))
+		})
+}
+
+// RemoveResourceGovernorClassifier detaches classifier function from Resource Governor and drops it, when managed.
+func RemoveResourceGovernorClassifier(ctx context.Context, conn Connection, settings ResourceGovernorClassifierSettings) {
+	utils.StopOnError(ctx).
+		Then(func() {
+			conn.exec(ctx, fmt.Sprintf("ALTER RESOURCE GOVERNOR WITH (CLASSIFIER_FUNCTION = NULL); %s", resourceGovernorReconfigure))
+		}).
+		Then(func() {
+			if settings.IsManaged() {
+				_, err := conn.getDBSqlConnection(ctx, "master").ExecContext(ctx, fmt.Sprintf("DROP FUNCTION IF EXISTS %s", settings.quotedFunctionName()))
+				utils.AddError(ctx, "Failed to drop classifier function", err)
+			}
+		})
+}
+
+func (s ResourceGovernorClassifierSettings) toFunctionBody(ctx context.Context, conn Connection) string {
+	routes := append([]ResourceGovernorClassifierRoute{}, s.Routes...)
+	sort.Slice(routes, func(i, j int) bool { return routes[i].LoginId < routes[j].LoginId })
+
+	var builder strings.Builder
+	builder.WriteString("RETURNS SYSNAME WITH SCHEMABINDING\nAS\nBEGIN\n")
+
+	if len(routes) == 0 {
+		builder.WriteString("\tRETURN N'default'\nEND")
+		return builder.String()
+	}
+
+	builder.WriteString("\tRETURN CASE CONVERT(VARCHAR(85), SUSER_SID(), 1)\n")
+
+	for _, route := range routes {
+		groupName := GetWorkloadGroup(ctx, conn, route.WorkloadGroupId).GetSettings(ctx).Name
+		if utils.HasError(ctx) {
+			return ""
+		}
+
+		builder.WriteString(fmt.Sprintf("\t\tWHEN %s THEN N%s\n", quoteString(string(route.LoginId)), quoteString(groupName)))
+	}
+
+	builder.WriteString("\t\tELSE N'default'\n\tEND\nEND")
+	return builder.String()
+}
@@ -0,0 +1,106 @@
+package sql
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestResourceGovernorClassifierTestSuite(t *testing.T) {
+	s := &ResourceGovernorClassifierTestSuite{}
+	suite.Run(t, s)
+}
+
+type ResourceGovernorClassifierTestSuite struct {
+	SqlTestSuite
+}
+
+const expectedClassifierBody = `RETURNS SYSNAME WITH SCHEMABINDING
+AS
+BEGIN
+	RETURN CASE CONVERT(VARCHAR(85), SUSER_SID(), 1)
+		WHEN '0x01' THEN N'reports'
+		WHEN '0x02' THEN N'etl'
+		ELSE N'default'
+	END
+END`
+
+var classifierSettings = ResourceGovernorClassifierSettings{
+	FunctionSchema: "dbo",
+	FunctionName:   "classifier",
+	Routes: []ResourceGovernorClassifierRoute{
+		{LoginId: "0x02", WorkloadGroupId: 258},
+		{LoginId: "0x01", WorkloadGroupId: 257},
+	},
+}
+
+func (s *ResourceGovernorClassifierTestSuite) TestGet() {
+	s.expectClassifierQuery("dbo", "classifier")
+
+	s.Equal(ResourceGovernorClassifierSettings{FunctionSchema: "dbo", FunctionName: "classifier"}, GetResourceGovernorClassifier(s.ctx, s.connMock))
+}
+
+func (s *ResourceGovernorClassifierTestSuite) TestIsUpToDate() {
+	s.expectGroupNames()
+	expectExactQuery(s.mock, "SELECT [definition] FROM sys.sql_modules WHERE [object_id]=OBJECT_ID(@p1)").
+		WithArgs("[dbo].[classifier]").
+		WillReturnRows(newRows("definition").AddRow("CREATE   FUNCTION [dbo].[classifier]()\n" + expectedClassifierBody))
+
+	s.True(IsResourceGovernorClassifierUpToDate(s.ctx, s.connMock, classifierSettings))
+}
+
+func (s *ResourceGovernorClassifierTestSuite) TestSetManaged() {
+	s.expectClassifierQuery("dbo", "classifier")
+	s.expectGroupNames()
+	expectExactExec(s.mock, "ALTER RESOURCE GOVERNOR WITH (CLASSIFIER_FUNCTION = NULL); ALTER RESOURCE GOVERNOR RECONFIGURE").WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactExec(s.mock, "CREATE OR ALTER FUNCTION [dbo].[classifier]()\n"+expectedClassifierBody).WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectBind("[dbo].[classifier]")
+
+	SetResourceGovernorClassifier(s.ctx, s.connMock, classifierSettings)
+}
+
+func (s *ResourceGovernorClassifierTestSuite) TestSetManagedWithoutRoutes() {
+	s.expectClassifierQuery("", "")
+	expectExactExec(s.mock, "CREATE OR ALTER FUNCTION [dbo].[classifier]()\nRETURNS SYSNAME WITH SCHEMABINDING\nAS\nBEGIN\n\tRETURN N'default'\nEND").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectBind("[dbo].[classifier]")
+
+	SetResourceGovernorClassifier(s.ctx, s.connMock, ResourceGovernorClassifierSettings{FunctionSchema: "dbo", FunctionName: "classifier", Routes: []ResourceGovernorClassifierRoute{}})
+}
+
+func (s *ResourceGovernorClassifierTestSuite) TestSetUnmanaged() {
+	s.expectClassifierQuery("", "")
+	s.expectBind("[rg].[custom_classifier]")
+
+	SetResourceGovernorClassifier(s.ctx, s.connMock, ResourceGovernorClassifierSettings{FunctionSchema: "rg", FunctionName: "custom_classifier"})
+}
+
+func (s *ResourceGovernorClassifierTestSuite) TestRemove() {
+	expectExactExec(s.mock, "ALTER RESOURCE GOVERNOR WITH (CLASSIFIER_FUNCTION = NULL); ALTER RESOURCE GOVERNOR RECONFIGURE").WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactExec(s.mock, "DROP FUNCTION IF EXISTS [dbo].[classifier]").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	RemoveResourceGovernorClassifier(s.ctx, s.connMock, classifierSettings)
+}
+
+func (s *ResourceGovernorClassifierTestSuite) expectClassifierQuery(schema string, name string) {
+	expectExactQuery(s.mock, "SELECT ISNULL(OBJECT_SCHEMA_NAME([classifier_function_id], 1), ''), ISNULL(OBJECT_NAME([classifier_function_id], 1), '') FROM sys.resource_governor_configuration").
+		WillReturnRows(newRows("schema", "name").AddRow(schema, name))
+}
+
+func (s *ResourceGovernorClassifierTestSuite) expectBind(functionName string) {
+	expectExactExec(s.mock, "ALTER RESOURCE GOVERNOR WITH (CLASSIFIER_FUNCTION = "+functionName+"); ALTER RESOURCE GOVERNOR RECONFIGURE").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func (s *ResourceGovernorClassifierTestSuite) expectGroupNames() {
+	for _, group := range []struct {
+		id   int
+		name string
+	}{{257, "reports"}, {258, "etl"}} {
+		expectExactQuery(s.mock, `SELECT [name], [pool_id], [importance], [request_max_memory_grant_percent], [request_max_cpu_time_sec], [request_memory_grant_timeout_sec], [max_dop], [group_max_requests]
+			FROM sys.resource_governor_workload_groups WHERE [group_id]=@p1`).
+			WithArgs(group.id).
+			WillReturnRows(newRows("name", "pool_id", "importance", "request_max_memory_grant_percent", "request_max_cpu_time_sec", "request_memory_grant_timeout_sec", "max_dop", "group_max_requests").
+				AddRow(group.name, 2, "MEDIUM", 25, 0, 0, 0, 0))
+	}
+}
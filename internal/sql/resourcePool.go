@@ -0,0 +1,121 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+)
+
+const resourceGovernorReconfigure = "ALTER RESOURCE GOVERNOR RECONFIGURE"
+
+type ResourcePoolSettings struct {
+	Name             string
+	MinCpuPercent    int
+	MaxCpuPercent    int
+	CapCpuPercent    int
+	MinMemoryPercent int
+	MaxMemoryPercent int
+}
+
+func (s ResourcePoolSettings) toSqlOptions() string {
+	return fmt.Sprintf("MIN_CPU_PERCENT = %d, MAX_CPU_PERCENT = %d, CAP_CPU_PERCENT = %d, MIN_MEMORY_PERCENT = %d, MAX_MEMORY_PERCENT = %d",
+		s.MinCpuPercent, s.MaxCpuPercent, s.CapCpuPercent, s.MinMemoryPercent, s.MaxMemoryPercent)
+}
+
+type ResourcePool interface {
+	GetId(context.Context) ResourcePoolId
+	Exists(context.Context) bool
+	GetSettings(context.Context) ResourcePoolSettings
+	UpdateSettings(context.Context, ResourcePoolSettings)
+	Drop(context.Context)
+}
+
+func GetResourcePool(_ context.Context, conn Connection, id ResourcePoolId) ResourcePool {
+	return resourcePool{conn: conn, id: id}
+}
+
+func GetResourcePoolByName(ctx context.Context, conn Connection, name string) ResourcePool {
+	var id ResourcePoolId
+
+	err := conn.getSqlConnection(ctx).QueryRowContext(ctx, "SELECT [pool_id] FROM sys.resource_governor_resource_pools WHERE [name]=@p1", name).Scan(&id)
+	if err != nil {
+		utils.AddError(ctx, "Failed to retrieve resource pool ID", err)
+		return nil
+	}
+
+	return GetResourcePool(ctx, conn, id)
+}
+
+func CreateResourcePool(ctx context.Context, conn Connection, settings ResourcePoolSettings) ResourcePool {
+	var pool ResourcePool
+
+	utils.StopOnError(ctx).
+		Then(func() {
+			conn.exec(ctx, fmt.Sprintf("CREATE RESOURCE POOL [%s] WITH (%s); %s", settings.Name, settings.toSqlOptions(), resourceGovernorReconfigure))
+		}).
+		Then(func() { pool = GetResourcePoolByName(ctx, conn, settings.Name) })
+
+	return pool
+}
+
+var _ ResourcePool = resourcePool{}
+
+type resourcePool struct {
+	conn Connection
+	id   ResourcePoolId
+}
+
+func (p resourcePool) GetId(context.Context) ResourcePoolId {
+	return p.id
+}
+
+func (p resourcePool) Exists(ctx context.Context) bool {
+	switch _, err := p.getSettingsRaw(ctx); err {
+	case sql.ErrNoRows:
+		return false
+	case nil:
+		return true
+	default:
+		utils.AddError(ctx, "Failed to check if resource pool exists", err)
+		return false
+	}
+}
+
+func (p resourcePool) GetSettings(ctx context.Context) ResourcePoolSettings {
+	settings, err := p.getSettingsRaw(ctx)
+	utils.AddError(ctx, "Failed to retrieve resource pool settings", err)
+	return settings
+}
+
+func (p resourcePool) UpdateSettings(ctx context.Context, settings ResourcePoolSettings) {
+	var current ResourcePoolSettings
+
+	utils.StopOnError(ctx).
+		Then(func() { current = p.GetSettings(ctx) }).
+		Then(func() {
+			settings.Name = current.Name
+			if settings != current {
+				p.conn.exec(ctx, fmt.Sprintf("ALTER RESOURCE POOL [%s] WITH (%s); %s", current.Name, settings.toSqlOptions(), resourceGovernorReconfigure))
+			}
+		})
+}
+
+func (p resourcePool) Drop(ctx context.Context) {
+	var settings ResourcePoolSettings
+
+	utils.StopOnError(ctx).
+		Then(func() { settings = p.GetSettings(ctx) }).
+		Then(func() {
+			p.conn.exec(ctx, fmt.Sprintf("DROP RESOURCE POOL [%s]; %s", settings.Name, resourceGovernorReconfigure))
+		})
+}
+
+func (p resourcePool) getSettingsRaw(ctx context.Context) (ResourcePoolSettings, error) {
+	var settings ResourcePoolSettings
+	err := p.conn.getSqlConnection(ctx).
+		QueryRowContext(ctx, `SELECT [name], [min_cpu_percent], [max_cpu_percent], [cap_cpu_percent], [min_memory_percent], [max_memory_percent]
+			FROM sys.resource_governor_resource_pools WHERE [pool_id]=@p1`, p.id).
+		Scan(&settings.Name, &settings.MinCpuPercent, &settings.MaxCpuPercent, &settings.CapCpuPercent, &settings.MinMemoryPercent, &settings.MaxMemoryPercent)
+	return settings, err
+}
@@ -0,0 +1,86 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestResourcePoolTestSuite(t *testing.T) {
+	s := &ResourcePoolTestSuite{}
+	suite.Run(t, s)
+}
+
+type ResourcePoolTestSuite struct {
+	SqlTestSuite
+	pool resourcePool
+}
+
+func (s *ResourcePoolTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.pool = resourcePool{conn: s.connMock, id: ResourcePoolId(rand.Int())}
+}
+
+func (s *ResourcePoolTestSuite) TestCreate() {
+	expectExactExec(s.mock, "CREATE RESOURCE POOL [reporting] WITH (MIN_CPU_PERCENT = 10, MAX_CPU_PERCENT = 50, CAP_CPU_PERCENT = 60, MIN_MEMORY_PERCENT = 0, MAX_MEMORY_PERCENT = 40); ALTER RESOURCE GOVERNOR RECONFIGURE").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactQuery(s.mock, "SELECT [pool_id] FROM sys.resource_governor_resource_pools WHERE [name]=@p1").
+		WithArgs("reporting").
+		WillReturnRows(newRows("pool_id").AddRow(256))
+
+	pool := CreateResourcePool(s.ctx, s.connMock, ResourcePoolSettings{Name: "reporting", MinCpuPercent: 10, MaxCpuPercent: 50, CapCpuPercent: 60, MaxMemoryPercent: 40})
+
+	s.Equal(ResourcePoolId(256), pool.GetId(s.ctx))
+}
+
+func (s *ResourcePoolTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	s.True(s.pool.Exists(s.ctx))
+}
+
+func (s *ResourcePoolTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.pool.Exists(s.ctx))
+}
+
+func (s *ResourcePoolTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	s.Equal(ResourcePoolSettings{Name: "reporting", MinCpuPercent: 10, MaxCpuPercent: 50, CapCpuPercent: 60, MaxMemoryPercent: 40}, s.pool.GetSettings(s.ctx))
+}
+
+func (s *ResourcePoolTestSuite) TestUpdateSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+	expectExactExec(s.mock, "ALTER RESOURCE POOL [reporting] WITH (MIN_CPU_PERCENT = 0, MAX_CPU_PERCENT = 30, CAP_CPU_PERCENT = 100, MIN_MEMORY_PERCENT = 0, MAX_MEMORY_PERCENT = 40); ALTER RESOURCE GOVERNOR RECONFIGURE").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.pool.UpdateSettings(s.ctx, ResourcePoolSettings{Name: "reporting", MaxCpuPercent: 30, CapCpuPercent: 100, MaxMemoryPercent: 40})
+}
+
+func (s *ResourcePoolTestSuite) TestUpdateUnchangedSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	s.pool.UpdateSettings(s.ctx, ResourcePoolSettings{Name: "reporting", MinCpuPercent: 10, MaxCpuPercent: 50, CapCpuPercent: 60, MaxMemoryPercent: 40})
+}
+
+func (s *ResourcePoolTestSuite) TestDrop() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+	expectExactExec(s.mock, "DROP RESOURCE POOL [reporting]; ALTER RESOURCE GOVERNOR RECONFIGURE").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.pool.Drop(s.ctx)
+}
+
+func (s *ResourcePoolTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, `SELECT [name], [min_cpu_percent], [max_cpu_percent], [cap_cpu_percent], [min_memory_percent], [max_memory_percent]
+			FROM sys.resource_governor_resource_pools WHERE [pool_id]=@p1`).
+		WithArgs(s.pool.id)
+}
+
+func (s *ResourcePoolTestSuite) newSettingsRows() *sqlmock.Rows {
+	return newRows("name", "min_cpu_percent", "max_cpu_percent", "cap_cpu_percent", "min_memory_percent", "max_memory_percent").
+		AddRow("reporting", 10, 50, 60, 0, 40)
+}
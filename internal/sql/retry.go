@@ -0,0 +1,431 @@
+package sql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	mssql "github.com/microsoft/go-mssqldb"
+)
+
+// DefaultRetryErrorCodes are SQL Server error numbers of transient failures, raised when the connection or the database
+// is not available, so the failed statement has not been executed and can be safely repeated. Errors raised in the middle
+// of a batch, e.g. deadlocks, are not included, as the whole batch would be executed again.
+var DefaultRetryErrorCodes = []int32{
+	4060,  // cannot open database, e.g. while serverless database is resuming
+	40197, // service error during failover
+	40501, // service busy, throttling
+	40613, // database unavailable
+	49918, // not enough resources to process request
+	49919, // too many create or update operations
+	49920, // too many operations
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: time.Second,
+	MaxBackoff:     30 * time.Second,
+	ErrorCodes:     DefaultRetryErrorCodes,
+}
+
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one. Values lower than 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is doubled after each failed attempt, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// ErrorCodes lists SQL Server error numbers which are retried. Failed statements are executed again as a whole,
+	// so codes of errors raised after part of the batch has been executed should be used only with idempotent statements.
+	ErrorCodes []int32
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+
+	return backoff
+}
+
+func (p RetryPolicy) isRetryable(err error) (int32, bool) {
+	var sqlErr mssql.Error
+	if !errors.As(err, &sqlErr) {
+		return 0, false
+	}
+
+	for _, e := range append(sqlErr.All, sqlErr) {
+		for _, code := range p.ErrorCodes {
+			if e.Number == code {
+				return e.Number, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// run calls fn until it succeeds, fails with non-retryable error or the attempts are exhausted.
+func (p RetryPolicy) run(ctx context.Context, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+
+		code, retryable := p.isRetryable(err)
+		if !retryable || attempt >= p.MaxAttempts {
+			return err
+		}
+
+		backoff := p.backoff(attempt)
+		tflog.Warn(ctx, "Retrying SQL operation after transient error", map[string]any{
+			"error_code":   code,
+			"error":        err.Error(),
+			"attempt":      attempt,
+			"max_attempts": p.MaxAttempts,
+			"backoff":      backoff.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// wrapConnector makes connections opened by the connector, and statements executed using them, retry transient errors
+// and time out after commandTimeout. Statements executed in explicit transactions are not retried, as the failure
+// usually rolls back the whole transaction.
+func wrapConnector(connector driver.Connector, policy RetryPolicy, commandTimeout time.Duration) driver.Connector {
+	if policy.MaxAttempts < 2 && commandTimeout <= 0 {
+		return connector
+	}
+
+	return retryConnector{Connector: connector, policy: policy, commandTimeout: commandTimeout}
+}
+
+type retryConnector struct {
+	driver.Connector
+	policy         RetryPolicy
+	commandTimeout time.Duration
+}
+
+func (c retryConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	var conn driver.Conn
+
+	err := c.policy.run(ctx, func() error {
+		var err error
+		conn, err = c.Connector.Connect(ctx)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &retryConn{conn: conn, policy: c.policy, commandTimeout: c.commandTimeout}, nil
+}
+
+var (
+	_ driver.ExecerContext      = &retryConn{}
+	_ driver.QueryerContext     = &retryConn{}
+	_ driver.ConnPrepareContext = &retryConn{}
+	_ driver.ConnBeginTx        = &retryConn{}
+	_ driver.Pinger             = &retryConn{}
+	_ driver.SessionResetter    = &retryConn{}
+	_ driver.Validator          = &retryConn{}
+	_ driver.NamedValueChecker  = &retryConn{}
+)
+
+type retryConn struct {
+	conn           driver.Conn
+	policy         RetryPolicy
+	commandTimeout time.Duration
+	inTx           bool
+}
+
+// withCommandTimeout returns context limiting single attempt of SQL call.
+func (c *retryConn) withCommandTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.commandTimeout <= 0 {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, c.commandTimeout)
+}
+
+// run retries fn on the same connection. The last error is returned as it is, once the attempts are exhausted, so
+// database/sql does not repeat the call on its own.
+func (c *retryConn) run(ctx context.Context, fn func() error) error {
+	if c.inTx {
+		return fn()
+	}
+
+	return c.policy.run(ctx, fn)
+}
+
+func (c *retryConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *retryConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	var stmt driver.Stmt
+
+	err := c.run(ctx, func() error {
+		var err error
+		if cp, ok := c.conn.(driver.ConnPrepareContext); ok {
+			stmt, err = cp.PrepareContext(ctx, query)
+		} else {
+			stmt, err = c.conn.Prepare(query)
+		}
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &retryStmt{Stmt: stmt, conn: c}, nil
+}
+
+func (c *retryConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	var res driver.Result
+	err := c.run(ctx, func() error {
+		execCtx, cancel := c.withCommandTimeout(ctx)
+		defer cancel()
+
+		var err error
+		res, err = execer.ExecContext(execCtx, query, args)
+		return err
+	})
+
+	return res, err
+}
+
+func (c *retryConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	var rows driver.Rows
+	err := c.run(ctx, func() error {
+		queryCtx, cancel := c.withCommandTimeout(ctx)
+
+		var err error
+		rows, err = queryer.QueryContext(queryCtx, query, args)
+		rows = withCancel(rows, err, cancel)
+		return err
+	})
+
+	return rows, err
+}
+
+func (c *retryConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *retryConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	var tx driver.Tx
+	var err error
+
+	if cb, ok := c.conn.(driver.ConnBeginTx); ok {
+		tx, err = cb.BeginTx(ctx, opts)
+	} else {
+		tx, err = c.conn.Begin()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.inTx = true
+	return retryTx{Tx: tx, conn: c}, nil
+}
+
+func (c *retryConn) Close() error {
+	return c.conn.Close()
+}
+
+func (c *retryConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *retryConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *retryConn) IsValid() bool {
+	if validator, ok := c.conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+	return true
+}
+
+func (c *retryConn) CheckNamedValue(nv *driver.NamedValue) error {
+	if checker, ok := c.conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(nv)
+	}
+	return driver.ErrSkip
+}
+
+type retryTx struct {
+	driver.Tx
+	conn *retryConn
+}
+
+func (tx retryTx) Commit() error {
+	tx.conn.inTx = false
+	return tx.Tx.Commit()
+}
+
+func (tx retryTx) Rollback() error {
+	tx.conn.inTx = false
+	return tx.Tx.Rollback()
+}
+
+type retryStmt struct {
+	driver.Stmt
+	conn *retryConn
+}
+
+func (s *retryStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	var res driver.Result
+
+	err := s.conn.run(ctx, func() error {
+		execCtx, cancel := s.conn.withCommandTimeout(ctx)
+		defer cancel()
+
+		var err error
+		if se, ok := s.Stmt.(driver.StmtExecContext); ok {
+			res, err = se.ExecContext(execCtx, args)
+		} else {
+			res, err = s.Stmt.Exec(namedValuesToValues(args))
+		}
+		return err
+	})
+
+	return res, err
+}
+
+func (s *retryStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	var rows driver.Rows
+
+	err := s.conn.run(ctx, func() error {
+		queryCtx, cancel := s.conn.withCommandTimeout(ctx)
+
+		var err error
+		if sq, ok := s.Stmt.(driver.StmtQueryContext); ok {
+			rows, err = sq.QueryContext(queryCtx, args)
+		} else {
+			rows, err = s.Stmt.Query(namedValuesToValues(args))
+		}
+		rows = withCancel(rows, err, cancel)
+		return err
+	})
+
+	return rows, err
+}
+
+func namedValuesToValues(args []driver.NamedValue) []driver.Value {
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+	return values
+}
+
+// withCancel makes closing the rows cancel the query context, as the rows are read using it after the query returns.
+func withCancel(rows driver.Rows, err error, cancel context.CancelFunc) driver.Rows {
+	if err != nil {
+		cancel()
+		return rows
+	}
+
+	return &cancelRows{Rows: rows, cancel: cancel}
+}
+
+var (
+	_ driver.RowsNextResultSet              = &cancelRows{}
+	_ driver.RowsColumnTypeDatabaseTypeName = &cancelRows{}
+	_ driver.RowsColumnTypeScanType         = &cancelRows{}
+	_ driver.RowsColumnTypeNullable         = &cancelRows{}
+	_ driver.RowsColumnTypeLength           = &cancelRows{}
+	_ driver.RowsColumnTypePrecisionScale   = &cancelRows{}
+)
+
+type cancelRows struct {
+	driver.Rows
+	cancel context.CancelFunc
+}
+
+func (r *cancelRows) Close() error {
+	defer r.cancel()
+	return r.Rows.Close()
+}
+
+func (r *cancelRows) HasNextResultSet() bool {
+	if rs, ok := r.Rows.(driver.RowsNextResultSet); ok {
+		return rs.HasNextResultSet()
+	}
+	return false
+}
+
+func (r *cancelRows) NextResultSet() error {
+	if rs, ok := r.Rows.(driver.RowsNextResultSet); ok {
+		return rs.NextResultSet()
+	}
+	return io.EOF
+}
+
+func (r *cancelRows) ColumnTypeDatabaseTypeName(index int) string {
+	if ct, ok := r.Rows.(driver.RowsColumnTypeDatabaseTypeName); ok {
+		return ct.ColumnTypeDatabaseTypeName(index)
+	}
+	return ""
+}
+
+func (r *cancelRows) ColumnTypeScanType(index int) reflect.Type {
+	if ct, ok := r.Rows.(driver.RowsColumnTypeScanType); ok {
+		return ct.ColumnTypeScanType(index)
+	}
+	return reflect.TypeOf(new(any)).Elem()
+}
+
+func (r *cancelRows) ColumnTypeNullable(index int) (bool, bool) {
+	if ct, ok := r.Rows.(driver.RowsColumnTypeNullable); ok {
+		return ct.ColumnTypeNullable(index)
+	}
+	return false, false
+}
+
+func (r *cancelRows) ColumnTypeLength(index int) (int64, bool) {
+	if ct, ok := r.Rows.(driver.RowsColumnTypeLength); ok {
+		return ct.ColumnTypeLength(index)
+	}
+	return 0, false
+}
+
+func (r *cancelRows) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	if ct, ok := r.Rows.(driver.RowsColumnTypePrecisionScale); ok {
+		return ct.ColumnTypePrecisionScale(index)
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,222 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	mssql "github.com/microsoft/go-mssqldb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     2 * time.Millisecond,
+	ErrorCodes:     DefaultRetryErrorCodes,
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	assert.Equal(t, time.Second, p.backoff(1), "attempt 1")
+	assert.Equal(t, 2*time.Second, p.backoff(2), "attempt 2")
+	assert.Equal(t, 4*time.Second, p.backoff(3), "attempt 3")
+	assert.Equal(t, 5*time.Second, p.backoff(4), "attempt 4")
+	assert.Equal(t, 5*time.Second, p.backoff(10), "attempt 10")
+}
+
+func TestRetryPolicyIsRetryable(t *testing.T) {
+	cases := map[string]struct {
+		err       error
+		retryable bool
+	}{
+		"nil":           {err: nil},
+		"other error":   {err: errors.New("test")},
+		"not listed":    {err: mssql.Error{Number: 208}},
+		"deadlock":      {err: mssql.Error{Number: 1205}},
+		"unavailable":   {err: mssql.Error{Number: 40613}, retryable: true},
+		"wrapped":       {err: errorWrapper{mssql.Error{Number: 40613}}, retryable: true},
+		"earlier error": {err: mssql.Error{Number: 3621, All: []mssql.Error{{Number: 40501}}}, retryable: true},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			_, retryable := testRetryPolicy.isRetryable(tc.err)
+			assert.Equal(t, tc.retryable, retryable)
+		})
+	}
+}
+
+func TestRetryExecSucceedsAfterTransientErrors(t *testing.T) {
+	conn := &fakeRetryConn{errors: []error{mssql.Error{Number: 40501}, mssql.Error{Number: 4060}}}
+	db := sql.OpenDB(wrapConnector(&fakeRetryConnector{conn: conn}, testRetryPolicy, 0))
+
+	_, err := db.ExecContext(context.Background(), "SELECT 1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, conn.calls, "calls")
+}
+
+func TestRetryExecStopsAfterMaxAttempts(t *testing.T) {
+	conn := &fakeRetryConn{errors: []error{mssql.Error{Number: 40613}, mssql.Error{Number: 40613}, mssql.Error{Number: 40613}, nil}}
+	db := sql.OpenDB(wrapConnector(&fakeRetryConnector{conn: conn}, testRetryPolicy, 0))
+
+	_, err := db.ExecContext(context.Background(), "SELECT 1")
+
+	assert.ErrorAs(t, err, &mssql.Error{})
+	assert.Equal(t, 3, conn.calls, "calls")
+}
+
+func TestRetryExecReturnsErrorOfBrokenConnection(t *testing.T) {
+	conn := &fakeRetryConn{errors: []error{mssql.Error{Number: 40613}, mssql.Error{Number: 40613}, mssql.Error{Number: 40613}, nil}, invalid: true}
+	connector := &fakeRetryConnector{conn: conn}
+	db := sql.OpenDB(wrapConnector(connector, testRetryPolicy, 0))
+
+	_, err := db.ExecContext(context.Background(), "SELECT 1")
+
+	assert.ErrorAs(t, err, &mssql.Error{})
+	assert.Equal(t, 3, conn.calls, "calls")
+	assert.Equal(t, 1, connector.calls, "connect calls")
+}
+
+func TestRetryExecDoesNotRetryDeadlockByDefault(t *testing.T) {
+	conn := &fakeRetryConn{errors: []error{mssql.Error{Number: 1205}, nil}}
+	db := sql.OpenDB(wrapConnector(&fakeRetryConnector{conn: conn}, testRetryPolicy, 0))
+
+	_, err := db.ExecContext(context.Background(), "SELECT 1")
+
+	assert.ErrorAs(t, err, &mssql.Error{})
+	assert.Equal(t, 1, conn.calls, "calls")
+}
+
+func TestRetryExecDoesNotRetryOtherErrors(t *testing.T) {
+	conn := &fakeRetryConn{errors: []error{mssql.Error{Number: 208}, nil}}
+	db := sql.OpenDB(wrapConnector(&fakeRetryConnector{conn: conn}, testRetryPolicy, 0))
+
+	_, err := db.ExecContext(context.Background(), "SELECT 1")
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, conn.calls, "calls")
+}
+
+func TestRetryDisabled(t *testing.T) {
+	connector := &fakeRetryConnector{conn: &fakeRetryConn{}}
+
+	assert.Equal(t, connector, wrapConnector(connector, RetryPolicy{MaxAttempts: 1}, 0))
+}
+
+func TestRetryConnect(t *testing.T) {
+	connector := &fakeRetryConnector{conn: &fakeRetryConn{}, errors: []error{mssql.Error{Number: 4060}}}
+	db := sql.OpenDB(wrapConnector(connector, testRetryPolicy, 0))
+
+	_, err := db.ExecContext(context.Background(), "SELECT 1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, connector.calls, "connect calls")
+}
+
+func TestRetryNotUsedInTransaction(t *testing.T) {
+	conn := &fakeRetryConn{errors: []error{mssql.Error{Number: 40501}, nil}}
+	db := sql.OpenDB(wrapConnector(&fakeRetryConnector{conn: conn}, testRetryPolicy, 0))
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+	_, err = tx.Exec("SELECT 1")
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, conn.calls, "calls")
+	assert.NoError(t, tx.Rollback())
+}
+
+func TestCommandTimeout(t *testing.T) {
+	conn := &fakeRetryConn{block: true}
+	db := sql.OpenDB(wrapConnector(&fakeRetryConnector{conn: conn}, RetryPolicy{MaxAttempts: 1}, 10*time.Millisecond))
+
+	_, err := db.ExecContext(context.Background(), "WAITFOR DELAY '01:00'")
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+type errorWrapper struct {
+	err error
+}
+
+func (e errorWrapper) Error() string {
+	return e.err.Error()
+}
+
+func (e errorWrapper) Unwrap() error {
+	return e.err
+}
+
+type fakeRetryConnector struct {
+	conn   *fakeRetryConn
+	errors []error
+	calls  int
+}
+
+func (c *fakeRetryConnector) Connect(context.Context) (driver.Conn, error) {
+	c.calls++
+	if len(c.errors) >= c.calls {
+		return nil, c.errors[c.calls-1]
+	}
+	return c.conn, nil
+}
+
+func (c *fakeRetryConnector) Driver() driver.Driver {
+	return nil
+}
+
+type fakeRetryConn struct {
+	errors []error
+	calls  int
+	// when set, calls wait for context cancellation
+	block bool
+	// when set, the connection reports it is broken
+	invalid bool
+}
+
+func (c *fakeRetryConn) IsValid() bool {
+	return !c.invalid
+}
+
+func (c *fakeRetryConn) ExecContext(ctx context.Context, _ string, _ []driver.NamedValue) (driver.Result, error) {
+	c.calls++
+	if c.block {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	if len(c.errors) >= c.calls {
+		if err := c.errors[c.calls-1]; err != nil {
+			return nil, err
+		}
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (c *fakeRetryConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *fakeRetryConn) Close() error {
+	return nil
+}
+
+func (c *fakeRetryConn) Begin() (driver.Tx, error) {
+	return fakeRetryTx{}, nil
+}
+
+type fakeRetryTx struct{}
+
+func (fakeRetryTx) Commit() error {
+	return nil
+}
+
+func (fakeRetryTx) Rollback() error {
+	return nil
+}
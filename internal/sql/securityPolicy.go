@@ -0,0 +1,289 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"strings"
+)
+
+type SecurityPredicate struct {
+	Type      string
+	Function  string
+	Arguments string
+	Table     string
+	Operation string
+}
+
+func (p SecurityPredicate) key() string {
+	return strings.ToUpper(fmt.Sprintf("%s|%s|%s", p.Type, p.Table, p.Operation))
+}
+
+func (p SecurityPredicate) toSqlTarget() string {
+	target := fmt.Sprintf("%s PREDICATE %s(%s) ON %s", p.Type, quoteSchemaQualifiedName(p.Function), p.Arguments, quoteSchemaQualifiedName(p.Table))
+
+	if p.Operation != "" {
+		target += " " + strings.ReplaceAll(p.Operation, "_", " ")
+	}
+
+	return target
+}
+
+func (p SecurityPredicate) toSqlDropTarget() string {
+	target := fmt.Sprintf("%s PREDICATE ON %s", p.Type, quoteSchemaQualifiedName(p.Table))
+
+	if p.Operation != "" {
+		target += " " + strings.ReplaceAll(p.Operation, "_", " ")
+	}
+
+	return target
+}
+
+// IsEquivalent ignores differences in quoting and casing of identifiers, as predicate definitions are normalized by SQL Server.
+func (p SecurityPredicate) IsEquivalent(other SecurityPredicate) bool {
+	return p.key() == other.key() && p.hasSameDefinition(other)
+}
+
+func (p SecurityPredicate) hasSameDefinition(other SecurityPredicate) bool {
+	normalize := func(s string) string {
+		return strings.ToUpper(strings.NewReplacer("[", "", "]", "", " ", "").Replace(s))
+	}
+
+	return normalize(p.Function) == normalize(other.Function) && normalize(p.Arguments) == normalize(other.Arguments)
+}
+
+type SecurityPolicySettings struct {
+	Name          string
+	SchemaName    string
+	Predicates    []SecurityPredicate
+	Enabled       bool
+	SchemaBinding bool
+}
+
+type SecurityPolicy interface {
+	GetId(context.Context) SecurityPolicyId
+	GetDb(context.Context) Database
+	Exists(context.Context) bool
+	GetSettings(context.Context) SecurityPolicySettings
+	UpdateSettings(context.Context, SecurityPolicySettings)
+	Drop(context.Context)
+}
+
+func GetSecurityPolicy(_ context.Context, db Database, id SecurityPolicyId) SecurityPolicy {
+	return securityPolicy{db: db, id: id}
+}
+
+func CreateSecurityPolicy(ctx context.Context, db Database, settings SecurityPolicySettings) SecurityPolicy {
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) SecurityPolicy {
+		var targets []string
+		for _, pred := range settings.Predicates {
+			targets = append(targets, "ADD "+pred.toSqlTarget())
+		}
+
+		fullName := fmt.Sprintf("[%s].[%s]", settings.SchemaName, settings.Name)
+		stat := fmt.Sprintf("CREATE SECURITY POLICY %s %s WITH (STATE = %s, SCHEMABINDING = %s)",
+			fullName, strings.Join(targets, ", "), onOff(settings.Enabled), onOff(settings.SchemaBinding))
+
+		if _, err := conn.ExecContext(ctx, stat); err != nil {
+			utils.AddError(ctx, "Failed to create security policy", err)
+			return nil
+		}
+
+		var id sql.NullInt32
+		if err := conn.QueryRowContext(ctx, "SELECT OBJECT_ID(@p1)", fullName).Scan(&id); err != nil {
+			utils.AddError(ctx, "Failed to retrieve security policy ID", err)
+			return nil
+		}
+
+		if !id.Valid {
+			utils.AddError(ctx, "Failed to retrieve security policy ID", fmt.Errorf("security policy %s not found", fullName))
+			return nil
+		}
+
+		return GetSecurityPolicy(ctx, db, SecurityPolicyId(id.Int32))
+	})
+}
+
+var _ SecurityPolicy = securityPolicy{}
+
+type securityPolicy struct {
+	db Database
+	id SecurityPolicyId
+}
+
+func (p securityPolicy) GetId(context.Context) SecurityPolicyId {
+	return p.id
+}
+
+func (p securityPolicy) GetDb(context.Context) Database {
+	return p.db
+}
+
+func (p securityPolicy) Exists(ctx context.Context) bool {
+	return WithConnection(ctx, p.db.connect, func(conn *sql.DB) bool {
+		switch _, err := p.getSettingsRaw(ctx, conn); err {
+		case sql.ErrNoRows:
+			return false
+		case nil:
+			return true
+		default:
+			utils.AddError(ctx, "Failed to check if security policy exists", err)
+			return false
+		}
+	})
+}
+
+func (p securityPolicy) GetSettings(ctx context.Context) SecurityPolicySettings {
+	return WithConnection(ctx, p.db.connect, func(conn *sql.DB) SecurityPolicySettings {
+		settings, err := p.getSettingsRaw(ctx, conn)
+		if err != nil {
+			utils.AddError(ctx, "Failed to retrieve security policy settings", err)
+			return settings
+		}
+
+		settings.Predicates = p.getPredicates(ctx, conn)
+		return settings
+	})
+}
+
+func (p securityPolicy) UpdateSettings(ctx context.Context, settings SecurityPolicySettings) {
+	WithConnection(ctx, p.db.connect, func(conn *sql.DB) any {
+		current, err := p.getSettingsRaw(ctx, conn)
+		if err != nil {
+			utils.AddError(ctx, "Failed to retrieve security policy settings", err)
+			return nil
+		}
+
+		currentPredicates := map[string]SecurityPredicate{}
+		for _, pred := range p.getPredicates(ctx, conn) {
+			currentPredicates[pred.key()] = pred
+		}
+
+		if utils.HasError(ctx) {
+			return nil
+		}
+
+		var actions []string
+		for _, pred := range settings.Predicates {
+			cur, exists := currentPredicates[pred.key()]
+			delete(currentPredicates, pred.key())
+
+			switch {
+			case !exists:
+				actions = append(actions, "ADD "+pred.toSqlTarget())
+			case !cur.hasSameDefinition(pred):
+				actions = append(actions, "ALTER "+pred.toSqlTarget())
+			}
+		}
+
+		for _, pred := range currentPredicates {
+			actions = append(actions, "DROP "+pred.toSqlDropTarget())
+		}
+
+		fullName := fmt.Sprintf("[%s].[%s]", current.SchemaName, current.Name)
+
+		if len(actions) > 0 {
+			if _, err := conn.ExecContext(ctx, fmt.Sprintf("ALTER SECURITY POLICY %s %s", fullName, strings.Join(actions, ", "))); err != nil {
+				utils.AddError(ctx, "Failed to update security policy predicates", err)
+				return nil
+			}
+		}
+
+		if current.Enabled != settings.Enabled {
+			if _, err := conn.ExecContext(ctx, fmt.Sprintf("ALTER SECURITY POLICY %s WITH (STATE = %s)", fullName, onOff(settings.Enabled))); err != nil {
+				utils.AddError(ctx, "Failed to update security policy state", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (p securityPolicy) Drop(ctx context.Context) {
+	WithConnection(ctx, p.db.connect, func(conn *sql.DB) any {
+		settings, err := p.getSettingsRaw(ctx, conn)
+		if err != nil {
+			utils.AddError(ctx, "Failed to retrieve security policy settings", err)
+			return nil
+		}
+
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("DROP SECURITY POLICY [%s].[%s]", settings.SchemaName, settings.Name)); err != nil {
+			utils.AddError(ctx, "Failed to drop security policy", err)
+		}
+
+		return nil
+	})
+}
+
+func (p securityPolicy) getSettingsRaw(ctx context.Context, conn *sql.DB) (SecurityPolicySettings, error) {
+	var settings SecurityPolicySettings
+	err := conn.
+		QueryRowContext(ctx, "SELECT [name], SCHEMA_NAME([schema_id]), [is_enabled], [is_schema_bound] FROM sys.security_policies WHERE [object_id]=@p1", p.id).
+		Scan(&settings.Name, &settings.SchemaName, &settings.Enabled, &settings.SchemaBinding)
+	return settings, err
+}
+
+func (p securityPolicy) getPredicates(ctx context.Context, conn *sql.DB) []SecurityPredicate {
+	const errorSummary = "Failed to retrieve security policy predicates"
+	var predicates []SecurityPredicate
+
+	rows, err := conn.QueryContext(ctx, `
+SELECT
+    [predicate_type_desc],
+    [predicate_definition],
+    OBJECT_SCHEMA_NAME([target_object_id]) + '.' + OBJECT_NAME([target_object_id]),
+    ISNULL([operation_desc], '')
+FROM sys.security_predicates
+WHERE [object_id]=@p1`, p.id)
+	if err != nil {
+		utils.AddError(ctx, errorSummary, err)
+		return predicates
+	}
+
+	for rows.Next() {
+		var pred SecurityPredicate
+		var definition string
+
+		if err := rows.Scan(&pred.Type, &definition, &pred.Table, &pred.Operation); err != nil {
+			utils.AddError(ctx, errorSummary, err)
+			return predicates
+		}
+
+		pred.Function, pred.Arguments = parsePredicateDefinition(definition)
+		pred.Operation = strings.ReplaceAll(pred.Operation, " ", "_")
+		predicates = append(predicates, pred)
+	}
+
+	utils.AddError(ctx, errorSummary, rows.Err())
+	return predicates
+}
+
+// parsePredicateDefinition splits definition returned by SQL Server, e.g. `([rls].[fn_tenant]([TenantId]))`,
+// into unquoted schema-qualified function name and raw arguments list.
+func parsePredicateDefinition(definition string) (string, string) {
+	definition = strings.TrimSpace(definition)
+	if strings.HasPrefix(definition, "(") && strings.HasSuffix(definition, ")") {
+		definition = definition[1 : len(definition)-1]
+	}
+
+	argsStart := strings.Index(definition, "(")
+	if argsStart < 0 || !strings.HasSuffix(definition, ")") {
+		return definition, ""
+	}
+
+	function := strings.NewReplacer("[", "", "]", "").Replace(definition[:argsStart])
+	return function, definition[argsStart+1 : len(definition)-1]
+}
+
+func quoteSchemaQualifiedName(name string) string {
+	return strings.Join(quoteIdentifiers(strings.SplitN(name, ".", 2)), ".")
+}
+
+func onOff(value bool) string {
+	if value {
+		return "ON"
+	}
+
+	return "OFF"
+}
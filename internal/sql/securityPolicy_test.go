@@ -0,0 +1,165 @@
+package sql
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestSecurityPolicyTestSuite(t *testing.T) {
+	s := &SecurityPolicyTestSuite{}
+	suite.Run(t, s)
+}
+
+type SecurityPolicyTestSuite struct {
+	SqlTestSuite
+	policy securityPolicy
+}
+
+func (s *SecurityPolicyTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.policy = securityPolicy{db: &s.dbMock, id: SecurityPolicyId(rand.Int())}
+}
+
+func (s *SecurityPolicyTestSuite) TestCreateSecurityPolicy() {
+	expectExactExec(s.mock, "CREATE SECURITY POLICY [rls].[tenant_policy] ADD FILTER PREDICATE [rls].[fn_tenant](TenantId) ON [dbo].[Orders], ADD BLOCK PREDICATE [rls].[fn_tenant](TenantId) ON [dbo].[Orders] AFTER INSERT WITH (STATE = ON, SCHEMABINDING = ON)").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactQuery(s.mock, "SELECT OBJECT_ID(@p1)").
+		WithArgs("[rls].[tenant_policy]").
+		WillReturnRows(newRows("id").AddRow(1234))
+
+	policy := CreateSecurityPolicy(s.ctx, &s.dbMock, SecurityPolicySettings{
+		Name:       "tenant_policy",
+		SchemaName: "rls",
+		Predicates: []SecurityPredicate{
+			{Type: "FILTER", Function: "rls.fn_tenant", Arguments: "TenantId", Table: "dbo.Orders"},
+			{Type: "BLOCK", Function: "rls.fn_tenant", Arguments: "TenantId", Table: "dbo.Orders", Operation: "AFTER_INSERT"},
+		},
+		Enabled:       true,
+		SchemaBinding: true,
+	})
+
+	s.Equal(SecurityPolicyId(1234), policy.GetId(s.ctx))
+}
+
+func (s *SecurityPolicyTestSuite) TestCreateSecurityPolicyNotFound() {
+	expectExactExec(s.mock, "CREATE SECURITY POLICY [rls].[tenant_policy] ADD FILTER PREDICATE [rls].[fn_tenant](TenantId) ON [dbo].[Orders] WITH (STATE = OFF, SCHEMABINDING = OFF)").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactQuery(s.mock, "SELECT OBJECT_ID(@p1)").
+		WithArgs("[rls].[tenant_policy]").
+		WillReturnRows(newRows("id").AddRow(nil))
+
+	policy := CreateSecurityPolicy(s.ctx, &s.dbMock, SecurityPolicySettings{
+		Name:       "tenant_policy",
+		SchemaName: "rls",
+		Predicates: []SecurityPredicate{{Type: "FILTER", Function: "rls.fn_tenant", Arguments: "TenantId", Table: "dbo.Orders"}},
+	})
+
+	s.Nil(policy)
+	s.verifyError(errors.New("security policy [rls].[tenant_policy] not found"))
+}
+
+func (s *SecurityPolicyTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(true))
+
+	s.True(s.policy.Exists(s.ctx))
+}
+
+func (s *SecurityPolicyTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.policy.Exists(s.ctx))
+}
+
+func (s *SecurityPolicyTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(false))
+	s.expectPredicatesQuery().WillReturnRows(s.newPredicateRows().
+		AddRow("FILTER", "([rls].[fn_tenant]([TenantId]))", "dbo.Orders", "").
+		AddRow("BLOCK", "([rls].[fn_tenant]([TenantId]))", "dbo.Orders", "AFTER INSERT"))
+
+	settings := s.policy.GetSettings(s.ctx)
+
+	s.Equal(SecurityPolicySettings{
+		Name:       "tenant_policy",
+		SchemaName: "rls",
+		Predicates: []SecurityPredicate{
+			{Type: "FILTER", Function: "rls.fn_tenant", Arguments: "[TenantId]", Table: "dbo.Orders"},
+			{Type: "BLOCK", Function: "rls.fn_tenant", Arguments: "[TenantId]", Table: "dbo.Orders", Operation: "AFTER_INSERT"},
+		},
+		Enabled:       false,
+		SchemaBinding: true,
+	}, settings)
+}
+
+func (s *SecurityPolicyTestSuite) TestUpdateSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(true))
+	s.expectPredicatesQuery().WillReturnRows(s.newPredicateRows().
+		AddRow("FILTER", "([rls].[fn_tenant]([TenantId]))", "dbo.Orders", "").
+		AddRow("FILTER", "([rls].[fn_tenant]([TenantId]))", "dbo.Invoices", "").
+		AddRow("BLOCK", "([rls].[fn_tenant]([TenantId]))", "dbo.Orders", "AFTER INSERT"))
+	expectExactExec(s.mock, "ALTER SECURITY POLICY [rls].[tenant_policy] ALTER FILTER PREDICATE [rls].[fn_tenant_v2](TenantId) ON [dbo].[Orders], ADD FILTER PREDICATE [rls].[fn_tenant](TenantId) ON [dbo].[Customers], DROP BLOCK PREDICATE ON [dbo].[Orders] AFTER INSERT").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactExec(s.mock, "ALTER SECURITY POLICY [rls].[tenant_policy] WITH (STATE = OFF)").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.policy.UpdateSettings(s.ctx, SecurityPolicySettings{
+		Name:       "tenant_policy",
+		SchemaName: "rls",
+		Predicates: []SecurityPredicate{
+			{Type: "FILTER", Function: "rls.fn_tenant_v2", Arguments: "TenantId", Table: "dbo.Orders"},
+			{Type: "FILTER", Function: "rls.fn_tenant", Arguments: "TenantId", Table: "dbo.Invoices"},
+			{Type: "FILTER", Function: "rls.fn_tenant", Arguments: "TenantId", Table: "dbo.Customers"},
+		},
+		Enabled: false,
+	})
+}
+
+func (s *SecurityPolicyTestSuite) TestDrop() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(true))
+	expectExactExec(s.mock, "DROP SECURITY POLICY [rls].[tenant_policy]").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.policy.Drop(s.ctx)
+}
+
+func (s *SecurityPolicyTestSuite) TestParsePredicateDefinition() {
+	cases := map[string]struct{ function, arguments string }{
+		"([rls].[fn_tenant]([TenantId]))":             {"rls.fn_tenant", "[TenantId]"},
+		"([rls].[fn_tenant]([TenantId],[Region]))":    {"rls.fn_tenant", "[TenantId],[Region]"},
+		"([rls].[fn_session](CONVERT([int],@@spid)))": {"rls.fn_session", "CONVERT([int],@@spid)"},
+		"[dbo].[fn_no_parens]()":                      {"dbo.fn_no_parens", ""},
+	}
+
+	for definition, expected := range cases {
+		function, arguments := parsePredicateDefinition(definition)
+
+		s.Equal(expected.function, function, definition)
+		s.Equal(expected.arguments, arguments, definition)
+	}
+}
+
+func (s *SecurityPolicyTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, "SELECT [name], SCHEMA_NAME([schema_id]), [is_enabled], [is_schema_bound] FROM sys.security_policies WHERE [object_id]=@p1").
+		WithArgs(s.policy.id)
+}
+
+func (s *SecurityPolicyTestSuite) newSettingsRows(enabled bool) *sqlmock.Rows {
+	return newRows("name", "schema_name", "is_enabled", "is_schema_bound").AddRow("tenant_policy", "rls", enabled, true)
+}
+
+func (s *SecurityPolicyTestSuite) expectPredicatesQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, `
+SELECT
+    [predicate_type_desc],
+    [predicate_definition],
+    OBJECT_SCHEMA_NAME([target_object_id]) + '.' + OBJECT_NAME([target_object_id]),
+    ISNULL([operation_desc], '')
+FROM sys.security_predicates
+WHERE [object_id]=@p1`).WithArgs(s.policy.id)
+}
+
+func (s *SecurityPolicyTestSuite) newPredicateRows() *sqlmock.Rows {
+	return newRows("predicate_type_desc", "predicate_definition", "target", "operation_desc")
+}
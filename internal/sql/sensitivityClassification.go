@@ -0,0 +1,193 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"strings"
+)
+
+type SensitivityClassificationSettings struct {
+	Label             string
+	LabelId           string
+	InformationType   string
+	InformationTypeId string
+	Rank              string
+}
+
+func (s SensitivityClassificationSettings) toSqlOptions() string {
+	var options []string
+
+	for _, opt := range []struct{ name, value string }{
+		{"LABEL", s.Label},
+		{"LABEL_ID", s.LabelId},
+		{"INFORMATION_TYPE", s.InformationType},
+		{"INFORMATION_TYPE_ID", s.InformationTypeId},
+	} {
+		if opt.value != "" {
+			options = append(options, fmt.Sprintf("%s = %s", opt.name, quoteString(opt.value)))
+		}
+	}
+
+	if s.Rank != "" {
+		options = append(options, "RANK = "+s.Rank)
+	}
+
+	return strings.Join(options, ", ")
+}
+
+type SensitivityClassification interface {
+	GetTable(context.Context) Table
+	GetColumnId(context.Context) ColumnId
+	Exists(context.Context) bool
+	GetSettings(context.Context) SensitivityClassificationSettings
+	UpdateSettings(context.Context, SensitivityClassificationSettings)
+	Drop(context.Context)
+}
+
+func GetSensitivityClassification(_ context.Context, table Table, columnId ColumnId) SensitivityClassification {
+	return sensitivityClassification{table: table, columnId: columnId}
+}
+
+func GetSensitivityClassifications(ctx context.Context, db Database) []SensitivityClassification {
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) []SensitivityClassification {
+		const errorSummary = "Failed to fetch sensitivity classifications"
+		var classifications []SensitivityClassification
+
+		rows, err := conn.QueryContext(ctx, "SELECT [major_id], [minor_id] FROM sys.sensitivity_classifications WHERE [class]=1")
+		if err != nil {
+			utils.AddError(ctx, errorSummary, err)
+			return classifications
+		}
+
+		for rows.Next() {
+			var (
+				tableId  TableId
+				columnId ColumnId
+			)
+
+			if err := rows.Scan(&tableId, &columnId); err != nil {
+				utils.AddError(ctx, errorSummary, err)
+				return classifications
+			}
+
+			classifications = append(classifications, GetSensitivityClassification(ctx, GetTable(ctx, db, tableId), columnId))
+		}
+
+		utils.AddError(ctx, errorSummary, rows.Err())
+		return classifications
+	})
+}
+
+func CreateSensitivityClassification(ctx context.Context, table Table, columnName string, settings SensitivityClassificationSettings) SensitivityClassification {
+	var classification sensitivityClassification
+
+	utils.StopOnError(ctx).
+		Then(func() {
+			classification = sensitivityClassification{table: table, columnId: table.GetColumnId(ctx, columnName)}
+		}).
+		Then(func() { classification.add(ctx, settings, "Failed to add sensitivity classification") })
+
+	if utils.HasError(ctx) {
+		return nil
+	}
+
+	return classification
+}
+
+var _ SensitivityClassification = sensitivityClassification{}
+
+type sensitivityClassification struct {
+	table    Table
+	columnId ColumnId
+}
+
+func (c sensitivityClassification) GetTable(context.Context) Table {
+	return c.table
+}
+
+func (c sensitivityClassification) GetColumnId(context.Context) ColumnId {
+	return c.columnId
+}
+
+func (c sensitivityClassification) Exists(ctx context.Context) bool {
+	return WithConnection(ctx, c.table.GetDb(ctx).connect, func(conn *sql.DB) bool {
+		switch _, err := c.getSettingsRaw(ctx, conn); err {
+		case sql.ErrNoRows:
+			return false
+		case nil:
+			return true
+		default:
+			utils.AddError(ctx, "Failed to check if sensitivity classification exists", err)
+			return false
+		}
+	})
+}
+
+func (c sensitivityClassification) GetSettings(ctx context.Context) SensitivityClassificationSettings {
+	return WithConnection(ctx, c.table.GetDb(ctx).connect, func(conn *sql.DB) SensitivityClassificationSettings {
+		settings, err := c.getSettingsRaw(ctx, conn)
+		utils.AddError(ctx, "Failed to retrieve sensitivity classification settings", err)
+		return settings
+	})
+}
+
+// UpdateSettings overrides all attributes of the classification, as adding classification to already classified column replaces the existing one.
+func (c sensitivityClassification) UpdateSettings(ctx context.Context, settings SensitivityClassificationSettings) {
+	c.add(ctx, settings, "Failed to update sensitivity classification")
+}
+
+func (c sensitivityClassification) Drop(ctx context.Context) {
+	var columnName string
+
+	utils.StopOnError(ctx).
+		Then(func() { columnName = c.getQuotedColumnName(ctx) }).
+		Then(func() {
+			WithConnection(ctx, c.table.GetDb(ctx).connect, func(conn *sql.DB) any {
+				_, err := conn.ExecContext(ctx, fmt.Sprintf("DROP SENSITIVITY CLASSIFICATION FROM %s", columnName))
+				utils.AddError(ctx, "Failed to drop sensitivity classification", err)
+				return nil
+			})
+		})
+}
+
+func (c sensitivityClassification) add(ctx context.Context, settings SensitivityClassificationSettings, errorSummary string) {
+	var columnName string
+
+	utils.StopOnError(ctx).
+		Then(func() { columnName = c.getQuotedColumnName(ctx) }).
+		Then(func() {
+			WithConnection(ctx, c.table.GetDb(ctx).connect, func(conn *sql.DB) any {
+				_, err := conn.ExecContext(ctx, fmt.Sprintf("ADD SENSITIVITY CLASSIFICATION TO %s WITH (%s)", columnName, settings.toSqlOptions()))
+				utils.AddError(ctx, errorSummary, err)
+				return nil
+			})
+		})
+}
+
+func (c sensitivityClassification) getQuotedColumnName(ctx context.Context) string {
+	var tableName, columnName string
+
+	utils.StopOnError(ctx).
+		Then(func() { tableName = c.table.GetName(ctx) }).
+		Then(func() { columnName = c.table.GetColumnName(ctx, c.columnId) })
+
+	return quoteSchemaQualifiedName(tableName) + "." + quoteIdentifiers([]string{columnName})[0]
+}
+
+func (c sensitivityClassification) getSettingsRaw(ctx context.Context, conn *sql.DB) (SensitivityClassificationSettings, error) {
+	var settings SensitivityClassificationSettings
+	err := conn.
+		QueryRowContext(ctx, `
+SELECT
+    ISNULL([label], ''),
+    ISNULL([label_id], ''),
+    ISNULL([information_type], ''),
+    ISNULL([information_type_id], ''),
+    ISNULL([rank_desc], '')
+FROM sys.sensitivity_classifications
+WHERE [class]=1 AND [major_id]=@p1 AND [minor_id]=@p2`, c.table.GetId(ctx), c.columnId).
+		Scan(&settings.Label, &settings.LabelId, &settings.InformationType, &settings.InformationTypeId, &settings.Rank)
+	return settings, err
+}
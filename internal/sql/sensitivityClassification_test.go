@@ -0,0 +1,122 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestSensitivityClassificationTestSuite(t *testing.T) {
+	s := &SensitivityClassificationTestSuite{}
+	suite.Run(t, s)
+}
+
+type SensitivityClassificationTestSuite struct {
+	SqlTestSuite
+	classification sensitivityClassification
+}
+
+func (s *SensitivityClassificationTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.classification = sensitivityClassification{table: table{db: &s.dbMock, id: 7531}, columnId: 2}
+}
+
+func (s *SensitivityClassificationTestSuite) TestCreateSensitivityClassification() {
+	expectExactQuery(s.mock, "SELECT COLUMNPROPERTY(@p1, @p2, 'ColumnId')").
+		WithArgs(7531, "Email").
+		WillReturnRows(newRows("id").AddRow(2))
+	s.expectColumnLookup()
+	expectExactExec(s.mock, "ADD SENSITIVITY CLASSIFICATION TO [dbo].[Customers].[Email] WITH (LABEL = 'Confidential', INFORMATION_TYPE = 'Contact Info', RANK = MEDIUM)").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	classification := CreateSensitivityClassification(s.ctx, s.classification.table, "Email", SensitivityClassificationSettings{
+		Label:           "Confidential",
+		InformationType: "Contact Info",
+		Rank:            "MEDIUM",
+	})
+
+	s.Equal(ColumnId(2), classification.GetColumnId(s.ctx))
+}
+
+func (s *SensitivityClassificationTestSuite) TestGetSensitivityClassifications() {
+	expectExactQuery(s.mock, "SELECT [major_id], [minor_id] FROM sys.sensitivity_classifications WHERE [class]=1").
+		WillReturnRows(newRows("major_id", "minor_id").AddRow(7531, 2).AddRow(7531, 4).AddRow(8642, 1))
+
+	classifications := GetSensitivityClassifications(s.ctx, &s.dbMock)
+
+	s.Require().Len(classifications, 3)
+	s.Equal(TableId(7531), classifications[1].GetTable(s.ctx).GetId(s.ctx))
+	s.Equal(ColumnId(4), classifications[1].GetColumnId(s.ctx))
+}
+
+func (s *SensitivityClassificationTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	s.True(s.classification.Exists(s.ctx))
+}
+
+func (s *SensitivityClassificationTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.classification.Exists(s.ctx))
+}
+
+func (s *SensitivityClassificationTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	s.Equal(SensitivityClassificationSettings{
+		Label:             "Confidential",
+		LabelId:           "331f0b13-76b5-2f1b-a77b-def5a73c73c2",
+		InformationType:   "Contact Info",
+		InformationTypeId: "",
+		Rank:              "MEDIUM",
+	}, s.classification.GetSettings(s.ctx))
+}
+
+func (s *SensitivityClassificationTestSuite) TestUpdateSettings() {
+	s.expectColumnLookup()
+	expectExactExec(s.mock, "ADD SENSITIVITY CLASSIFICATION TO [dbo].[Customers].[Email] WITH (LABEL = 'Highly Confidential', LABEL_ID = '3302ae7f-b8ac-46bc-97f8-378828781efd', INFORMATION_TYPE_ID = '5c503e21-22c6-81fa-620b-f369b8ec38d1', RANK = HIGH)").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.classification.UpdateSettings(s.ctx, SensitivityClassificationSettings{
+		Label:             "Highly Confidential",
+		LabelId:           "3302ae7f-b8ac-46bc-97f8-378828781efd",
+		InformationTypeId: "5c503e21-22c6-81fa-620b-f369b8ec38d1",
+		Rank:              "HIGH",
+	})
+}
+
+func (s *SensitivityClassificationTestSuite) TestDrop() {
+	s.expectColumnLookup()
+	expectExactExec(s.mock, "DROP SENSITIVITY CLASSIFICATION FROM [dbo].[Customers].[Email]").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.classification.Drop(s.ctx)
+}
+
+func (s *SensitivityClassificationTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, `
+SELECT
+    ISNULL([label], ''),
+    ISNULL([label_id], ''),
+    ISNULL([information_type], ''),
+    ISNULL([information_type_id], ''),
+    ISNULL([rank_desc], '')
+FROM sys.sensitivity_classifications
+WHERE [class]=1 AND [major_id]=@p1 AND [minor_id]=@p2`).WithArgs(7531, 2)
+}
+
+func (s *SensitivityClassificationTestSuite) newSettingsRows() *sqlmock.Rows {
+	return newRows("label", "label_id", "information_type", "information_type_id", "rank_desc").
+		AddRow("Confidential", "331f0b13-76b5-2f1b-a77b-def5a73c73c2", "Contact Info", "", "MEDIUM")
+}
+
+func (s *SensitivityClassificationTestSuite) expectColumnLookup() {
+	expectExactQuery(s.mock, "SELECT OBJECT_SCHEMA_NAME(@p1) + '.' + OBJECT_NAME(@p1)").
+		WithArgs(7531).
+		WillReturnRows(newRows("name").AddRow("dbo.Customers"))
+	expectExactQuery(s.mock, "SELECT COL_NAME(@p1, @p2)").
+		WithArgs(7531, 2).
+		WillReturnRows(newRows("name").AddRow("Email"))
+}
@@ -0,0 +1,201 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"strings"
+)
+
+const (
+	AuditDestinationFile           = "FILE"
+	AuditDestinationApplicationLog = "APPLICATION_LOG"
+	AuditDestinationSecurityLog    = "SECURITY_LOG"
+)
+
+var auditDestinationTypes = map[string]string{
+	"FL": AuditDestinationFile,
+	"AL": AuditDestinationApplicationLog,
+	"SL": AuditDestinationSecurityLog,
+}
+
+var auditOnFailureActions = []string{"CONTINUE", "SHUTDOWN", "FAIL_OPERATION"}
+
+// unlimitedRolloverFiles is reported by sys.server_file_audits when MAX_ROLLOVER_FILES is set to UNLIMITED.
+const unlimitedRolloverFiles = 2147483647
+
+type ServerAuditSettings struct {
+	Name             string
+	Destination      string
+	FilePath         string
+	MaxFileSizeMb    int
+	MaxRolloverFiles int
+	QueueDelay       int
+	OnFailure        string
+	Enabled          bool
+}
+
+func (s ServerAuditSettings) toSqlTarget() string {
+	if s.Destination != AuditDestinationFile {
+		return "TO " + s.Destination
+	}
+
+	limit := func(value int, unit string) string {
+		if value == 0 {
+			return "UNLIMITED"
+		}
+
+		return strings.TrimSpace(fmt.Sprintf("%d %s", value, unit))
+	}
+
+	return fmt.Sprintf("TO FILE (FILEPATH = %s, MAXSIZE = %s, MAX_ROLLOVER_FILES = %s)",
+		quoteString(s.FilePath), limit(s.MaxFileSizeMb, "MB"), limit(s.MaxRolloverFiles, ""))
+}
+
+func (s ServerAuditSettings) toSqlOptions() string {
+	return fmt.Sprintf("WITH (QUEUE_DELAY = %d, ON_FAILURE = %s)", s.QueueDelay, s.OnFailure)
+}
+
+type ServerAudit interface {
+	GetId(context.Context) ServerAuditId
+	Exists(context.Context) bool
+	GetSettings(context.Context) ServerAuditSettings
+	UpdateSettings(context.Context, ServerAuditSettings)
+	Drop(context.Context)
+}
+
+func GetServerAudit(_ context.Context, conn Connection, id ServerAuditId) ServerAudit {
+	return serverAudit{conn: conn, id: id}
+}
+
+func GetServerAuditByName(ctx context.Context, conn Connection, name string) ServerAudit {
+	var id ServerAuditId
+
+	err := conn.getSqlConnection(ctx).QueryRowContext(ctx, "SELECT [audit_id] FROM sys.server_audits WHERE [name]=@p1", name).Scan(&id)
+	if err != nil {
+		utils.AddError(ctx, fmt.Sprintf("Failed to retrieve server audit ID for name '%s'", name), err)
+		return nil
+	}
+
+	return GetServerAudit(ctx, conn, id)
+}
+
+func CreateServerAudit(ctx context.Context, conn Connection, settings ServerAuditSettings) ServerAudit {
+	var audit ServerAudit
+
+	utils.StopOnError(ctx).
+		Then(func() {
+			conn.exec(ctx, fmt.Sprintf("CREATE SERVER AUDIT [%s] %s %s", settings.Name, settings.toSqlTarget(), settings.toSqlOptions()))
+		}).
+		Then(func() { audit = GetServerAuditByName(ctx, conn, settings.Name) }).
+		Then(func() {
+			if settings.Enabled {
+				conn.exec(ctx, fmt.Sprintf("ALTER SERVER AUDIT [%s] WITH (STATE = ON)", settings.Name))
+			}
+		})
+
+	return audit
+}
+
+var _ ServerAudit = serverAudit{}
+
+type serverAudit struct {
+	conn Connection
+	id   ServerAuditId
+}
+
+func (a serverAudit) GetId(context.Context) ServerAuditId {
+	return a.id
+}
+
+func (a serverAudit) Exists(ctx context.Context) bool {
+	switch _, err := a.getSettingsRaw(ctx); err {
+	case sql.ErrNoRows:
+		return false
+	case nil:
+		return true
+	default:
+		utils.AddError(ctx, "Failed to check if server audit exists", err)
+		return false
+	}
+}
+
+func (a serverAudit) GetSettings(ctx context.Context) ServerAuditSettings {
+	settings, err := a.getSettingsRaw(ctx)
+	utils.AddError(ctx, "Failed to retrieve server audit settings", err)
+	return settings
+}
+
+// UpdateSettings disables the audit for the time of the change, as SQL Server does not allow altering enabled audits.
+func (a serverAudit) UpdateSettings(ctx context.Context, settings ServerAuditSettings) {
+	var current ServerAuditSettings
+
+	utils.StopOnError(ctx).
+		Then(func() { current = a.GetSettings(ctx) }).
+		Then(func() {
+			if current.Enabled {
+				a.conn.exec(ctx, fmt.Sprintf("ALTER SERVER AUDIT [%s] WITH (STATE = OFF)", current.Name))
+			}
+		}).
+		Then(func() {
+			target, options := settings.toSqlTarget(), settings.toSqlOptions()
+			if target != current.toSqlTarget() || options != current.toSqlOptions() {
+				a.conn.exec(ctx, fmt.Sprintf("ALTER SERVER AUDIT [%s] %s %s", current.Name, target, options))
+			}
+		}).
+		Then(func() {
+			if settings.Enabled {
+				a.conn.exec(ctx, fmt.Sprintf("ALTER SERVER AUDIT [%s] WITH (STATE = ON)", current.Name))
+			}
+		})
+}
+
+func (a serverAudit) Drop(ctx context.Context) {
+	var settings ServerAuditSettings
+
+	utils.StopOnError(ctx).
+		Then(func() { settings = a.GetSettings(ctx) }).
+		Then(func() {
+			if settings.Enabled {
+				a.conn.exec(ctx, fmt.Sprintf("ALTER SERVER AUDIT [%s] WITH (STATE = OFF)", settings.Name))
+			}
+		}).
+		Then(func() { a.conn.exec(ctx, fmt.Sprintf("DROP SERVER AUDIT [%s]", settings.Name)) })
+}
+
+func (a serverAudit) getSettingsRaw(ctx context.Context) (ServerAuditSettings, error) {
+	var (
+		settings  ServerAuditSettings
+		auditType string
+		onFailure int
+	)
+
+	err := a.conn.getSqlConnection(ctx).
+		QueryRowContext(ctx, `
+SELECT
+    a.[name],
+    a.[type],
+    ISNULL(f.[log_file_path], ''),
+    ISNULL(f.[max_file_size], 0),
+    ISNULL(f.[max_rollover_files], 0),
+    a.[queue_delay],
+    a.[on_failure],
+    a.[is_state_enabled]
+FROM sys.server_audits a
+LEFT JOIN sys.server_file_audits f ON f.[audit_id] = a.[audit_id]
+WHERE a.[audit_id]=@p1`, a.id).
+		Scan(&settings.Name, &auditType, &settings.FilePath, &settings.MaxFileSizeMb, &settings.MaxRolloverFiles, &settings.QueueDelay, &onFailure, &settings.Enabled)
+
+	settings.Destination = auditDestinationTypes[auditType]
+
+	if onFailure >= 0 && onFailure < len(auditOnFailureActions) {
+		settings.OnFailure = auditOnFailureActions[onFailure]
+	}
+
+	if settings.MaxRolloverFiles == unlimitedRolloverFiles {
+		settings.MaxRolloverFiles = 0
+	}
+
+	return settings, err
+}
@@ -0,0 +1,196 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"strings"
+)
+
+type ServerAuditSpecificationSettings struct {
+	Name         string
+	AuditId      ServerAuditId
+	ActionGroups []string
+	Enabled      bool
+}
+
+type ServerAuditSpecification interface {
+	GetId(context.Context) ServerAuditSpecificationId
+	Exists(context.Context) bool
+	GetSettings(context.Context) ServerAuditSpecificationSettings
+	UpdateSettings(context.Context, ServerAuditSpecificationSettings)
+	Drop(context.Context)
+}
+
+func GetServerAuditSpecification(_ context.Context, conn Connection, id ServerAuditSpecificationId) ServerAuditSpecification {
+	return serverAuditSpecification{conn: conn, id: id}
+}
+
+func CreateServerAuditSpecification(ctx context.Context, conn Connection, settings ServerAuditSpecificationSettings) ServerAuditSpecification {
+	var (
+		auditName string
+		id        ServerAuditSpecificationId
+	)
+
+	utils.StopOnError(ctx).
+		Then(func() { auditName = GetServerAudit(ctx, conn, settings.AuditId).GetSettings(ctx).Name }).
+		Then(func() {
+			var actions []string
+			for _, group := range settings.ActionGroups {
+				actions = append(actions, fmt.Sprintf("ADD (%s)", group))
+			}
+
+			conn.exec(ctx, fmt.Sprintf("CREATE SERVER AUDIT SPECIFICATION [%s] FOR SERVER AUDIT [%s] %s WITH (STATE = %s)",
+				settings.Name, auditName, strings.Join(actions, ", "), onOff(settings.Enabled)))
+		}).
+		Then(func() {
+			err := conn.getSqlConnection(ctx).
+				QueryRowContext(ctx, "SELECT [server_specification_id] FROM sys.server_audit_specifications WHERE [name]=@p1", settings.Name).
+				Scan(&id)
+			utils.AddError(ctx, "Failed to retrieve server audit specification ID", err)
+		})
+
+	if utils.HasError(ctx) {
+		return nil
+	}
+
+	return GetServerAuditSpecification(ctx, conn, id)
+}
+
+var _ ServerAuditSpecification = serverAuditSpecification{}
+
+type serverAuditSpecification struct {
+	conn Connection
+	id   ServerAuditSpecificationId
+}
+
+func (s serverAuditSpecification) GetId(context.Context) ServerAuditSpecificationId {
+	return s.id
+}
+
+func (s serverAuditSpecification) Exists(ctx context.Context) bool {
+	switch _, err := s.getSettingsRaw(ctx); err {
+	case sql.ErrNoRows:
+		return false
+	case nil:
+		return true
+	default:
+		utils.AddError(ctx, "Failed to check if server audit specification exists", err)
+		return false
+	}
+}
+
+func (s serverAuditSpecification) GetSettings(ctx context.Context) ServerAuditSpecificationSettings {
+	settings, err := s.getSettingsRaw(ctx)
+	if err != nil {
+		utils.AddError(ctx, "Failed to retrieve server audit specification settings", err)
+		return settings
+	}
+
+	settings.ActionGroups = s.getActionGroups(ctx)
+	return settings
+}
+
+// UpdateSettings disables the specification for the time of the change, as SQL Server does not allow altering enabled specifications.
+func (s serverAuditSpecification) UpdateSettings(ctx context.Context, settings ServerAuditSpecificationSettings) {
+	var (
+		current   ServerAuditSpecificationSettings
+		auditName string
+	)
+
+	utils.StopOnError(ctx).
+		Then(func() { current = s.GetSettings(ctx) }).
+		Then(func() { auditName = GetServerAudit(ctx, s.conn, settings.AuditId).GetSettings(ctx).Name }).
+		Then(func() {
+			if current.Enabled {
+				s.conn.exec(ctx, fmt.Sprintf("ALTER SERVER AUDIT SPECIFICATION [%s] WITH (STATE = OFF)", current.Name))
+			}
+		}).
+		Then(func() {
+			actions := diffActionGroups(current.ActionGroups, settings.ActionGroups)
+			if len(actions) > 0 || current.AuditId != settings.AuditId {
+				s.conn.exec(ctx, strings.TrimSpace(fmt.Sprintf("ALTER SERVER AUDIT SPECIFICATION [%s] FOR SERVER AUDIT [%s] %s", current.Name, auditName, strings.Join(actions, ", "))))
+			}
+		}).
+		Then(func() {
+			if settings.Enabled {
+				s.conn.exec(ctx, fmt.Sprintf("ALTER SERVER AUDIT SPECIFICATION [%s] WITH (STATE = ON)", current.Name))
+			}
+		})
+}
+
+func (s serverAuditSpecification) Drop(ctx context.Context) {
+	var settings ServerAuditSpecificationSettings
+
+	utils.StopOnError(ctx).
+		Then(func() { settings = s.GetSettings(ctx) }).
+		Then(func() {
+			if settings.Enabled {
+				s.conn.exec(ctx, fmt.Sprintf("ALTER SERVER AUDIT SPECIFICATION [%s] WITH (STATE = OFF)", settings.Name))
+			}
+		}).
+		Then(func() { s.conn.exec(ctx, fmt.Sprintf("DROP SERVER AUDIT SPECIFICATION [%s]", settings.Name)) })
+}
+
+func (s serverAuditSpecification) getSettingsRaw(ctx context.Context) (ServerAuditSpecificationSettings, error) {
+	var settings ServerAuditSpecificationSettings
+	err := s.conn.getSqlConnection(ctx).
+		QueryRowContext(ctx, `
+SELECT s.[name], a.[audit_id], s.[is_state_enabled]
+FROM sys.server_audit_specifications s
+INNER JOIN sys.server_audits a ON a.[audit_guid] = s.[audit_guid]
+WHERE s.[server_specification_id]=@p1`, s.id).
+		Scan(&settings.Name, &settings.AuditId, &settings.Enabled)
+	return settings, err
+}
+
+func (s serverAuditSpecification) getActionGroups(ctx context.Context) []string {
+	const errorSummary = "Failed to retrieve server audit specification action groups"
+	var groups []string
+
+	rows, err := s.conn.getSqlConnection(ctx).
+		QueryContext(ctx, "SELECT [audit_action_name] FROM sys.server_audit_specification_details WHERE [server_specification_id]=@p1", s.id)
+	if err != nil {
+		utils.AddError(ctx, errorSummary, err)
+		return groups
+	}
+
+	for rows.Next() {
+		var group string
+		if err := rows.Scan(&group); err != nil {
+			utils.AddError(ctx, errorSummary, err)
+			return groups
+		}
+		groups = append(groups, group)
+	}
+
+	utils.AddError(ctx, errorSummary, rows.Err())
+	return groups
+}
+
+// diffActionGroups returns ADD and DROP clauses transforming current set of action groups into the desired one.
+func diffActionGroups(current []string, desired []string) []string {
+	var actions []string
+	remaining := map[string]bool{}
+
+	for _, group := range current {
+		remaining[strings.ToUpper(group)] = true
+	}
+
+	for _, group := range desired {
+		if remaining[strings.ToUpper(group)] {
+			delete(remaining, strings.ToUpper(group))
+		} else {
+			actions = append(actions, fmt.Sprintf("ADD (%s)", group))
+		}
+	}
+
+	for _, group := range current {
+		if remaining[strings.ToUpper(group)] {
+			actions = append(actions, fmt.Sprintf("DROP (%s)", group))
+		}
+	}
+
+	return actions
+}
@@ -0,0 +1,128 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestServerAuditSpecificationTestSuite(t *testing.T) {
+	s := &ServerAuditSpecificationTestSuite{}
+	suite.Run(t, s)
+}
+
+type ServerAuditSpecificationTestSuite struct {
+	SqlTestSuite
+	spec serverAuditSpecification
+}
+
+func (s *ServerAuditSpecificationTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.spec = serverAuditSpecification{conn: s.connMock, id: ServerAuditSpecificationId(rand.Int())}
+}
+
+func (s *ServerAuditSpecificationTestSuite) TestCreate() {
+	s.expectAuditNameQuery(65536, "test_audit")
+	expectExactExec(s.mock, "CREATE SERVER AUDIT SPECIFICATION [test_spec] FOR SERVER AUDIT [test_audit] ADD (FAILED_LOGIN_GROUP), ADD (SUCCESSFUL_LOGIN_GROUP) WITH (STATE = ON)").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactQuery(s.mock, "SELECT [server_specification_id] FROM sys.server_audit_specifications WHERE [name]=@p1").
+		WithArgs("test_spec").
+		WillReturnRows(newRows("server_specification_id").AddRow(12))
+
+	spec := CreateServerAuditSpecification(s.ctx, s.connMock, ServerAuditSpecificationSettings{
+		Name:         "test_spec",
+		AuditId:      65536,
+		ActionGroups: []string{"FAILED_LOGIN_GROUP", "SUCCESSFUL_LOGIN_GROUP"},
+		Enabled:      true,
+	})
+
+	s.Equal(ServerAuditSpecificationId(12), spec.GetId(s.ctx))
+}
+
+func (s *ServerAuditSpecificationTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(true))
+
+	s.True(s.spec.Exists(s.ctx))
+}
+
+func (s *ServerAuditSpecificationTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.spec.Exists(s.ctx))
+}
+
+func (s *ServerAuditSpecificationTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(false))
+	s.expectActionGroupsQuery()
+
+	s.Equal(ServerAuditSpecificationSettings{
+		Name:         "test_spec",
+		AuditId:      65536,
+		ActionGroups: []string{"FAILED_LOGIN_GROUP", "SUCCESSFUL_LOGIN_GROUP"},
+		Enabled:      false,
+	}, s.spec.GetSettings(s.ctx))
+}
+
+func (s *ServerAuditSpecificationTestSuite) TestUpdateSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(true))
+	s.expectActionGroupsQuery()
+	s.expectAuditNameQuery(65536, "test_audit")
+	expectExactExec(s.mock, "ALTER SERVER AUDIT SPECIFICATION [test_spec] WITH (STATE = OFF)").WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactExec(s.mock, "ALTER SERVER AUDIT SPECIFICATION [test_spec] FOR SERVER AUDIT [test_audit] ADD (SERVER_ROLE_MEMBER_CHANGE_GROUP), DROP (SUCCESSFUL_LOGIN_GROUP)").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactExec(s.mock, "ALTER SERVER AUDIT SPECIFICATION [test_spec] WITH (STATE = ON)").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.spec.UpdateSettings(s.ctx, ServerAuditSpecificationSettings{
+		Name:         "test_spec",
+		AuditId:      65536,
+		ActionGroups: []string{"failed_login_group", "SERVER_ROLE_MEMBER_CHANGE_GROUP"},
+		Enabled:      true,
+	})
+}
+
+func (s *ServerAuditSpecificationTestSuite) TestDrop() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(false))
+	s.expectActionGroupsQuery()
+	expectExactExec(s.mock, "DROP SERVER AUDIT SPECIFICATION [test_spec]").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.spec.Drop(s.ctx)
+}
+
+func (s *ServerAuditSpecificationTestSuite) expectAuditNameQuery(id int, name string) {
+	expectExactQuery(s.mock, `
+SELECT
+    a.[name],
+    a.[type],
+    ISNULL(f.[log_file_path], ''),
+    ISNULL(f.[max_file_size], 0),
+    ISNULL(f.[max_rollover_files], 0),
+    a.[queue_delay],
+    a.[on_failure],
+    a.[is_state_enabled]
+FROM sys.server_audits a
+LEFT JOIN sys.server_file_audits f ON f.[audit_id] = a.[audit_id]
+WHERE a.[audit_id]=@p1`).
+		WithArgs(id).
+		WillReturnRows(newRows("name", "type", "log_file_path", "max_file_size", "max_rollover_files", "queue_delay", "on_failure", "is_state_enabled").
+			AddRow(name, "AL", "", 0, 0, 1000, 0, true))
+}
+
+func (s *ServerAuditSpecificationTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, `
+SELECT s.[name], a.[audit_id], s.[is_state_enabled]
+FROM sys.server_audit_specifications s
+INNER JOIN sys.server_audits a ON a.[audit_guid] = s.[audit_guid]
+WHERE s.[server_specification_id]=@p1`).WithArgs(s.spec.id)
+}
+
+func (s *ServerAuditSpecificationTestSuite) newSettingsRows(enabled bool) *sqlmock.Rows {
+	return newRows("name", "audit_id", "is_state_enabled").AddRow("test_spec", 65536, enabled)
+}
+
+func (s *ServerAuditSpecificationTestSuite) expectActionGroupsQuery() {
+	expectExactQuery(s.mock, "SELECT [audit_action_name] FROM sys.server_audit_specification_details WHERE [server_specification_id]=@p1").
+		WithArgs(s.spec.id).
+		WillReturnRows(newRows("audit_action_name").AddRow("FAILED_LOGIN_GROUP").AddRow("SUCCESSFUL_LOGIN_GROUP"))
+}
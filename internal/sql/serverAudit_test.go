@@ -0,0 +1,152 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestServerAuditTestSuite(t *testing.T) {
+	s := &ServerAuditTestSuite{}
+	suite.Run(t, s)
+}
+
+type ServerAuditTestSuite struct {
+	SqlTestSuite
+	audit serverAudit
+}
+
+func (s *ServerAuditTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.audit = serverAudit{conn: s.connMock, id: ServerAuditId(rand.Int())}
+}
+
+func (s *ServerAuditTestSuite) TestCreateFileAudit() {
+	expectExactExec(s.mock, "CREATE SERVER AUDIT [test_audit] TO FILE (FILEPATH = '/var/opt/mssql/audit/', MAXSIZE = 100 MB, MAX_ROLLOVER_FILES = UNLIMITED) WITH (QUEUE_DELAY = 1000, ON_FAILURE = CONTINUE)").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectAuditIdQuery("test_audit", 65536)
+	expectExactExec(s.mock, "ALTER SERVER AUDIT [test_audit] WITH (STATE = ON)").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	audit := CreateServerAudit(s.ctx, s.connMock, ServerAuditSettings{
+		Name:          "test_audit",
+		Destination:   AuditDestinationFile,
+		FilePath:      "/var/opt/mssql/audit/",
+		MaxFileSizeMb: 100,
+		QueueDelay:    1000,
+		OnFailure:     "CONTINUE",
+		Enabled:       true,
+	})
+
+	s.Equal(ServerAuditId(65536), audit.GetId(s.ctx))
+}
+
+func (s *ServerAuditTestSuite) TestCreateDisabledApplicationLogAudit() {
+	expectExactExec(s.mock, "CREATE SERVER AUDIT [test_audit] TO APPLICATION_LOG WITH (QUEUE_DELAY = 0, ON_FAILURE = FAIL_OPERATION)").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectAuditIdQuery("test_audit", 65537)
+
+	audit := CreateServerAudit(s.ctx, s.connMock, ServerAuditSettings{
+		Name:        "test_audit",
+		Destination: AuditDestinationApplicationLog,
+		OnFailure:   "FAIL_OPERATION",
+	})
+
+	s.Equal(ServerAuditId(65537), audit.GetId(s.ctx))
+}
+
+func (s *ServerAuditTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(true))
+
+	s.True(s.audit.Exists(s.ctx))
+}
+
+func (s *ServerAuditTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.audit.Exists(s.ctx))
+}
+
+func (s *ServerAuditTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(true))
+
+	s.Equal(ServerAuditSettings{
+		Name:          "test_audit",
+		Destination:   AuditDestinationFile,
+		FilePath:      "/var/opt/mssql/audit/",
+		MaxFileSizeMb: 100,
+		QueueDelay:    1000,
+		OnFailure:     "SHUTDOWN",
+		Enabled:       true,
+	}, s.audit.GetSettings(s.ctx))
+}
+
+func (s *ServerAuditTestSuite) TestUpdateSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(true))
+	expectExactExec(s.mock, "ALTER SERVER AUDIT [test_audit] WITH (STATE = OFF)").WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactExec(s.mock, "ALTER SERVER AUDIT [test_audit] TO FILE (FILEPATH = '/var/opt/mssql/audit/', MAXSIZE = 100 MB, MAX_ROLLOVER_FILES = 5) WITH (QUEUE_DELAY = 1000, ON_FAILURE = SHUTDOWN)").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactExec(s.mock, "ALTER SERVER AUDIT [test_audit] WITH (STATE = ON)").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.audit.UpdateSettings(s.ctx, ServerAuditSettings{
+		Name:             "test_audit",
+		Destination:      AuditDestinationFile,
+		FilePath:         "/var/opt/mssql/audit/",
+		MaxFileSizeMb:    100,
+		MaxRolloverFiles: 5,
+		QueueDelay:       1000,
+		OnFailure:        "SHUTDOWN",
+		Enabled:          true,
+	})
+}
+
+func (s *ServerAuditTestSuite) TestUpdateStateOnly() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(true))
+	expectExactExec(s.mock, "ALTER SERVER AUDIT [test_audit] WITH (STATE = OFF)").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.audit.UpdateSettings(s.ctx, ServerAuditSettings{
+		Name:          "test_audit",
+		Destination:   AuditDestinationFile,
+		FilePath:      "/var/opt/mssql/audit/",
+		MaxFileSizeMb: 100,
+		QueueDelay:    1000,
+		OnFailure:     "SHUTDOWN",
+		Enabled:       false,
+	})
+}
+
+func (s *ServerAuditTestSuite) TestDrop() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows(true))
+	expectExactExec(s.mock, "ALTER SERVER AUDIT [test_audit] WITH (STATE = OFF)").WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactExec(s.mock, "DROP SERVER AUDIT [test_audit]").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.audit.Drop(s.ctx)
+}
+
+func (s *ServerAuditTestSuite) expectAuditIdQuery(name string, id int) {
+	expectExactQuery(s.mock, "SELECT [audit_id] FROM sys.server_audits WHERE [name]=@p1").
+		WithArgs(name).
+		WillReturnRows(newRows("audit_id").AddRow(id))
+}
+
+func (s *ServerAuditTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, `
+SELECT
+    a.[name],
+    a.[type],
+    ISNULL(f.[log_file_path], ''),
+    ISNULL(f.[max_file_size], 0),
+    ISNULL(f.[max_rollover_files], 0),
+    a.[queue_delay],
+    a.[on_failure],
+    a.[is_state_enabled]
+FROM sys.server_audits a
+LEFT JOIN sys.server_file_audits f ON f.[audit_id] = a.[audit_id]
+WHERE a.[audit_id]=@p1`).WithArgs(s.audit.id)
+}
+
+func (s *ServerAuditTestSuite) newSettingsRows(enabled bool) *sqlmock.Rows {
+	return newRows("name", "type", "log_file_path", "max_file_size", "max_rollover_files", "queue_delay", "on_failure", "is_state_enabled").
+		AddRow("test_audit", "FL", "/var/opt/mssql/audit/", 100, 2147483647, 1000, 1, enabled)
+}
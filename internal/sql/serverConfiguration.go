@@ -0,0 +1,130 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"sort"
+	"strings"
+)
+
+const showAdvancedOptions = "show advanced options"
+
+type ServerConfigurationOption struct {
+	Name       string
+	Value      int64
+	ValueInUse int64
+	IsDynamic  bool
+	IsAdvanced bool
+}
+
+// RestartRequired reports whether the configured value has not been applied yet and will take effect only after the server restarts.
+func (o ServerConfigurationOption) RestartRequired() bool {
+	return o.Value != o.ValueInUse && !o.IsDynamic
+}
+
+// GetServerConfigurationOptions returns options with given names, matched case-insensitively, keyed by the name used in the request.
+func GetServerConfigurationOptions(ctx context.Context, conn Connection, names []string) map[string]ServerConfigurationOption {
+	requested := map[string][]string{}
+	for _, name := range names {
+		requested[strings.ToLower(name)] = append(requested[strings.ToLower(name)], name)
+	}
+
+	rows, err := conn.getSqlConnection(ctx).QueryContext(ctx, "SELECT [name], CONVERT(BIGINT, [value]), CONVERT(BIGINT, [value_in_use]), [is_dynamic], [is_advanced] FROM sys.configurations")
+	if err != nil {
+		utils.AddError(ctx, "Failed to read server configuration", err)
+		return nil
+	}
+	defer rows.Close()
+
+	options := map[string]ServerConfigurationOption{}
+	for rows.Next() {
+		var opt ServerConfigurationOption
+		if err := rows.Scan(&opt.Name, &opt.Value, &opt.ValueInUse, &opt.IsDynamic, &opt.IsAdvanced); err != nil {
+			utils.AddError(ctx, "Failed to read server configuration", err)
+			return nil
+		}
+
+		for _, name := range requested[strings.ToLower(opt.Name)] {
+			options[name] = opt
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.AddError(ctx, "Failed to read server configuration", err)
+		return nil
+	}
+
+	for _, name := range names {
+		if _, ok := options[name]; !ok {
+			utils.AddError(ctx, "Unknown server configuration option", fmt.Errorf("option '%s' does not exist in sys.configurations", name))
+		}
+	}
+
+	return options
+}
+
+// SetServerConfigurationOptions changes values of given options using sp_configure and applies them with RECONFIGURE.
+// When any of changed options is advanced, 'show advanced options' is temporarily enabled.
+func SetServerConfigurationOptions(ctx context.Context, conn Connection, values map[string]int64) {
+	var names []string
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var (
+		current       map[string]ServerConfigurationOption
+		changed       []string
+		needsAdvanced bool
+		showAdvanced  bool
+		advancedShown bool
+	)
+
+	configure := func(name string, value int64) {
+		conn.exec(ctx, "EXEC sp_configure @configname=@p1, @configvalue=@p2", name, value)
+	}
+
+	reconfigure := func() {
+		conn.exec(ctx, "RECONFIGURE")
+	}
+
+	utils.StopOnError(ctx).
+		Then(func() { current = GetServerConfigurationOptions(ctx, conn, append(names, showAdvancedOptions)) }).
+		Then(func() {
+			for _, name := range names {
+				if opt := current[name]; opt.Value != values[name] {
+					changed = append(changed, name)
+					needsAdvanced = needsAdvanced || opt.IsAdvanced
+				}
+			}
+
+			_, managesShowAdvanced := values[showAdvancedOptions]
+			showAdvanced = needsAdvanced && !managesShowAdvanced && current[showAdvancedOptions].ValueInUse == 0
+		}).
+		Then(func() {
+			if showAdvanced {
+				configure(showAdvancedOptions, 1)
+				advancedShown = !utils.HasError(ctx)
+				reconfigure()
+			}
+		}).
+		Then(func() {
+			for _, name := range changed {
+				configure(name, values[name])
+				if utils.HasError(ctx) {
+					return
+				}
+			}
+
+			if len(changed) > 0 {
+				reconfigure()
+			}
+		})
+
+	// Advanced options are hidden again even when setting the options failed, so they are not left exposed
+	if advancedShown {
+		configure(showAdvancedOptions, 0)
+		reconfigure()
+	}
+}
@@ -0,0 +1,119 @@
+package sql
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestServerConfigurationTestSuite(t *testing.T) {
+	s := &ServerConfigurationTestSuite{}
+	suite.Run(t, s)
+}
+
+type ServerConfigurationTestSuite struct {
+	SqlTestSuite
+}
+
+func (s *ServerConfigurationTestSuite) TestGetOptions() {
+	s.expectOptionsQuery().WillReturnRows(s.newOptionsRows(false))
+
+	options := GetServerConfigurationOptions(s.ctx, s.connMock, []string{"Max Server Memory (MB)", "optimize for ad hoc workloads"})
+
+	s.Equal(map[string]ServerConfigurationOption{
+		"Max Server Memory (MB)":        {Name: "max server memory (MB)", Value: 4096, ValueInUse: 4096, IsDynamic: true, IsAdvanced: true},
+		"optimize for ad hoc workloads": {Name: "optimize for ad hoc workloads", Value: 0, ValueInUse: 0, IsDynamic: true, IsAdvanced: true},
+	}, options)
+}
+
+func (s *ServerConfigurationTestSuite) TestGetUnknownOption() {
+	s.expectOptionsQuery().WillReturnRows(s.newOptionsRows(false))
+
+	GetServerConfigurationOptions(s.ctx, s.connMock, []string{"max memory"})
+
+	s.verifyError(errors.New("option 'max memory' does not exist in sys.configurations"))
+}
+
+func (s *ServerConfigurationTestSuite) TestSetBasicOption() {
+	s.expectOptionsQuery().WillReturnRows(s.newOptionsRows(false))
+	s.expectConfigure("contained database authentication", 1)
+	s.expectReconfigure()
+
+	SetServerConfigurationOptions(s.ctx, s.connMock, map[string]int64{"contained database authentication": 1})
+}
+
+func (s *ServerConfigurationTestSuite) TestSetAdvancedOptionEnablesAdvancedOptionsTemporarily() {
+	s.expectOptionsQuery().WillReturnRows(s.newOptionsRows(false))
+	s.expectConfigure(showAdvancedOptions, 1)
+	s.expectReconfigure()
+	s.expectConfigure("max server memory (MB)", 8192)
+	s.expectConfigure("optimize for ad hoc workloads", 1)
+	s.expectReconfigure()
+	s.expectConfigure(showAdvancedOptions, 0)
+	s.expectReconfigure()
+
+	SetServerConfigurationOptions(s.ctx, s.connMock, map[string]int64{"optimize for ad hoc workloads": 1, "max server memory (MB)": 8192})
+}
+
+func (s *ServerConfigurationTestSuite) TestSetAdvancedOptionFailureHidesAdvancedOptions() {
+	s.expectOptionsQuery().WillReturnRows(s.newOptionsRows(false))
+	s.expectConfigure(showAdvancedOptions, 1)
+	s.expectReconfigure()
+	expectExactExec(s.mock, "EXEC sp_configure @configname=@p1, @configvalue=@p2").
+		WithArgs("max server memory (MB)", 8192).
+		WillReturnError(errors.New("test_error"))
+	s.expectConfigure(showAdvancedOptions, 0)
+	s.expectReconfigure()
+
+	SetServerConfigurationOptions(s.ctx, s.connMock, map[string]int64{"max server memory (MB)": 8192})
+
+	s.verifyError(errors.New("test_error"))
+}
+
+func (s *ServerConfigurationTestSuite) TestSetAdvancedOptionWhenAdvancedOptionsShown() {
+	s.expectOptionsQuery().WillReturnRows(s.newOptionsRows(true))
+	s.expectConfigure("max server memory (MB)", 8192)
+	s.expectReconfigure()
+
+	SetServerConfigurationOptions(s.ctx, s.connMock, map[string]int64{"max server memory (MB)": 8192})
+}
+
+func (s *ServerConfigurationTestSuite) TestSetUnchangedOptions() {
+	s.expectOptionsQuery().WillReturnRows(s.newOptionsRows(false))
+
+	SetServerConfigurationOptions(s.ctx, s.connMock, map[string]int64{"max server memory (MB)": 4096, "contained database authentication": 0})
+}
+
+func (s *ServerConfigurationTestSuite) TestRestartRequired() {
+	s.True(ServerConfigurationOption{Value: 1, ValueInUse: 0}.RestartRequired())
+	s.False(ServerConfigurationOption{Value: 1, ValueInUse: 0, IsDynamic: true}.RestartRequired())
+	s.False(ServerConfigurationOption{Value: 1, ValueInUse: 1}.RestartRequired())
+}
+
+func (s *ServerConfigurationTestSuite) expectOptionsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, "SELECT [name], CONVERT(BIGINT, [value]), CONVERT(BIGINT, [value_in_use]), [is_dynamic], [is_advanced] FROM sys.configurations")
+}
+
+func (s *ServerConfigurationTestSuite) expectConfigure(name string, value int64) {
+	expectExactExec(s.mock, "EXEC sp_configure @configname=@p1, @configvalue=@p2").
+		WithArgs(name, value).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func (s *ServerConfigurationTestSuite) expectReconfigure() {
+	expectExactExec(s.mock, "RECONFIGURE").WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func (s *ServerConfigurationTestSuite) newOptionsRows(showAdvanced bool) *sqlmock.Rows {
+	advanced := 0
+	if showAdvanced {
+		advanced = 1
+	}
+
+	return newRows("name", "value", "value_in_use", "is_dynamic", "is_advanced").
+		AddRow("contained database authentication", 0, 0, true, false).
+		AddRow("max server memory (MB)", 4096, 4096, true, true).
+		AddRow("optimize for ad hoc workloads", 0, 0, true, true).
+		AddRow(showAdvancedOptions, advanced, advanced, true, false)
+}
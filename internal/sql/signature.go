@@ -0,0 +1,195 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"strings"
+)
+
+type SignatureSettings struct {
+	ObjectName         string
+	CertificateId      CertificateId
+	AsymmetricKeyId    AsymmetricKeyId
+	Password           string
+	IsCounterSignature bool
+}
+
+type Signature interface {
+	GetDb(context.Context) Database
+	GetObjectId(context.Context) int
+	GetThumbprint(context.Context) string
+	Exists(context.Context) bool
+	GetSettings(context.Context) SignatureSettings
+	Drop(context.Context)
+}
+
+func GetSignature(_ context.Context, db Database, objectId int, thumbprint string) Signature {
+	return signature{db: db, objectId: objectId, thumbprint: thumbprint}
+}
+
+func AddSignature(ctx context.Context, db Database, settings SignatureSettings) Signature {
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) Signature {
+		var (
+			objectId   sql.NullInt32
+			objectName string
+		)
+
+		err := conn.QueryRowContext(ctx, "SELECT OBJECT_ID(@p1), ISNULL(QUOTENAME(OBJECT_SCHEMA_NAME(OBJECT_ID(@p1))) + '.' + QUOTENAME(OBJECT_NAME(OBJECT_ID(@p1))), '')", settings.ObjectName).
+			Scan(&objectId, &objectName)
+		if err == nil && !objectId.Valid {
+			err = fmt.Errorf("object %q does not exist", settings.ObjectName)
+		}
+		if err != nil {
+			utils.AddError(ctx, "Failed to resolve signed object", err)
+			return nil
+		}
+
+		signer, thumbprint := getSigner(ctx, conn, settings)
+		if utils.HasError(ctx) {
+			return nil
+		}
+
+		stat := fmt.Sprintf("ADD %s TO %s BY %s", settings.signatureKind(), objectName, signer)
+		if settings.Password != "" {
+			stat += fmt.Sprintf(" WITH PASSWORD = %s", quoteString(settings.Password))
+		}
+
+		if _, err := conn.ExecContext(ctx, stat); err != nil {
+			utils.AddError(ctx, "Failed to add signature", err)
+			return nil
+		}
+
+		return GetSignature(ctx, db, int(objectId.Int32), thumbprint)
+	})
+}
+
+func (s SignatureSettings) signatureKind() string {
+	if s.IsCounterSignature {
+		return "COUNTER SIGNATURE"
+	}
+
+	return "SIGNATURE"
+}
+
+func getSigner(ctx context.Context, conn *sql.DB, settings SignatureSettings) (string, string) {
+	var (
+		name, thumbprint string
+		err              error
+		signer           string
+	)
+
+	if settings.CertificateId != 0 {
+		signer = "CERTIFICATE"
+		err = conn.QueryRowContext(ctx, "SELECT [name], CONVERT(VARCHAR(MAX), [thumbprint], 1) FROM sys.certificates WHERE [certificate_id]=@p1", settings.CertificateId).
+			Scan(&name, &thumbprint)
+	} else {
+		signer = "ASYMMETRIC KEY"
+		err = conn.QueryRowContext(ctx, "SELECT [name], CONVERT(VARCHAR(MAX), [thumbprint], 1) FROM sys.asymmetric_keys WHERE [asymmetric_key_id]=@p1", settings.AsymmetricKeyId).
+			Scan(&name, &thumbprint)
+	}
+
+	utils.AddError(ctx, "Failed to retrieve signing key", err)
+
+	return fmt.Sprintf("%s [%s]", signer, name), thumbprint
+}
+
+var _ Signature = signature{}
+
+type signature struct {
+	db         Database
+	objectId   int
+	thumbprint string
+}
+
+func (s signature) GetDb(context.Context) Database {
+	return s.db
+}
+
+func (s signature) GetObjectId(context.Context) int {
+	return s.objectId
+}
+
+func (s signature) GetThumbprint(context.Context) string {
+	return s.thumbprint
+}
+
+func (s signature) Exists(ctx context.Context) bool {
+	return WithConnection(ctx, s.db.connect, func(conn *sql.DB) bool {
+		switch _, err := s.getSettingsRaw(ctx, conn); err {
+		case sql.ErrNoRows:
+			return false
+		case nil:
+			return true
+		default:
+			utils.AddError(ctx, "Failed to check if signature exists", err)
+			return false
+		}
+	})
+}
+
+func (s signature) GetSettings(ctx context.Context) SignatureSettings {
+	return WithConnection(ctx, s.db.connect, func(conn *sql.DB) SignatureSettings {
+		settings, err := s.getSettingsRaw(ctx, conn)
+		utils.AddError(ctx, "Failed to retrieve signature settings", err)
+		return settings
+	})
+}
+
+func (s signature) Drop(ctx context.Context) {
+	WithConnection(ctx, s.db.connect, func(conn *sql.DB) any {
+		settings, err := s.getSettingsRaw(ctx, conn)
+		if err != nil {
+			utils.AddError(ctx, "Failed to retrieve signature settings", err)
+			return nil
+		}
+
+		signer, _ := getSigner(ctx, conn, settings)
+		if utils.HasError(ctx) {
+			return nil
+		}
+
+		objectName := quoteSchemaQualifiedName(settings.ObjectName)
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("DROP %s FROM %s BY %s", settings.signatureKind(), objectName, signer)); err != nil {
+			utils.AddError(ctx, "Failed to drop signature", err)
+		}
+
+		return nil
+	})
+}
+
+func (s signature) getSettingsRaw(ctx context.Context, conn *sql.DB) (SignatureSettings, error) {
+	var (
+		settings  SignatureSettings
+		cryptType string
+		certId    sql.NullInt32
+		keyId     sql.NullInt32
+	)
+
+	err := conn.QueryRowContext(ctx, `
+SELECT
+    cp.[crypt_type],
+    OBJECT_SCHEMA_NAME(cp.[major_id]) + '.' + OBJECT_NAME(cp.[major_id]),
+    c.[certificate_id],
+    k.[asymmetric_key_id]
+FROM sys.crypt_properties AS cp
+LEFT JOIN sys.certificates AS c ON c.[thumbprint] = cp.[thumbprint]
+LEFT JOIN sys.asymmetric_keys AS k ON k.[thumbprint] = cp.[thumbprint]
+WHERE cp.[class] = 1 AND cp.[major_id] = @p1 AND cp.[thumbprint] = CONVERT(VARBINARY(32), @p2, 1)`, s.objectId, s.thumbprint).
+		Scan(&cryptType, &settings.ObjectName, &certId, &keyId)
+
+	settings.IsCounterSignature = strings.HasPrefix(cryptType, "C")
+	settings.CertificateId = CertificateId(certId.Int32)
+	settings.AsymmetricKeyId = AsymmetricKeyId(keyId.Int32)
+
+	return settings, err
+}
+
+func quoteIdentifiers(names []string) []string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = fmt.Sprintf("[%s]", strings.ReplaceAll(name, "]", "]]"))
+	}
+	return quoted
+}
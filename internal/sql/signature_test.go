@@ -0,0 +1,114 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestSignatureTestSuite(t *testing.T) {
+	s := &SignatureTestSuite{}
+	suite.Run(t, s)
+}
+
+type SignatureTestSuite struct {
+	SqlTestSuite
+	signature signature
+}
+
+func (s *SignatureTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.signature = signature{db: &s.dbMock, objectId: 1234, thumbprint: "0x0102"}
+}
+
+func (s *SignatureTestSuite) TestAddSignature() {
+	cases := map[string]struct {
+		settings SignatureSettings
+		sql      string
+	}{
+		"certificate": {
+			settings: SignatureSettings{ObjectName: "dbo.test_proc", CertificateId: 256},
+			sql:      "ADD SIGNATURE TO [dbo].[test_proc] BY CERTIFICATE [test_signer]",
+		},
+		"counter signature with password": {
+			settings: SignatureSettings{ObjectName: "dbo.test_proc", CertificateId: 256, Password: "test_password", IsCounterSignature: true},
+			sql:      "ADD COUNTER SIGNATURE TO [dbo].[test_proc] BY CERTIFICATE [test_signer] WITH PASSWORD = 'test_password'",
+		},
+		"asymmetric key": {
+			settings: SignatureSettings{ObjectName: "dbo.test_proc", AsymmetricKeyId: 256},
+			sql:      "ADD SIGNATURE TO [dbo].[test_proc] BY ASYMMETRIC KEY [test_signer]",
+		},
+	}
+
+	for name, t := range cases {
+		tc := t
+		s.Run(name, func() {
+			expectExactQuery(s.mock, "SELECT OBJECT_ID(@p1), ISNULL(QUOTENAME(OBJECT_SCHEMA_NAME(OBJECT_ID(@p1))) + '.' + QUOTENAME(OBJECT_NAME(OBJECT_ID(@p1))), '')").
+				WithArgs("dbo.test_proc").
+				WillReturnRows(newRows("id", "name").AddRow(1234, "[dbo].[test_proc]"))
+			s.expectSignerQuery(tc.settings)
+			expectExactExec(s.mock, tc.sql).WillReturnResult(sqlmock.NewResult(0, 1))
+
+			sig := AddSignature(s.ctx, &s.dbMock, tc.settings)
+
+			s.Equal(1234, sig.GetObjectId(s.ctx), "object ID")
+			s.Equal("0x0102", sig.GetThumbprint(s.ctx), "thumbprint")
+		})
+	}
+}
+
+func (s *SignatureTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(newRows("crypt_type", "object_name", "certificate_id", "asymmetric_key_id").AddRow("SPVC", "dbo.test_proc", 256, nil))
+
+	s.True(s.signature.Exists(s.ctx))
+}
+
+func (s *SignatureTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.signature.Exists(s.ctx))
+}
+
+func (s *SignatureTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(newRows("crypt_type", "object_name", "certificate_id", "asymmetric_key_id").AddRow("CPVA", "dbo.test_proc", nil, 257))
+
+	settings := s.signature.GetSettings(s.ctx)
+
+	s.Equal(SignatureSettings{ObjectName: "dbo.test_proc", AsymmetricKeyId: 257, IsCounterSignature: true}, settings)
+}
+
+func (s *SignatureTestSuite) TestDrop() {
+	settings := SignatureSettings{ObjectName: "dbo.test_proc", CertificateId: 256}
+	s.expectSettingsQuery().WillReturnRows(newRows("crypt_type", "object_name", "certificate_id", "asymmetric_key_id").AddRow("SPVC", "dbo.test_proc", 256, nil))
+	s.expectSignerQuery(settings)
+	expectExactExec(s.mock, "DROP SIGNATURE FROM [dbo].[test_proc] BY CERTIFICATE [test_signer]").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.signature.Drop(s.ctx)
+}
+
+func (s *SignatureTestSuite) expectSignerQuery(settings SignatureSettings) {
+	if settings.CertificateId != 0 {
+		expectExactQuery(s.mock, "SELECT [name], CONVERT(VARCHAR(MAX), [thumbprint], 1) FROM sys.certificates WHERE [certificate_id]=@p1").
+			WithArgs(settings.CertificateId).
+			WillReturnRows(newRows("name", "thumbprint").AddRow("test_signer", "0x0102"))
+	} else {
+		expectExactQuery(s.mock, "SELECT [name], CONVERT(VARCHAR(MAX), [thumbprint], 1) FROM sys.asymmetric_keys WHERE [asymmetric_key_id]=@p1").
+			WithArgs(settings.AsymmetricKeyId).
+			WillReturnRows(newRows("name", "thumbprint").AddRow("test_signer", "0x0102"))
+	}
+}
+
+func (s *SignatureTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, `
+SELECT
+    cp.[crypt_type],
+    OBJECT_SCHEMA_NAME(cp.[major_id]) + '.' + OBJECT_NAME(cp.[major_id]),
+    c.[certificate_id],
+    k.[asymmetric_key_id]
+FROM sys.crypt_properties AS cp
+LEFT JOIN sys.certificates AS c ON c.[thumbprint] = cp.[thumbprint]
+LEFT JOIN sys.asymmetric_keys AS k ON k.[thumbprint] = cp.[thumbprint]
+WHERE cp.[class] = 1 AND cp.[major_id] = @p1 AND cp.[thumbprint] = CONVERT(VARBINARY(32), @p2, 1)`).
+		WithArgs(s.signature.objectId, s.signature.thumbprint)
+}
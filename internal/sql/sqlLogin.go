@@ -20,6 +20,12 @@ type SqlLoginSettings struct {
 	CheckPasswordExpiration bool
 	CheckPasswordPolicy     bool
 	PrincipalId             SqlLoginId
+	CertificateId           CertificateId
+	AsymmetricKeyId         AsymmetricKeyId
+}
+
+func (s SqlLoginSettings) isMappedToKey() bool {
+	return s.CertificateId != 0 || s.AsymmetricKeyId != 0
 }
 
 func (s SqlLoginSettings) toSqlOptions(ctx context.Context, conn Connection) string {
@@ -133,6 +139,10 @@ func GetSqlLogins(ctx context.Context, conn Connection) map[LoginId]SqlLogin {
 }
 
 func CreateSqlLogin(ctx context.Context, conn Connection, settings SqlLoginSettings) SqlLogin {
+	if settings.isMappedToKey() {
+		return createKeyMappedLogin(ctx, conn, settings)
+	}
+
 	sqlOptions := settings.toSqlOptions(ctx, conn)
 	if utils.HasError(ctx) {
 		return nil
@@ -145,12 +155,40 @@ func CreateSqlLogin(ctx context.Context, conn Connection, settings SqlLoginSetti
 	return GetSqlLoginByName(ctx, conn, settings.Name)
 }
 
+func createKeyMappedLogin(ctx context.Context, conn Connection, settings SqlLoginSettings) SqlLogin {
+	var source string
+
+	if settings.CertificateId != 0 {
+		source = fmt.Sprintf("CERTIFICATE [%s]", lookupServerCertificateName(ctx, conn.getSqlConnection(ctx), settings.CertificateId))
+	} else {
+		source = fmt.Sprintf("ASYMMETRIC KEY [%s]", lookupServerAsymmetricKeyName(ctx, conn.getSqlConnection(ctx), settings.AsymmetricKeyId))
+	}
+
+	if utils.HasError(ctx) {
+		return nil
+	}
+
+	conn.exec(ctx, fmt.Sprintf("CREATE LOGIN [%s] FROM %s", settings.Name, source))
+	if utils.HasError(ctx) {
+		return nil
+	}
+
+	var id LoginId
+	err := conn.getSqlConnection(ctx).QueryRowContext(ctx, "SELECT CONVERT(VARCHAR(85), [sid], 1) FROM sys.server_principals WHERE [name]=@p1", settings.Name).Scan(&id)
+	if err != nil {
+		utils.AddError(ctx, "Failed to retrieve login ID", err)
+		return nil
+	}
+
+	return GetSqlLogin(ctx, conn, id)
+}
+
 func (l sqlLogin) GetId(context.Context) LoginId {
 	return l.id
 }
 
 func (l sqlLogin) Exists(ctx context.Context) bool {
-	const query = "SELECT [name] FROM sys.sql_logins WHERE CONVERT(VARCHAR(85), [sid], 1) = @p1"
+	const query = "SELECT [name] FROM sys.server_principals WHERE CONVERT(VARCHAR(85), [sid], 1) = @p1"
 
 	switch err := l.conn.getSqlConnection(ctx).QueryRowContext(ctx, query, l.id).Err(); err {
 	case sql.ErrNoRows:
@@ -197,6 +235,10 @@ WHERE CONVERT(VARCHAR(85), l.[sid], 1) = @p1`, l.id).
 		settings.Password = password.String
 	}
 
+	if err == sql.ErrNoRows {
+		settings, err = l.getKeyMappedSettings(ctx)
+	}
+
 	if err != nil {
 		utils.AddError(ctx, "Failed to retrieve SQL login settings", err)
 	}
@@ -205,6 +247,16 @@ WHERE CONVERT(VARCHAR(85), l.[sid], 1) = @p1`, l.id).
 }
 
 func (l sqlLogin) UpdateSettings(ctx context.Context, settings SqlLoginSettings) {
+	if settings.isMappedToKey() {
+		currentName := l.getName(ctx)
+		if utils.HasError(ctx) {
+			return
+		}
+
+		l.conn.exec(ctx, fmt.Sprintf("ALTER LOGIN [%s] WITH NAME=[%s]", currentName, settings.Name))
+		return
+	}
+
 	sqlOptions := settings.toSqlOptions(ctx, l.conn)
 	if utils.HasError(ctx) {
 		return
@@ -229,10 +281,38 @@ func (l sqlLogin) Drop(ctx context.Context) {
 
 func (l sqlLogin) getName(ctx context.Context) string {
 	var name string
-	err := l.conn.getSqlConnection(ctx).QueryRowContext(ctx, "SELECT [name] FROM sys.sql_logins WHERE [sid]=CONVERT(VARBINARY(85), @p1, 1)", l.id).Scan(&name)
+	err := l.conn.getSqlConnection(ctx).QueryRowContext(ctx, "SELECT [name] FROM sys.server_principals WHERE [sid]=CONVERT(VARBINARY(85), @p1, 1)", l.id).Scan(&name)
 	if err != nil {
 		utils.AddError(ctx, "Failed to retrieve login name", err)
 	}
 
 	return name
 }
+
+func (l sqlLogin) getKeyMappedSettings(ctx context.Context) (SqlLoginSettings, error) {
+	var settings SqlLoginSettings
+
+	err := l.conn.getSqlConnection(ctx).QueryRowContext(ctx, `
+SELECT
+    p.[name],
+    p.principal_id,
+    ISNULL(c.certificate_id, 0),
+    ISNULL(k.asymmetric_key_id, 0)
+FROM sys.server_principals AS p
+LEFT JOIN master.sys.certificates AS c ON c.[sid] = p.[sid]
+LEFT JOIN master.sys.asymmetric_keys AS k ON k.[sid] = p.[sid]
+WHERE p.[type] IN ('C', 'K') AND CONVERT(VARCHAR(85), p.[sid], 1) = @p1`, l.id).
+		Scan(&settings.Name, &settings.PrincipalId, &settings.CertificateId, &settings.AsymmetricKeyId)
+
+	return settings, err
+}
+
+func lookupServerAsymmetricKeyName(ctx context.Context, conn *sql.DB, id AsymmetricKeyId) string {
+	var name string
+
+	if err := conn.QueryRowContext(ctx, "SELECT [name] FROM master.sys.asymmetric_keys WHERE [asymmetric_key_id]=@p1", id).Scan(&name); err != nil {
+		utils.AddError(ctx, fmt.Sprintf("Failed to retrieve name of server asymmetric key with ID %d", id), err)
+	}
+
+	return name
+}
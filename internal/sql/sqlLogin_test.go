@@ -157,6 +157,42 @@ func (s *SqlLoginTestSuite) TestCreateSqlLoginError() {
 	s.verifyError(err)
 }
 
+func (s *SqlLoginTestSuite) TestCreateKeyMappedLogin() {
+	cases := map[string]struct {
+		settings    SqlLoginSettings
+		lookupQuery string
+		sql         string
+	}{
+		"certificate": {
+			settings:    SqlLoginSettings{Name: "cert_login", CertificateId: 256},
+			lookupQuery: "SELECT [name] FROM master.sys.certificates WHERE [certificate_id]=@p1",
+			sql:         "CREATE LOGIN [cert_login] FROM CERTIFICATE [test_key]",
+		},
+		"asymmetric key": {
+			settings:    SqlLoginSettings{Name: "key_login", AsymmetricKeyId: 256},
+			lookupQuery: "SELECT [name] FROM master.sys.asymmetric_keys WHERE [asymmetric_key_id]=@p1",
+			sql:         "CREATE LOGIN [key_login] FROM ASYMMETRIC KEY [test_key]",
+		},
+	}
+
+	for name, t := range cases {
+		tc := t
+		s.Run(name, func() {
+			const id = "0x0106000000000009010000"
+			expectExactQuery(s.mock, tc.lookupQuery).WithArgs(256).WillReturnRows(newRows("name").AddRow("test_key"))
+			expectExactExec(s.mock, tc.sql).WillReturnResult(sqlmock.NewResult(0, 1))
+			expectExactQuery(s.mock, "SELECT CONVERT(VARCHAR(85), [sid], 1) FROM sys.server_principals WHERE [name]=@p1").
+				WithArgs(tc.settings.Name).
+				WillReturnRows(newRows("id").AddRow(id))
+
+			login := CreateSqlLogin(s.ctx, s.connMock, tc.settings)
+
+			s.Require().NotNil(login)
+			s.Equal(LoginId(id), login.GetId(s.ctx), "Login ID")
+		})
+	}
+}
+
 func (s *SqlLoginTestSuite) TestExistsMissing() {
 	s.expectSqlLoginNamesByIdQuery().WithArgs(s.login.id).WillReturnError(sql.ErrNoRows)
 
@@ -238,6 +274,26 @@ func (s *SqlLoginTestSuite) TestGetSettingsAzureSql() {
 	s.Equal(expectedSettings, settings)
 }
 
+func (s *SqlLoginTestSuite) TestGetSettingsKeyMapped() {
+	s.expectSettingsQuery().WithArgs(s.login.id).WillReturnError(sql.ErrNoRows)
+	expectExactQuery(s.mock, `
+SELECT
+    p.[name],
+    p.principal_id,
+    ISNULL(c.certificate_id, 0),
+    ISNULL(k.asymmetric_key_id, 0)
+FROM sys.server_principals AS p
+LEFT JOIN master.sys.certificates AS c ON c.[sid] = p.[sid]
+LEFT JOIN master.sys.asymmetric_keys AS k ON k.[sid] = p.[sid]
+WHERE p.[type] IN ('C', 'K') AND CONVERT(VARCHAR(85), p.[sid], 1) = @p1`).
+		WithArgs(s.login.id).
+		WillReturnRows(newRows("name", "principal_id", "certificate_id", "asymmetric_key_id").AddRow("cert_login", 268, 256, 0))
+
+	settings := s.login.GetSettings(s.ctx)
+
+	s.Equal(SqlLoginSettings{Name: "cert_login", PrincipalId: 268, CertificateId: 256}, settings)
+}
+
 func (s *SqlLoginTestSuite) TestGetSettingsError() {
 	err := errors.New("test_error")
 	s.expectSettingsQuery().WithArgs(s.login.id).WillReturnError(err)
@@ -318,6 +374,13 @@ func (s *SqlLoginTestSuite) TestUpdateSqlLoginSettingsError() {
 	s.verifyError(err)
 }
 
+func (s *SqlLoginTestSuite) TestUpdateKeyMappedLoginSettings() {
+	s.expectSqlLoginNameLookupQuery().WithArgs(s.login.id).WillReturnRows(newRows("name").AddRow("old_name"))
+	expectExactExec(s.mock, "ALTER LOGIN [old_name] WITH NAME=[new_name]").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.login.UpdateSettings(s.ctx, SqlLoginSettings{Name: "new_name", CertificateId: 256})
+}
+
 func (s *SqlLoginTestSuite) TestDropSqlLogin() {
 	s.expectSqlLoginNameLookupQuery().WithArgs(s.login.id).WillReturnRows(newRows("name").AddRow("test_login"))
 	expectExactExec(s.mock, "DROP LOGIN [test_login]").WillReturnResult(sqlmock.NewResult(0, 1))
@@ -334,7 +397,7 @@ func (s *SqlLoginTestSuite) expectSqlLoginIdQuery(loginName string) *sqlmock.Exp
 }
 
 func (s *SqlLoginTestSuite) expectSqlLoginNamesByIdQuery() *sqlmock.ExpectedQuery {
-	return expectExactQuery(s.mock, "SELECT [name] FROM sys.sql_logins WHERE CONVERT(VARCHAR(85), [sid], 1) = @p1")
+	return expectExactQuery(s.mock, "SELECT [name] FROM sys.server_principals WHERE CONVERT(VARCHAR(85), [sid], 1) = @p1")
 }
 
 func (s *SqlLoginTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
@@ -2,6 +2,7 @@ package sql
 
 import (
 	"context"
+	"database/sql/driver"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -63,7 +64,7 @@ func (s *SqlTestSuite) verifyError(err error) {
 }
 
 func (s *SqlTestSuite) expectSqlLoginNameLookupQuery() *sqlmock.ExpectedQuery {
-	return expectExactQuery(s.mock, "SELECT [name] FROM sys.sql_logins WHERE [sid]=CONVERT(VARBINARY(85), @p1, 1)")
+	return expectExactQuery(s.mock, "SELECT [name] FROM sys.server_principals WHERE [sid]=CONVERT(VARBINARY(85), @p1, 1)")
 }
 
 func (s *SqlTestSuite) expectDatabasePrincipalIdLookupQuery(name string, id int) *sqlmock.ExpectedQuery {
@@ -87,3 +88,12 @@ func (s *SqlTestSuite) expectServerPrincipalNameLookupQuery(id int, name string)
 func (s *SqlTestSuite) expectServerPrincipalIdLookupQuery(id int, name string) {
 	s.connMock.On("lookupServerPrincipalId", mock.Anything, name).Return(GenericServerPrincipalId(id))
 }
+
+func toDriverValues(args []any) []driver.Value {
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg
+	}
+
+	return values
+}
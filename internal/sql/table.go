@@ -0,0 +1,189 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+)
+
+// WholeTable is used as column ID of permissions granted on the whole table.
+const WholeTable ColumnId = 0
+
+type TablePermission struct {
+	Name            string
+	WithGrantOption bool
+}
+
+type TablePermissions map[string]TablePermission
+
+type Table interface {
+	GetDb(context.Context) Database
+	GetId(context.Context) TableId
+	GetName(context.Context) string
+	GetColumnId(ctx context.Context, name string) ColumnId
+	GetColumnName(ctx context.Context, id ColumnId) string
+	GetPermissions(ctx context.Context, columnId ColumnId, principalId GenericDatabasePrincipalId) TablePermissions
+	GrantPermission(ctx context.Context, columnId ColumnId, principalId GenericDatabasePrincipalId, permission TablePermission)
+	UpdatePermission(ctx context.Context, columnId ColumnId, principalId GenericDatabasePrincipalId, permission TablePermission)
+	RevokePermission(ctx context.Context, columnId ColumnId, principalId GenericDatabasePrincipalId, permission string)
+}
+
+func GetTable(_ context.Context, db Database, id TableId) Table {
+	return table{db: db, id: id}
+}
+
+func GetTableByName(ctx context.Context, db Database, name string) Table {
+	return WithConnection(ctx, db.connect, func(conn *sql.DB) Table {
+		var id sql.NullInt32
+
+		if err := conn.QueryRowContext(ctx, "SELECT OBJECT_ID(@p1, 'U')", name).Scan(&id); err != nil {
+			utils.AddError(ctx, "Failed to fetch table ID", err)
+			return nil
+		}
+
+		if !id.Valid {
+			utils.AddError(ctx, "Table does not exist", fmt.Errorf("did not find table %q", name))
+			return nil
+		}
+
+		return GetTable(ctx, db, TableId(id.Int32))
+	})
+}
+
+var _ Table = table{}
+
+type table struct {
+	db Database
+	id TableId
+}
+
+func (t table) GetDb(context.Context) Database {
+	return t.db
+}
+
+func (t table) GetId(context.Context) TableId {
+	return t.id
+}
+
+// GetName returns schema-qualified, unquoted name of the table, e.g. `dbo.Customers`.
+func (t table) GetName(ctx context.Context) string {
+	return WithConnection(ctx, t.db.connect, func(conn *sql.DB) string {
+		var name sql.NullString
+
+		if err := conn.QueryRowContext(ctx, "SELECT OBJECT_SCHEMA_NAME(@p1) + '.' + OBJECT_NAME(@p1)", t.id).Scan(&name); err != nil {
+			utils.AddError(ctx, "Failed to fetch table name", err)
+		} else if !name.Valid {
+			utils.AddError(ctx, "Table does not exist", fmt.Errorf("did not find table with ID %d", t.id))
+		}
+
+		return name.String
+	})
+}
+
+func (t table) GetColumnId(ctx context.Context, name string) ColumnId {
+	return WithConnection(ctx, t.db.connect, func(conn *sql.DB) ColumnId {
+		var id sql.NullInt32
+
+		if err := conn.QueryRowContext(ctx, "SELECT COLUMNPROPERTY(@p1, @p2, 'ColumnId')", t.id, name).Scan(&id); err != nil {
+			utils.AddError(ctx, "Failed to fetch column ID", err)
+		} else if !id.Valid {
+			utils.AddError(ctx, "Column does not exist", fmt.Errorf("did not find column %q in table with ID %d", name, t.id))
+		}
+
+		return ColumnId(id.Int32)
+	})
+}
+
+func (t table) GetColumnName(ctx context.Context, id ColumnId) string {
+	return WithConnection(ctx, t.db.connect, func(conn *sql.DB) string {
+		var name sql.NullString
+
+		if err := conn.QueryRowContext(ctx, "SELECT COL_NAME(@p1, @p2)", t.id, id).Scan(&name); err != nil {
+			utils.AddError(ctx, "Failed to fetch column name", err)
+		} else if !name.Valid {
+			utils.AddError(ctx, "Column does not exist", fmt.Errorf("did not find column with ID %d in table with ID %d", id, t.id))
+		}
+
+		return name.String
+	})
+}
+
+func (t table) GetPermissions(ctx context.Context, columnId ColumnId, principalId GenericDatabasePrincipalId) TablePermissions {
+	return WithConnection(ctx, t.db.connect, func(conn *sql.DB) TablePermissions {
+		res, err := conn.QueryContext(ctx, "SELECT [permission_name], [state] FROM sys.database_permissions WHERE [class]=1 AND [major_id]=@p1 AND [minor_id]=@p2 AND [grantee_principal_id]=@p3", t.id, columnId, principalId)
+
+		perms := TablePermissions{}
+
+		switch err {
+		case sql.ErrNoRows:
+			return perms
+		case nil:
+			for res.Next() {
+				var state string
+				perm := TablePermission{}
+				err := res.Scan(&perm.Name, &state)
+				utils.AddError(ctx, "Failed to parse table permissions", err)
+				perm.WithGrantOption = state == "W"
+				perms[perm.Name] = perm
+			}
+		default:
+			utils.AddError(ctx, "Failed to fetch table permissions", err)
+			return nil
+		}
+
+		return perms
+	})
+}
+
+func (t table) GrantPermission(ctx context.Context, columnId ColumnId, principalId GenericDatabasePrincipalId, permission TablePermission) {
+	securable := t.getSecurable(ctx, columnId)
+	principalName := t.db.getUserName(ctx, principalId)
+
+	WithConnection(ctx, t.db.connect, func(conn *sql.DB) any {
+		stat := fmt.Sprintf("GRANT %s ON %s TO [%s]", permission.Name, securable, principalName)
+		if permission.WithGrantOption {
+			stat += " WITH GRANT OPTION"
+		}
+		_, err := conn.ExecContext(ctx, stat)
+		utils.AddError(ctx, "Failed to grant table permission", err)
+		return nil
+	})
+}
+
+func (t table) UpdatePermission(ctx context.Context, columnId ColumnId, principalId GenericDatabasePrincipalId, permission TablePermission) {
+	if permission.WithGrantOption {
+		t.GrantPermission(ctx, columnId, principalId, permission)
+		return
+	}
+
+	securable := t.getSecurable(ctx, columnId)
+	principalName := t.db.getUserName(ctx, principalId)
+
+	WithConnection(ctx, t.db.connect, func(conn *sql.DB) any {
+		_, err := conn.ExecContext(ctx, fmt.Sprintf("REVOKE GRANT OPTION FOR %s ON %s FROM [%s] CASCADE", permission.Name, securable, principalName))
+		utils.AddError(ctx, "Failed to revoke grant option", err)
+		return nil
+	})
+}
+
+func (t table) RevokePermission(ctx context.Context, columnId ColumnId, principalId GenericDatabasePrincipalId, permission string) {
+	securable := t.getSecurable(ctx, columnId)
+	principalName := t.db.getUserName(ctx, principalId)
+
+	WithConnection(ctx, t.db.connect, func(conn *sql.DB) any {
+		_, err := conn.ExecContext(ctx, fmt.Sprintf("REVOKE %s ON %s FROM [%s] CASCADE", permission, securable, principalName))
+		utils.AddError(ctx, "Failed to revoke permission", err)
+		return nil
+	})
+}
+
+func (t table) getSecurable(ctx context.Context, columnId ColumnId) string {
+	securable := "OBJECT::" + quoteSchemaQualifiedName(t.GetName(ctx))
+
+	if columnId != WholeTable && !utils.HasError(ctx) {
+		securable += fmt.Sprintf(" ([%s])", t.GetColumnName(ctx, columnId))
+	}
+
+	return securable
+}
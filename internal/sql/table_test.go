@@ -0,0 +1,115 @@
+package sql
+
+import (
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+func TestTableTestSuite(t *testing.T) {
+	s := &TableTestSuite{}
+	suite.Run(t, s)
+}
+
+type TableTestSuite struct {
+	SqlTestSuite
+	table table
+}
+
+func (s *TableTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.table = table{db: &s.dbMock, id: 5423}
+}
+
+func (s *TableTestSuite) TestGetTableByName() {
+	expectExactQuery(s.mock, "SELECT OBJECT_ID(@p1, 'U')").
+		WithArgs("dbo.Customers").
+		WillReturnRows(newRows("id").AddRow(8765))
+
+	t := GetTableByName(s.ctx, &s.dbMock, "dbo.Customers")
+
+	s.Equal(TableId(8765), t.GetId(s.ctx))
+}
+
+func (s *TableTestSuite) TestGetTableByNameNotExists() {
+	expectExactQuery(s.mock, "SELECT OBJECT_ID(@p1, 'U')").
+		WithArgs("dbo.Missing").
+		WillReturnRows(newRows("id").AddRow(nil))
+
+	GetTableByName(s.ctx, &s.dbMock, "dbo.Missing")
+
+	s.verifyError(errors.New(`did not find table "dbo.Missing"`))
+}
+
+func (s *TableTestSuite) TestGetName() {
+	s.expectTableNameQuery()
+
+	s.Equal("dbo.Customers", s.table.GetName(s.ctx))
+}
+
+func (s *TableTestSuite) TestGetColumnId() {
+	expectExactQuery(s.mock, "SELECT COLUMNPROPERTY(@p1, @p2, 'ColumnId')").
+		WithArgs(s.table.id, "Email").
+		WillReturnRows(newRows("id").AddRow(3))
+
+	s.Equal(ColumnId(3), s.table.GetColumnId(s.ctx, "Email"))
+}
+
+func (s *TableTestSuite) TestGetPermissions() {
+	expectExactQuery(s.mock, "SELECT [permission_name], [state] FROM sys.database_permissions WHERE [class]=1 AND [major_id]=@p1 AND [minor_id]=@p2 AND [grantee_principal_id]=@p3").
+		WithArgs(s.table.id, 3, 135).
+		WillReturnRows(newRows("permission_name", "state").AddRow("UNMASK", "W").AddRow("SELECT", "G"))
+
+	perms := s.table.GetPermissions(s.ctx, 3, 135)
+
+	s.Len(perms, 2, "count")
+	s.Equal(TablePermission{Name: "UNMASK", WithGrantOption: true}, perms["UNMASK"])
+	s.Equal(TablePermission{Name: "SELECT", WithGrantOption: false}, perms["SELECT"])
+}
+
+func (s *TableTestSuite) TestGrantPermission() {
+	s.expectTableNameQuery()
+	s.dbMock.expectUsernameLookup(631, "test_user")
+	expectExactExec(s.mock, "GRANT UNMASK ON OBJECT::[dbo].[Customers] TO [test_user]").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.table.GrantPermission(s.ctx, WholeTable, 631, TablePermission{Name: "UNMASK"})
+}
+
+func (s *TableTestSuite) TestGrantColumnPermissionWithGrantOption() {
+	s.expectTableNameQuery()
+	s.expectColumnNameQuery()
+	s.dbMock.expectUsernameLookup(151, "test_user")
+	expectExactExec(s.mock, "GRANT UNMASK ON OBJECT::[dbo].[Customers] ([Email]) TO [test_user] WITH GRANT OPTION").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.table.GrantPermission(s.ctx, 3, 151, TablePermission{Name: "UNMASK", WithGrantOption: true})
+}
+
+func (s *TableTestSuite) TestUpdatePermissionRevokeGrantOption() {
+	s.expectTableNameQuery()
+	s.dbMock.expectUsernameLookup(4567, "grant_user")
+	expectExactExec(s.mock, "REVOKE GRANT OPTION FOR SELECT ON OBJECT::[dbo].[Customers] FROM [grant_user] CASCADE").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.table.UpdatePermission(s.ctx, WholeTable, 4567, TablePermission{Name: "SELECT"})
+}
+
+func (s *TableTestSuite) TestRevokeColumnPermission() {
+	s.expectTableNameQuery()
+	s.expectColumnNameQuery()
+	s.dbMock.expectUsernameLookup(96, "revoke_user")
+	expectExactExec(s.mock, "REVOKE UNMASK ON OBJECT::[dbo].[Customers] ([Email]) FROM [revoke_user] CASCADE").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.table.RevokePermission(s.ctx, 3, 96, "UNMASK")
+}
+
+func (s *TableTestSuite) expectTableNameQuery() {
+	expectExactQuery(s.mock, "SELECT OBJECT_SCHEMA_NAME(@p1) + '.' + OBJECT_NAME(@p1)").
+		WithArgs(s.table.id).
+		WillReturnRows(newRows("name").AddRow("dbo.Customers"))
+}
+
+func (s *TableTestSuite) expectColumnNameQuery() {
+	expectExactQuery(s.mock, "SELECT COL_NAME(@p1, @p2)").
+		WithArgs(s.table.id, 3).
+		WillReturnRows(newRows("name").AddRow("Email"))
+}
@@ -16,13 +16,17 @@ const (
 	USER_TYPE_UKNOWN UserType = 0
 	USER_TYPE_SQL    UserType = iota
 	USER_TYPE_AZUREAD
+	USER_TYPE_CERTIFICATE
+	USER_TYPE_ASYMMETRIC_KEY
 )
 
 type UserSettings struct {
-	Name        string
-	LoginId     LoginId
-	AADObjectId AADObjectId
-	Type        UserType
+	Name            string
+	LoginId         LoginId
+	AADObjectId     AADObjectId
+	CertificateId   CertificateId
+	AsymmetricKeyId AsymmetricKeyId
+	Type            UserType
 }
 
 type User interface {
@@ -52,6 +56,22 @@ func CreateUser(ctx context.Context, db Database, settings UserSettings) User {
 DECLARE @SQL NVARCHAR(MAX) = 'CREATE USER [' + @p1 + '] WITH SID=' + (SELECT CONVERT(VARCHAR(85), CONVERT(VARBINARY(85), CAST(@p2 AS UNIQUEIDENTIFIER), 1), 1)) + ', TYPE=E';
 EXEC(@SQL)
 `)
+		case USER_TYPE_CERTIFICATE:
+			var certName string
+			if err := conn.QueryRowContext(ctx, "SELECT [name] FROM sys.certificates WHERE [certificate_id]=@p1", settings.CertificateId).Scan(&certName); err != nil {
+				utils.AddError(ctx, "Failed to retrieve certificate name", err)
+				return nil
+			}
+
+			sqlStat.WriteString(fmt.Sprintf("CREATE USER [%s] FROM CERTIFICATE [%s]", settings.Name, certName))
+		case USER_TYPE_ASYMMETRIC_KEY:
+			var keyName string
+			if err := conn.QueryRowContext(ctx, "SELECT [name] FROM sys.asymmetric_keys WHERE [asymmetric_key_id]=@p1", settings.AsymmetricKeyId).Scan(&keyName); err != nil {
+				utils.AddError(ctx, "Failed to retrieve asymmetric key name", err)
+				return nil
+			}
+
+			sqlStat.WriteString(fmt.Sprintf("CREATE USER [%s] FROM ASYMMETRIC KEY [%s]", settings.Name, keyName))
 		default:
 			utils.AddError(ctx, "Requested creation of unknown user type", fmt.Errorf("received unknown user type: %d", settings.Type))
 			return nil
@@ -148,6 +168,18 @@ func (u user) GetSettings(ctx context.Context) UserSettings {
 			fallthrough
 		case "X":
 			settings.Type = USER_TYPE_AZUREAD
+		case "C":
+			settings.Type = USER_TYPE_CERTIFICATE
+			settings.LoginId, settings.AADObjectId = "", ""
+			err = conn.QueryRowContext(ctx, "SELECT c.[certificate_id] FROM sys.certificates AS c INNER JOIN sys.database_principals AS p ON p.[sid] = c.[sid] WHERE p.[principal_id]=@p1", u.id).
+				Scan(&settings.CertificateId)
+			utils.AddError(ctx, "Failed to retrieve user certificate", err)
+		case "K":
+			settings.Type = USER_TYPE_ASYMMETRIC_KEY
+			settings.LoginId, settings.AADObjectId = "", ""
+			err = conn.QueryRowContext(ctx, "SELECT k.[asymmetric_key_id] FROM sys.asymmetric_keys AS k INNER JOIN sys.database_principals AS p ON p.[sid] = k.[sid] WHERE p.[principal_id]=@p1", u.id).
+				Scan(&settings.AsymmetricKeyId)
+			utils.AddError(ctx, "Failed to retrieve user asymmetric key", err)
 		default:
 			utils.AddError(ctx, "Unknown user type", fmt.Errorf("retrieved unknown user type: %s", userType))
 		}
@@ -179,6 +211,14 @@ func (u user) UpdateSettings(ctx context.Context, settings UserSettings) {
 			return nil
 		}
 
+		if settings.Type == USER_TYPE_CERTIFICATE || settings.Type == USER_TYPE_ASYMMETRIC_KEY {
+			if _, err := conn.ExecContext(ctx, fmt.Sprintf("ALTER USER [%s] WITH NAME=[%s]", name, settings.Name)); err != nil {
+				utils.AddError(ctx, "Failed to update user", err)
+			}
+
+			return nil
+		}
+
 		loginName := GetSqlLogin(ctx, u.db.GetConnection(ctx), settings.LoginId).getName(ctx)
 		if utils.HasError(ctx) {
 			return nil
@@ -48,6 +48,34 @@ EXEC(@SQL)
 	s.Equal(UserId(421), user.GetId(s.ctx))
 }
 
+func (s *UserTestSuite) TestCreateCertificateUser() {
+	settings := UserSettings{Name: "test_user", CertificateId: 256, Type: USER_TYPE_CERTIFICATE}
+	expectExactQuery(s.mock, "SELECT [name] FROM sys.certificates WHERE [certificate_id]=@p1").
+		WithArgs(settings.CertificateId).
+		WillReturnRows(newRows("name").AddRow("test_cert"))
+	expectExactExec(s.mock, "CREATE USER [test_user] FROM CERTIFICATE [test_cert]").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectUserIdLookupQuery("test_user", 124)
+
+	user := CreateUser(s.ctx, &s.dbMock, settings)
+
+	s.Equal(UserId(124), user.GetId(s.ctx))
+}
+
+func (s *UserTestSuite) TestCreateAsymmetricKeyUser() {
+	settings := UserSettings{Name: "test_user", AsymmetricKeyId: 257, Type: USER_TYPE_ASYMMETRIC_KEY}
+	expectExactQuery(s.mock, "SELECT [name] FROM sys.asymmetric_keys WHERE [asymmetric_key_id]=@p1").
+		WithArgs(settings.AsymmetricKeyId).
+		WillReturnRows(newRows("name").AddRow("test_key"))
+	expectExactExec(s.mock, "CREATE USER [test_user] FROM ASYMMETRIC KEY [test_key]").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.expectUserIdLookupQuery("test_user", 125)
+
+	user := CreateUser(s.ctx, &s.dbMock, settings)
+
+	s.Equal(UserId(125), user.GetId(s.ctx))
+}
+
 func (s *UserTestSuite) TestGetSqlUserByName() {
 	s.expectUserIdLookupQuery("test_user_by_name", 521)
 
@@ -90,6 +118,28 @@ func (s *UserTestSuite) TestGetSettingsAzureAD() {
 	s.Equal(AADObjectId("67f1ec25-847b-4440-98c0-26dc0ad9d1f0"), settings.AADObjectId, "object_id")
 }
 
+func (s *UserTestSuite) TestGetSettingsCertificate() {
+	s.expectSettingsQuery("C")
+	expectExactQuery(s.mock, "SELECT c.[certificate_id] FROM sys.certificates AS c INNER JOIN sys.database_principals AS p ON p.[sid] = c.[sid] WHERE p.[principal_id]=@p1").
+		WithArgs(s.user.id).
+		WillReturnRows(newRows("certificate_id").AddRow(256))
+
+	settings := s.user.GetSettings(s.ctx)
+
+	s.Equal(UserSettings{Name: "test_name", CertificateId: 256, Type: USER_TYPE_CERTIFICATE}, settings)
+}
+
+func (s *UserTestSuite) TestGetSettingsAsymmetricKey() {
+	s.expectSettingsQuery("K")
+	expectExactQuery(s.mock, "SELECT k.[asymmetric_key_id] FROM sys.asymmetric_keys AS k INNER JOIN sys.database_principals AS p ON p.[sid] = k.[sid] WHERE p.[principal_id]=@p1").
+		WithArgs(s.user.id).
+		WillReturnRows(newRows("asymmetric_key_id").AddRow(257))
+
+	settings := s.user.GetSettings(s.ctx)
+
+	s.Equal(UserSettings{Name: "test_name", AsymmetricKeyId: 257, Type: USER_TYPE_ASYMMETRIC_KEY}, settings)
+}
+
 func (s *UserTestSuite) TestDrop() {
 	s.expectUserNameQuery(int(s.user.id), "test_drop_name")
 	expectExactExec(s.mock, "DROP USER [test_drop_name]").
@@ -108,6 +158,14 @@ func (s *UserTestSuite) TestUpdateSettings() {
 	s.user.UpdateSettings(s.ctx, newSettings)
 }
 
+func (s *UserTestSuite) TestUpdateSettingsCertificate() {
+	s.expectUserNameQuery(int(s.user.id), "test_update_settings")
+	expectExactExec(s.mock, "ALTER USER [test_update_settings] WITH NAME=[new_name]").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.user.UpdateSettings(s.ctx, UserSettings{Name: "new_name", CertificateId: 256, Type: USER_TYPE_CERTIFICATE})
+}
+
 func (s *UserTestSuite) expectUserIdLookupQuery(name string, id int) {
 	expectExactQuery(s.mock, "SELECT USER_ID(@p1)").WithArgs(name).WillReturnRows(newRows("id").AddRow(id))
 }
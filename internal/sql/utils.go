@@ -3,7 +3,9 @@ package sql
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"strings"
 )
 
 func WithConnection[T any](ctx context.Context, connectionFactory func(context.Context) *sql.DB, action func(*sql.DB) T) T {
@@ -35,3 +37,34 @@ func getCurrentUserName(ctx context.Context, conn *sql.DB) string {
 
 	return res
 }
+
+func quoteString(s string) string {
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(s, "'", "''"))
+}
+
+func nullIfZero(value int) any {
+	if value == 0 {
+		return nil
+	}
+
+	return value
+}
+
+func findMapKey[K comparable, V comparable](m map[K]V, value V) K {
+	for k, v := range m {
+		if v == value {
+			return k
+		}
+	}
+
+	var zero K
+	return zero
+}
+
+func nullIfEmpty(value string) any {
+	if value == "" {
+		return nil
+	}
+
+	return value
+}
@@ -0,0 +1,132 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/PGSSoft/terraform-provider-mssql/internal/utils"
+	"strings"
+)
+
+type WorkloadGroupSettings struct {
+	Name                         string
+	ResourcePoolId               ResourcePoolId
+	Importance                   string
+	RequestMaxMemoryGrantPercent int
+	RequestMaxCpuTimeSec         int
+	RequestMemoryGrantTimeoutSec int
+	MaxDop                       int
+	GroupMaxRequests             int
+}
+
+func (s WorkloadGroupSettings) toSqlOptions() string {
+	return fmt.Sprintf("IMPORTANCE = %s, REQUEST_MAX_MEMORY_GRANT_PERCENT = %d, REQUEST_MAX_CPU_TIME_SEC = %d, REQUEST_MEMORY_GRANT_TIMEOUT_SEC = %d, MAX_DOP = %d, GROUP_MAX_REQUESTS = %d",
+		s.Importance, s.RequestMaxMemoryGrantPercent, s.RequestMaxCpuTimeSec, s.RequestMemoryGrantTimeoutSec, s.MaxDop, s.GroupMaxRequests)
+}
+
+type WorkloadGroup interface {
+	GetId(context.Context) WorkloadGroupId
+	Exists(context.Context) bool
+	GetSettings(context.Context) WorkloadGroupSettings
+	UpdateSettings(context.Context, WorkloadGroupSettings)
+	Drop(context.Context)
+}
+
+func GetWorkloadGroup(_ context.Context, conn Connection, id WorkloadGroupId) WorkloadGroup {
+	return workloadGroup{conn: conn, id: id}
+}
+
+func GetWorkloadGroupByName(ctx context.Context, conn Connection, name string) WorkloadGroup {
+	var id WorkloadGroupId
+
+	err := conn.getSqlConnection(ctx).QueryRowContext(ctx, "SELECT [group_id] FROM sys.resource_governor_workload_groups WHERE [name]=@p1", name).Scan(&id)
+	if err != nil {
+		utils.AddError(ctx, "Failed to retrieve workload group ID", err)
+		return nil
+	}
+
+	return GetWorkloadGroup(ctx, conn, id)
+}
+
+func CreateWorkloadGroup(ctx context.Context, conn Connection, settings WorkloadGroupSettings) WorkloadGroup {
+	var (
+		poolName string
+		group    WorkloadGroup
+	)
+
+	utils.StopOnError(ctx).
+		Then(func() { poolName = GetResourcePool(ctx, conn, settings.ResourcePoolId).GetSettings(ctx).Name }).
+		Then(func() {
+			conn.exec(ctx, fmt.Sprintf("CREATE WORKLOAD GROUP [%s] WITH (%s) USING [%s]; %s", settings.Name, settings.toSqlOptions(), poolName, resourceGovernorReconfigure))
+		}).
+		Then(func() { group = GetWorkloadGroupByName(ctx, conn, settings.Name) })
+
+	return group
+}
+
+var _ WorkloadGroup = workloadGroup{}
+
+type workloadGroup struct {
+	conn Connection
+	id   WorkloadGroupId
+}
+
+func (g workloadGroup) GetId(context.Context) WorkloadGroupId {
+	return g.id
+}
+
+func (g workloadGroup) Exists(ctx context.Context) bool {
+	switch _, err := g.getSettingsRaw(ctx); err {
+	case sql.ErrNoRows:
+		return false
+	case nil:
+		return true
+	default:
+		utils.AddError(ctx, "Failed to check if workload group exists", err)
+		return false
+	}
+}
+
+func (g workloadGroup) GetSettings(ctx context.Context) WorkloadGroupSettings {
+	settings, err := g.getSettingsRaw(ctx)
+	utils.AddError(ctx, "Failed to retrieve workload group settings", err)
+	return settings
+}
+
+func (g workloadGroup) UpdateSettings(ctx context.Context, settings WorkloadGroupSettings) {
+	var (
+		current  WorkloadGroupSettings
+		poolName string
+	)
+
+	utils.StopOnError(ctx).
+		Then(func() { current = g.GetSettings(ctx) }).
+		Then(func() { poolName = GetResourcePool(ctx, g.conn, settings.ResourcePoolId).GetSettings(ctx).Name }).
+		Then(func() {
+			settings.Name = current.Name
+			if settings != current {
+				g.conn.exec(ctx, fmt.Sprintf("ALTER WORKLOAD GROUP [%s] WITH (%s) USING [%s]; %s", current.Name, settings.toSqlOptions(), poolName, resourceGovernorReconfigure))
+			}
+		})
+}
+
+func (g workloadGroup) Drop(ctx context.Context) {
+	var settings WorkloadGroupSettings
+
+	utils.StopOnError(ctx).
+		Then(func() { settings = g.GetSettings(ctx) }).
+		Then(func() {
+			g.conn.exec(ctx, fmt.Sprintf("DROP WORKLOAD GROUP [%s]; %s", settings.Name, resourceGovernorReconfigure))
+		})
+}
+
+func (g workloadGroup) getSettingsRaw(ctx context.Context) (WorkloadGroupSettings, error) {
+	var settings WorkloadGroupSettings
+	err := g.conn.getSqlConnection(ctx).
+		QueryRowContext(ctx, `SELECT [name], [pool_id], [importance], [request_max_memory_grant_percent], [request_max_cpu_time_sec], [request_memory_grant_timeout_sec], [max_dop], [group_max_requests]
+			FROM sys.resource_governor_workload_groups WHERE [group_id]=@p1`, g.id).
+		Scan(&settings.Name, &settings.ResourcePoolId, &settings.Importance, &settings.RequestMaxMemoryGrantPercent, &settings.RequestMaxCpuTimeSec,
+			&settings.RequestMemoryGrantTimeoutSec, &settings.MaxDop, &settings.GroupMaxRequests)
+	settings.Importance = strings.ToUpper(settings.Importance)
+	return settings, err
+}
@@ -0,0 +1,91 @@
+package sql
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+	"math/rand"
+	"testing"
+)
+
+func TestWorkloadGroupTestSuite(t *testing.T) {
+	s := &WorkloadGroupTestSuite{}
+	suite.Run(t, s)
+}
+
+type WorkloadGroupTestSuite struct {
+	SqlTestSuite
+	group workloadGroup
+}
+
+func (s *WorkloadGroupTestSuite) SetupTest() {
+	s.SqlTestSuite.SetupTest()
+	s.group = workloadGroup{conn: s.connMock, id: WorkloadGroupId(rand.Int())}
+}
+
+func (s *WorkloadGroupTestSuite) TestCreate() {
+	s.expectPoolNameQuery(256, "reporting")
+	expectExactExec(s.mock, "CREATE WORKLOAD GROUP [reports] WITH (IMPORTANCE = LOW, REQUEST_MAX_MEMORY_GRANT_PERCENT = 25, REQUEST_MAX_CPU_TIME_SEC = 0, "+
+		"REQUEST_MEMORY_GRANT_TIMEOUT_SEC = 0, MAX_DOP = 2, GROUP_MAX_REQUESTS = 0) USING [reporting]; ALTER RESOURCE GOVERNOR RECONFIGURE").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectExactQuery(s.mock, "SELECT [group_id] FROM sys.resource_governor_workload_groups WHERE [name]=@p1").
+		WithArgs("reports").
+		WillReturnRows(newRows("group_id").AddRow(257))
+
+	group := CreateWorkloadGroup(s.ctx, s.connMock, WorkloadGroupSettings{Name: "reports", ResourcePoolId: 256, Importance: "LOW", RequestMaxMemoryGrantPercent: 25, MaxDop: 2})
+
+	s.Equal(WorkloadGroupId(257), group.GetId(s.ctx))
+}
+
+func (s *WorkloadGroupTestSuite) TestExists() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	s.True(s.group.Exists(s.ctx))
+}
+
+func (s *WorkloadGroupTestSuite) TestNotExists() {
+	s.expectSettingsQuery().WillReturnError(sql.ErrNoRows)
+
+	s.False(s.group.Exists(s.ctx))
+}
+
+func (s *WorkloadGroupTestSuite) TestGetSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+
+	s.Equal(WorkloadGroupSettings{Name: "reports", ResourcePoolId: 256, Importance: "LOW", RequestMaxMemoryGrantPercent: 25, MaxDop: 2}, s.group.GetSettings(s.ctx))
+}
+
+func (s *WorkloadGroupTestSuite) TestUpdateSettings() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+	s.expectPoolNameQuery(2, "default")
+	expectExactExec(s.mock, "ALTER WORKLOAD GROUP [reports] WITH (IMPORTANCE = HIGH, REQUEST_MAX_MEMORY_GRANT_PERCENT = 25, REQUEST_MAX_CPU_TIME_SEC = 0, "+
+		"REQUEST_MEMORY_GRANT_TIMEOUT_SEC = 0, MAX_DOP = 2, GROUP_MAX_REQUESTS = 0) USING [default]; ALTER RESOURCE GOVERNOR RECONFIGURE").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.group.UpdateSettings(s.ctx, WorkloadGroupSettings{Name: "reports", ResourcePoolId: 2, Importance: "HIGH", RequestMaxMemoryGrantPercent: 25, MaxDop: 2})
+}
+
+func (s *WorkloadGroupTestSuite) TestDrop() {
+	s.expectSettingsQuery().WillReturnRows(s.newSettingsRows())
+	expectExactExec(s.mock, "DROP WORKLOAD GROUP [reports]; ALTER RESOURCE GOVERNOR RECONFIGURE").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	s.group.Drop(s.ctx)
+}
+
+func (s *WorkloadGroupTestSuite) expectPoolNameQuery(id ResourcePoolId, name string) {
+	expectExactQuery(s.mock, `SELECT [name], [min_cpu_percent], [max_cpu_percent], [cap_cpu_percent], [min_memory_percent], [max_memory_percent]
+			FROM sys.resource_governor_resource_pools WHERE [pool_id]=@p1`).
+		WithArgs(id).
+		WillReturnRows(newRows("name", "min_cpu_percent", "max_cpu_percent", "cap_cpu_percent", "min_memory_percent", "max_memory_percent").AddRow(name, 0, 100, 100, 0, 100))
+}
+
+func (s *WorkloadGroupTestSuite) expectSettingsQuery() *sqlmock.ExpectedQuery {
+	return expectExactQuery(s.mock, `SELECT [name], [pool_id], [importance], [request_max_memory_grant_percent], [request_max_cpu_time_sec], [request_memory_grant_timeout_sec], [max_dop], [group_max_requests]
+			FROM sys.resource_governor_workload_groups WHERE [group_id]=@p1`).
+		WithArgs(s.group.id)
+}
+
+func (s *WorkloadGroupTestSuite) newSettingsRows() *sqlmock.Rows {
+	return newRows("name", "pool_id", "importance", "request_max_memory_grant_percent", "request_max_cpu_time_sec", "request_memory_grant_timeout_sec", "max_dop", "group_max_requests").
+		AddRow("reports", 256, "Low", 25, 0, 0, 2, 0)
+}
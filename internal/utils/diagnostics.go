@@ -33,3 +33,7 @@ func AddAttributeError(ctx context.Context, path path.Path, summary string, deta
 func AppendDiagnostics(ctx context.Context, diagnostics ...diag.Diagnostic) {
 	GetDiagnostics(ctx).Append(diagnostics...)
 }
+
+func AddWarning(ctx context.Context, summary string, details string) {
+	GetDiagnostics(ctx).AddWarning(summary, details)
+}
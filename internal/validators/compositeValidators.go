@@ -20,3 +20,47 @@ var UserNameValidators = []validator.String{
 var SchemaNameValidators = []validator.String{
 	stringLengthValidator{Min: 1, Max: 128},
 }
+
+var CredentialNameValidators = []validator.String{
+	stringLengthValidator{Min: 1, Max: 128},
+}
+
+var CertificateNameValidators = []validator.String{
+	stringLengthValidator{Min: 1, Max: 128},
+}
+
+var AsymmetricKeyNameValidators = []validator.String{
+	stringLengthValidator{Min: 1, Max: 128},
+}
+
+var ColumnKeyNameValidators = []validator.String{
+	stringLengthValidator{Min: 1, Max: 128},
+}
+
+var SecurityPolicyNameValidators = []validator.String{
+	stringLengthValidator{Min: 1, Max: 128},
+}
+
+var ColumnNameValidators = []validator.String{
+	stringLengthValidator{Min: 1, Max: 128},
+}
+
+var AuditNameValidators = []validator.String{
+	stringLengthValidator{Min: 1, Max: 128},
+}
+
+var EventSessionNameValidators = []validator.String{
+	stringLengthValidator{Min: 1, Max: 128},
+}
+
+var AgentObjectNameValidators = []validator.String{
+	stringLengthValidator{Min: 1, Max: 128},
+}
+
+var LinkedServerNameValidators = []validator.String{
+	stringLengthValidator{Min: 1, Max: 128},
+}
+
+var ResourceGovernorNameValidators = []validator.String{
+	stringLengthValidator{Min: 1, Max: 128},
+}